@@ -45,6 +45,13 @@ var (
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Acknowledgement{},
+		&AcknowledgementList{},
+		&Notification{},
+		&Comment{},
+		&Assignment{},
+		&Resolution{},
+		&Escalation{},
+		&Report{},
 	)
 	return nil
 }
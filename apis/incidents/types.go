@@ -21,7 +21,7 @@ import (
 )
 
 // +genclient
-// +genclient:skipVerbs=get,list,update,patch,deleteCollection,watch
+// +genclient:skipVerbs=update,patch,deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type Acknowledgement struct {
@@ -39,10 +39,330 @@ type AcknowledgementRequest struct {
 	// Skip sending notification
 	// +optional
 	SkipNotify bool
+
+	// The time at which Icinga removes the acknowledgement. Acknowledgement does not expire, if not set.
+	// +optional
+	Expiry *metav1.Time
+
+	// If true, acknowledgement is kept until the problem recovers. Otherwise, it is removed on any change of state.
+	// +optional
+	Sticky bool
 }
 
 type AcknowledgementResponse struct {
 	// The time at which the acknowledgement was done.
 	// +optional
 	Timestamp metav1.Time
+
+	// Name of the user who acknowledged the problem
+	// +optional
+	Author string
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AcknowledgementList is a collection of Acknowledgement.
+type AcknowledgementList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []Acknowledgement
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type Notification struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Request  NotificationRequest
+	Response NotificationResponse
+}
+
+type NotificationRequest struct {
+	// Name of alert
+	Alert string
+
+	// Icinga host of the notification
+	Host string
+
+	// Type of notification, such as PROBLEM, ACKNOWLEDGEMENT or RECOVERY
+	Type string
+
+	// State of service, such as OK, Warning or Critical
+	State string
+
+	// Output of service check
+	// +optional
+	Output string
+
+	// The time at which Icinga detected the event
+	Time metav1.Time
+
+	// Author of acknowledgement or custom notification
+	// +optional
+	Author string
+
+	// Comment of acknowledgement or custom notification
+	// +optional
+	Comment string
+
+	// Only find the receivers of notification, without sending it or updating Incident
+	// +optional
+	DryRun bool
+}
+
+type NotificationResponse struct {
+	// The time at which the notification was dispatched.
+	// +optional
+	Timestamp metav1.Time
+
+	// Receivers the notification is sent to
+	// +optional
+	Receivers []NotificationReceiver
+
+	// Kind/name of the Downtime or Silence that suppressed the notification
+	// +optional
+	SuppressedBy string
+
+	// Number of targets of the digest, if the notification is sent as a digest of its group
+	// +optional
+	DigestTargets int32
+}
+
+type NotificationReceiver struct {
+	// Route of NotificationPolicy that selected the receiver, empty for receivers of alert
+	// +optional
+	Route string
+
+	// Notifier used to send the notification
+	Notifier string
+
+	// Contacts the notification is sent to
+	To []string
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Comment adds a comment to the Incident of the same name. The comment is added to the service in Icinga
+// and sent to the receivers of incident as a custom notification.
+type Comment struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Request  CommentRequest
+	Response CommentResponse
+}
+
+type CommentRequest struct {
+	// Comment by user
+	Comment string
+}
+
+type CommentResponse struct {
+	// The time at which the comment was added.
+	// +optional
+	Timestamp metav1.Time
+
+	// Name of the user who added the comment
+	// +optional
+	Author string
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Assignment assigns an owner to the Incident of the same name.
+type Assignment struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Request  AssignmentRequest
+	Response AssignmentResponse
+}
+
+type AssignmentRequest struct {
+	// Name of the user who owns the incident
+	Assignee string
+
+	// Comment by user
+	// +optional
+	Comment string
+}
+
+type AssignmentResponse struct {
+	// The time at which the incident was assigned.
+	// +optional
+	Timestamp metav1.Time
+
+	// Name of the user who assigned the incident
+	// +optional
+	Author string
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Resolution manually resolves the Incident of the same name. If the problem persists, Icinga opens a new Incident
+// on its next notification.
+type Resolution struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Request  ResolutionRequest
+	Response ResolutionResponse
+}
+
+type ResolutionRequest struct {
+	// Comment by user
+	Comment string
+}
+
+type ResolutionResponse struct {
+	// The time at which the incident was resolved.
+	// +optional
+	Timestamp metav1.Time
+
+	// Name of the user who resolved the incident
+	// +optional
+	Author string
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Escalation re-triggers escalation of the Incident of the same name. The incident is sent to the receivers
+// of the next escalation step that has not been reached, or of the step given in request.
+type Escalation struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Request  EscalationRequest
+	Response EscalationResponse
+}
+
+type EscalationRequest struct {
+	// Comment by user
+	// +optional
+	Comment string
+
+	// EscalateAfter of the escalation step to send the incident to. The next step that has not been reached is
+	// used, if not set.
+	// +optional
+	EscalateAfter *metav1.Duration
+}
+
+type EscalationResponse struct {
+	// The time at which the incident was escalated.
+	// +optional
+	Timestamp metav1.Time
+
+	// Name of the user who escalated the incident
+	// +optional
+	Author string
+
+	// EscalateAfter of the escalation step the incident is sent to
+	// +optional
+	EscalateAfter metav1.Duration
+
+	// Receivers the incident is sent to
+	// +optional
+	Receivers []NotificationReceiver
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Report computes the availability, number of incidents, mean time to acknowledge and mean time to recover
+// of the alerts in its namespace over a period, from their Incidents.
+type Report struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Request  ReportRequest
+	Response ReportResponse
+}
+
+type ReportRequest struct {
+	// Start of the period
+	From metav1.Time
+
+	// End of the period. Current time is used, if not set.
+	// +optional
+	To *metav1.Time
+
+	// Names of alerts to report. All alerts of namespace are reported, if empty.
+	// +optional
+	Alerts []string
+}
+
+type ReportResponse struct {
+	// The time at which the report was computed.
+	// +optional
+	Timestamp metav1.Time
+
+	// Start of the period
+	// +optional
+	From metav1.Time
+
+	// End of the period. It is never after the time at which the report was computed.
+	// +optional
+	To metav1.Time
+
+	// Report of all reported alerts of namespace together
+	// +optional
+	Summary AlertReport
+
+	// Reports of alerts, ordered by kind and name
+	// +optional
+	Alerts []AlertReport
+}
+
+// AlertReport is computed from the Incidents of an alert, or of all alerts of a namespace.
+type AlertReport struct {
+	// Namespace of alert
+	Namespace string
+
+	// Kind of alert, such as PodAlert. Empty for reports of all alerts of namespace.
+	// +optional
+	Kind string
+
+	// Name of alert. Empty for reports of all alerts of namespace.
+	// +optional
+	Name string
+
+	// Number of incidents whose problem started in the period
+	// +optional
+	Incidents int32
+
+	// Number of these incidents that were acknowledged
+	// +optional
+	Acknowledged int32
+
+	// Number of these incidents that were recovered or resolved
+	// +optional
+	Recovered int32
+
+	// Duration of the period during which any incident was open
+	// +optional
+	Downtime metav1.Duration
+
+	// Percentage of the period during which no incident was open
+	Availability float64
+
+	// Mean time from the start of problems to their first acknowledgement. Not set, if no incident was acknowledged.
+	// +optional
+	MeanTimeToAcknowledge *metav1.Duration
+
+	// Mean time from the start of problems to their recovery or resolution. Not set, if no incident was recovered.
+	// +optional
+	MeanTimeToRecover *metav1.Duration
 }
@@ -33,8 +33,29 @@ import (
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
 		"github.com/appscode/searchlight/apis/incidents/v1alpha1.Acknowledgement":         schema_searchlight_apis_incidents_v1alpha1_Acknowledgement(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.AcknowledgementList":     schema_searchlight_apis_incidents_v1alpha1_AcknowledgementList(ref),
 		"github.com/appscode/searchlight/apis/incidents/v1alpha1.AcknowledgementRequest":  schema_searchlight_apis_incidents_v1alpha1_AcknowledgementRequest(ref),
 		"github.com/appscode/searchlight/apis/incidents/v1alpha1.AcknowledgementResponse": schema_searchlight_apis_incidents_v1alpha1_AcknowledgementResponse(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.AlertReport":             schema_searchlight_apis_incidents_v1alpha1_AlertReport(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.Assignment":              schema_searchlight_apis_incidents_v1alpha1_Assignment(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.AssignmentRequest":       schema_searchlight_apis_incidents_v1alpha1_AssignmentRequest(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.AssignmentResponse":      schema_searchlight_apis_incidents_v1alpha1_AssignmentResponse(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.Comment":                 schema_searchlight_apis_incidents_v1alpha1_Comment(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.CommentRequest":          schema_searchlight_apis_incidents_v1alpha1_CommentRequest(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.CommentResponse":         schema_searchlight_apis_incidents_v1alpha1_CommentResponse(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.Escalation":              schema_searchlight_apis_incidents_v1alpha1_Escalation(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.EscalationRequest":       schema_searchlight_apis_incidents_v1alpha1_EscalationRequest(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.EscalationResponse":      schema_searchlight_apis_incidents_v1alpha1_EscalationResponse(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.Notification":            schema_searchlight_apis_incidents_v1alpha1_Notification(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationReceiver":    schema_searchlight_apis_incidents_v1alpha1_NotificationReceiver(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationRequest":     schema_searchlight_apis_incidents_v1alpha1_NotificationRequest(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationResponse":    schema_searchlight_apis_incidents_v1alpha1_NotificationResponse(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.Report":                  schema_searchlight_apis_incidents_v1alpha1_Report(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.ReportRequest":           schema_searchlight_apis_incidents_v1alpha1_ReportRequest(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.ReportResponse":          schema_searchlight_apis_incidents_v1alpha1_ReportResponse(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.Resolution":              schema_searchlight_apis_incidents_v1alpha1_Resolution(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.ResolutionRequest":       schema_searchlight_apis_incidents_v1alpha1_ResolutionRequest(ref),
+		"github.com/appscode/searchlight/apis/incidents/v1alpha1.ResolutionResponse":      schema_searchlight_apis_incidents_v1alpha1_ResolutionResponse(ref),
 		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                   schema_apimachinery_pkg_api_resource_Quantity(ref),
 		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                schema_apimachinery_pkg_api_resource_int64Amount(ref),
 		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                   schema_pkg_apis_meta_v1_APIGroup(ref),
@@ -130,6 +151,54 @@ func schema_searchlight_apis_incidents_v1alpha1_Acknowledgement(ref common.Refer
 	}
 }
 
+func schema_searchlight_apis_incidents_v1alpha1_AcknowledgementList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AcknowledgementList is a collection of Acknowledgement.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Acknowledgement.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.Acknowledgement"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.Acknowledgement", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_searchlight_apis_incidents_v1alpha1_AcknowledgementRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -150,10 +219,25 @@ func schema_searchlight_apis_incidents_v1alpha1_AcknowledgementRequest(ref commo
 							Format:      "",
 						},
 					},
+					"expiry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which Icinga removes the acknowledgement. Acknowledgement does not expire, if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"sticky": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, acknowledgement is kept until the problem recovers. Otherwise, it is removed on any change of state.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"comment"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -169,6 +253,844 @@ func schema_searchlight_apis_incidents_v1alpha1_AcknowledgementResponse(ref comm
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the user who acknowledged the problem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_AlertReport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertReport is computed from the Incidents of an alert, or of all alerts of a namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of alert",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of alert, such as PodAlert. Empty for reports of all alerts of namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of alert. Empty for reports of all alerts of namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"incidents": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of incidents whose problem started in the period",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"acknowledged": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of these incidents that were acknowledged",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"recovered": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of these incidents that were recovered or resolved",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"downtime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration of the period during which any incident was open",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"availability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Percentage of the period during which no incident was open",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"meanTimeToAcknowledge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mean time from the start of problems to their first acknowledgement. Not set, if no incident was acknowledged.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"meanTimeToRecover": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mean time from the start of problems to their recovery or resolution. Not set, if no incident was recovered.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"namespace", "availability"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_Assignment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Assignment assigns an owner to the Incident of the same name.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.AssignmentRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.AssignmentResponse"),
+						},
+					},
+				},
+				Required: []string{"request"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.AssignmentRequest", "github.com/appscode/searchlight/apis/incidents/v1alpha1.AssignmentResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_AssignmentRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"assignee": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the user who owns the incident",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment by user",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"assignee"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_AssignmentResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the incident was assigned.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the user who assigned the incident",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_Comment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Comment adds a comment to the Incident of the same name. The comment is added to the service in Icinga and sent to the receivers of incident as a custom notification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.CommentRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.CommentResponse"),
+						},
+					},
+				},
+				Required: []string{"request"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.CommentRequest", "github.com/appscode/searchlight/apis/incidents/v1alpha1.CommentResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_CommentRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment by user",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"comment"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_CommentResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the comment was added.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the user who added the comment",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_Escalation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Escalation re-triggers escalation of the Incident of the same name. The incident is sent to the receivers of the next escalation step that has not been reached, or of the step given in request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.EscalationRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.EscalationResponse"),
+						},
+					},
+				},
+				Required: []string{"request"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.EscalationRequest", "github.com/appscode/searchlight/apis/incidents/v1alpha1.EscalationResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_EscalationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment by user",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"escalateAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EscalateAfter of the escalation step to send the incident to. The next step that has not been reached is used, if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_EscalationResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the incident was escalated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the user who escalated the incident",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"escalateAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EscalateAfter of the escalation step the incident is sent to",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Receivers the incident is sent to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationReceiver"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationReceiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_Notification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Notification is a notification of Icinga for an alert, posted by hyperalert to be sent by Searchlight operator.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationResponse"),
+						},
+					},
+				},
+				Required: []string{"request"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationRequest", "github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_NotificationReceiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"route": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Route of NotificationPolicy that selected the receiver, empty for receivers of alert",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notifier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Notifier used to send the notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contacts the notification is sent to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"notifier", "to"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_NotificationRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"alert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of alert",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Icinga host of the notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of notification, such as PROBLEM, ACKNOWLEDGEMENT or RECOVERY",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State of service, such as OK, Warning or Critical",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"output": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Output of service check",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which Icinga detected the event",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Author of acknowledgement or custom notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment of acknowledgement or custom notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Only find the receivers of notification, without sending it or updating Incident",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"alert", "host", "type", "state", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_NotificationResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the notification was dispatched.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Receivers the notification is sent to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationReceiver"),
+									},
+								},
+							},
+						},
+					},
+					"suppressedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind/name of the Downtime or Silence that suppressed the notification",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"digestTargets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of targets of the digest, if the notification is sent as a digest of its group",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.NotificationReceiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_Report(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Report computes the availability, number of incidents, mean time to acknowledge and mean time to recover of the alerts in its namespace over a period, from their Incidents.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.ReportRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.ReportResponse"),
+						},
+					},
+				},
+				Required: []string{"request"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.ReportRequest", "github.com/appscode/searchlight/apis/incidents/v1alpha1.ReportResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_ReportRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start of the period",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End of the period. Current time is used, if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"alerts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of alerts to report. All alerts of namespace are reported, if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"from"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_ReportResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the report was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start of the period",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End of the period. It is never after the time at which the report was computed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Report of all reported alerts of namespace together",
+							Ref:         ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.AlertReport"),
+						},
+					},
+					"alerts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reports of alerts, ordered by kind and name",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.AlertReport"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.AlertReport", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_Resolution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Resolution manually resolves the Incident of the same name. If the problem persists, Icinga opens a new Incident on its next notification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"request": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.ResolutionRequest"),
+						},
+					},
+					"response": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/incidents/v1alpha1.ResolutionResponse"),
+						},
+					},
+				},
+				Required: []string{"request"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/incidents/v1alpha1.ResolutionRequest", "github.com/appscode/searchlight/apis/incidents/v1alpha1.ResolutionResponse", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_ResolutionRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Comment by user",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"comment"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_incidents_v1alpha1_ResolutionResponse(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the incident was resolved.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the user who resolved the incident",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
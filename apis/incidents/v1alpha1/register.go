@@ -46,6 +46,13 @@ func init() {
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Acknowledgement{},
+		&AcknowledgementList{},
+		&Notification{},
+		&Comment{},
+		&Assignment{},
+		&Resolution{},
+		&Escalation{},
+		&Report{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
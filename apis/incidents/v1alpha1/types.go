@@ -24,10 +24,34 @@ const (
 	ResourceKindAcknowledgement     = "Acknowledgement"
 	ResourcePluralAcknowledgement   = "acknowledgements"
 	ResourceSingularAcknowledgement = "acknowledgement"
+
+	ResourceKindNotification     = "Notification"
+	ResourcePluralNotification   = "notifications"
+	ResourceSingularNotification = "notification"
+
+	ResourceKindComment     = "Comment"
+	ResourcePluralComment   = "comments"
+	ResourceSingularComment = "comment"
+
+	ResourceKindAssignment     = "Assignment"
+	ResourcePluralAssignment   = "assignments"
+	ResourceSingularAssignment = "assignment"
+
+	ResourceKindResolution     = "Resolution"
+	ResourcePluralResolution   = "resolutions"
+	ResourceSingularResolution = "resolution"
+
+	ResourceKindEscalation     = "Escalation"
+	ResourcePluralEscalation   = "escalations"
+	ResourceSingularEscalation = "escalation"
+
+	ResourceKindReport     = "Report"
+	ResourcePluralReport   = "reports"
+	ResourceSingularReport = "report"
 )
 
 // +genclient
-// +genclient:skipVerbs=get,list,update,patch,deleteCollection,watch
+// +genclient:skipVerbs=update,patch,deleteCollection
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -46,10 +70,338 @@ type AcknowledgementRequest struct {
 	// Skip sending notification
 	// +optional
 	SkipNotify bool `json:"skipNotify,omitempty"`
+
+	// The time at which Icinga removes the acknowledgement. Acknowledgement does not expire, if not set.
+	// +optional
+	Expiry *metav1.Time `json:"expiry,omitempty"`
+
+	// If true, acknowledgement is kept until the problem recovers. Otherwise, it is removed on any change of state.
+	// +optional
+	Sticky bool `json:"sticky,omitempty"`
 }
 
 type AcknowledgementResponse struct {
 	// The time at which the acknowledgement was done.
 	// +optional
 	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Name of the user who acknowledged the problem
+	// +optional
+	Author string `json:"author,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AcknowledgementList is a collection of Acknowledgement.
+type AcknowledgementList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of Acknowledgement.
+	Items []Acknowledgement `json:"items"`
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Notification is a notification of Icinga for an alert, posted by hyperalert to be sent by Searchlight operator.
+type Notification struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Request  NotificationRequest  `json:"request"`
+	Response NotificationResponse `json:"response,omitempty"`
+}
+
+type NotificationRequest struct {
+	// Name of alert
+	Alert string `json:"alert"`
+
+	// Icinga host of the notification
+	Host string `json:"host"`
+
+	// Type of notification, such as PROBLEM, ACKNOWLEDGEMENT or RECOVERY
+	Type string `json:"type"`
+
+	// State of service, such as OK, Warning or Critical
+	State string `json:"state"`
+
+	// Output of service check
+	// +optional
+	Output string `json:"output,omitempty"`
+
+	// The time at which Icinga detected the event
+	Time metav1.Time `json:"time"`
+
+	// Author of acknowledgement or custom notification
+	// +optional
+	Author string `json:"author,omitempty"`
+
+	// Comment of acknowledgement or custom notification
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Only find the receivers of notification, without sending it or updating Incident
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+type NotificationResponse struct {
+	// The time at which the notification was dispatched.
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Receivers the notification is sent to
+	// +optional
+	Receivers []NotificationReceiver `json:"receivers,omitempty"`
+
+	// Kind/name of the Downtime or Silence that suppressed the notification
+	// +optional
+	SuppressedBy string `json:"suppressedBy,omitempty"`
+
+	// Number of targets of the digest, if the notification is sent as a digest of its group
+	// +optional
+	DigestTargets int32 `json:"digestTargets,omitempty"`
+}
+
+type NotificationReceiver struct {
+	// Route of NotificationPolicy that selected the receiver, empty for receivers of alert
+	// +optional
+	Route string `json:"route,omitempty"`
+
+	// Notifier used to send the notification
+	Notifier string `json:"notifier"`
+
+	// Contacts the notification is sent to
+	To []string `json:"to"`
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Comment adds a comment to the Incident of the same name. The comment is added to the service in Icinga
+// and sent to the receivers of incident as a custom notification.
+type Comment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Request  CommentRequest  `json:"request"`
+	Response CommentResponse `json:"response,omitempty"`
+}
+
+type CommentRequest struct {
+	// Comment by user
+	Comment string `json:"comment"`
+}
+
+type CommentResponse struct {
+	// The time at which the comment was added.
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Name of the user who added the comment
+	// +optional
+	Author string `json:"author,omitempty"`
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Assignment assigns an owner to the Incident of the same name.
+type Assignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Request  AssignmentRequest  `json:"request"`
+	Response AssignmentResponse `json:"response,omitempty"`
+}
+
+type AssignmentRequest struct {
+	// Name of the user who owns the incident
+	Assignee string `json:"assignee"`
+
+	// Comment by user
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+type AssignmentResponse struct {
+	// The time at which the incident was assigned.
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Name of the user who assigned the incident
+	// +optional
+	Author string `json:"author,omitempty"`
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Resolution manually resolves the Incident of the same name. If the problem persists, Icinga opens a new Incident
+// on its next notification.
+type Resolution struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Request  ResolutionRequest  `json:"request"`
+	Response ResolutionResponse `json:"response,omitempty"`
+}
+
+type ResolutionRequest struct {
+	// Comment by user
+	Comment string `json:"comment"`
+}
+
+type ResolutionResponse struct {
+	// The time at which the incident was resolved.
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Name of the user who resolved the incident
+	// +optional
+	Author string `json:"author,omitempty"`
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Escalation re-triggers escalation of the Incident of the same name. The incident is sent to the receivers
+// of the next escalation step that has not been reached, or of the step given in request.
+type Escalation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Request  EscalationRequest  `json:"request"`
+	Response EscalationResponse `json:"response,omitempty"`
+}
+
+type EscalationRequest struct {
+	// Comment by user
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// EscalateAfter of the escalation step to send the incident to. The next step that has not been reached is
+	// used, if not set.
+	// +optional
+	EscalateAfter *metav1.Duration `json:"escalateAfter,omitempty"`
+}
+
+type EscalationResponse struct {
+	// The time at which the incident was escalated.
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Name of the user who escalated the incident
+	// +optional
+	Author string `json:"author,omitempty"`
+
+	// EscalateAfter of the escalation step the incident is sent to
+	// +optional
+	EscalateAfter metav1.Duration `json:"escalateAfter,omitempty"`
+
+	// Receivers the incident is sent to
+	// +optional
+	Receivers []NotificationReceiver `json:"receivers,omitempty"`
+}
+
+// +genclient
+// +genclient:skipVerbs=get,list,update,patch,delete,deleteCollection,watch
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Report computes the availability, number of incidents, mean time to acknowledge and mean time to recover
+// of the alerts in its namespace over a period, from their Incidents.
+type Report struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Request  ReportRequest  `json:"request"`
+	Response ReportResponse `json:"response,omitempty"`
+}
+
+type ReportRequest struct {
+	// Start of the period
+	From metav1.Time `json:"from"`
+
+	// End of the period. Current time is used, if not set.
+	// +optional
+	To *metav1.Time `json:"to,omitempty"`
+
+	// Names of alerts to report. All alerts of namespace are reported, if empty.
+	// +optional
+	Alerts []string `json:"alerts,omitempty"`
+}
+
+type ReportResponse struct {
+	// The time at which the report was computed.
+	// +optional
+	Timestamp metav1.Time `json:"timestamp,omitempty"`
+
+	// Start of the period
+	// +optional
+	From metav1.Time `json:"from,omitempty"`
+
+	// End of the period. It is never after the time at which the report was computed.
+	// +optional
+	To metav1.Time `json:"to,omitempty"`
+
+	// Report of all reported alerts of namespace together
+	// +optional
+	Summary AlertReport `json:"summary,omitempty"`
+
+	// Reports of alerts, ordered by kind and name
+	// +optional
+	Alerts []AlertReport `json:"alerts,omitempty"`
+}
+
+// AlertReport is computed from the Incidents of an alert, or of all alerts of a namespace.
+type AlertReport struct {
+	// Namespace of alert
+	Namespace string `json:"namespace"`
+
+	// Kind of alert, such as PodAlert. Empty for reports of all alerts of namespace.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Name of alert. Empty for reports of all alerts of namespace.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Number of incidents whose problem started in the period
+	// +optional
+	Incidents int32 `json:"incidents,omitempty"`
+
+	// Number of these incidents that were acknowledged
+	// +optional
+	Acknowledged int32 `json:"acknowledged,omitempty"`
+
+	// Number of these incidents that were recovered or resolved
+	// +optional
+	Recovered int32 `json:"recovered,omitempty"`
+
+	// Duration of the period during which any incident was open
+	// +optional
+	Downtime metav1.Duration `json:"downtime,omitempty"`
+
+	// Percentage of the period during which no incident was open
+	Availability float64 `json:"availability"`
+
+	// Mean time from the start of problems to their first acknowledgement. Not set, if no incident was acknowledged.
+	// +optional
+	MeanTimeToAcknowledge *metav1.Duration `json:"meanTimeToAcknowledge,omitempty"`
+
+	// Mean time from the start of problems to their recovery or resolution. Not set, if no incident was recovered.
+	// +optional
+	MeanTimeToRecover *metav1.Duration `json:"meanTimeToRecover,omitempty"`
 }
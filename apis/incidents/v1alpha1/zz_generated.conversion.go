@@ -21,7 +21,10 @@ limitations under the License.
 package v1alpha1
 
 import (
+	unsafe "unsafe"
+
 	incidents "github.com/appscode/searchlight/apis/incidents"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -43,6 +46,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AcknowledgementList)(nil), (*incidents.AcknowledgementList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AcknowledgementList_To_incidents_AcknowledgementList(a.(*AcknowledgementList), b.(*incidents.AcknowledgementList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.AcknowledgementList)(nil), (*AcknowledgementList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_AcknowledgementList_To_v1alpha1_AcknowledgementList(a.(*incidents.AcknowledgementList), b.(*AcknowledgementList), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AcknowledgementRequest)(nil), (*incidents.AcknowledgementRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AcknowledgementRequest_To_incidents_AcknowledgementRequest(a.(*AcknowledgementRequest), b.(*incidents.AcknowledgementRequest), scope)
 	}); err != nil {
@@ -63,6 +76,206 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AlertReport)(nil), (*incidents.AlertReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertReport_To_incidents_AlertReport(a.(*AlertReport), b.(*incidents.AlertReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.AlertReport)(nil), (*AlertReport)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_AlertReport_To_v1alpha1_AlertReport(a.(*incidents.AlertReport), b.(*AlertReport), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Assignment)(nil), (*incidents.Assignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Assignment_To_incidents_Assignment(a.(*Assignment), b.(*incidents.Assignment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.Assignment)(nil), (*Assignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_Assignment_To_v1alpha1_Assignment(a.(*incidents.Assignment), b.(*Assignment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AssignmentRequest)(nil), (*incidents.AssignmentRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AssignmentRequest_To_incidents_AssignmentRequest(a.(*AssignmentRequest), b.(*incidents.AssignmentRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.AssignmentRequest)(nil), (*AssignmentRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_AssignmentRequest_To_v1alpha1_AssignmentRequest(a.(*incidents.AssignmentRequest), b.(*AssignmentRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AssignmentResponse)(nil), (*incidents.AssignmentResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AssignmentResponse_To_incidents_AssignmentResponse(a.(*AssignmentResponse), b.(*incidents.AssignmentResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.AssignmentResponse)(nil), (*AssignmentResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_AssignmentResponse_To_v1alpha1_AssignmentResponse(a.(*incidents.AssignmentResponse), b.(*AssignmentResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Comment)(nil), (*incidents.Comment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Comment_To_incidents_Comment(a.(*Comment), b.(*incidents.Comment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.Comment)(nil), (*Comment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_Comment_To_v1alpha1_Comment(a.(*incidents.Comment), b.(*Comment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CommentRequest)(nil), (*incidents.CommentRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CommentRequest_To_incidents_CommentRequest(a.(*CommentRequest), b.(*incidents.CommentRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.CommentRequest)(nil), (*CommentRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_CommentRequest_To_v1alpha1_CommentRequest(a.(*incidents.CommentRequest), b.(*CommentRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CommentResponse)(nil), (*incidents.CommentResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CommentResponse_To_incidents_CommentResponse(a.(*CommentResponse), b.(*incidents.CommentResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.CommentResponse)(nil), (*CommentResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_CommentResponse_To_v1alpha1_CommentResponse(a.(*incidents.CommentResponse), b.(*CommentResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Escalation)(nil), (*incidents.Escalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Escalation_To_incidents_Escalation(a.(*Escalation), b.(*incidents.Escalation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.Escalation)(nil), (*Escalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_Escalation_To_v1alpha1_Escalation(a.(*incidents.Escalation), b.(*Escalation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationRequest)(nil), (*incidents.EscalationRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EscalationRequest_To_incidents_EscalationRequest(a.(*EscalationRequest), b.(*incidents.EscalationRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.EscalationRequest)(nil), (*EscalationRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_EscalationRequest_To_v1alpha1_EscalationRequest(a.(*incidents.EscalationRequest), b.(*EscalationRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationResponse)(nil), (*incidents.EscalationResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EscalationResponse_To_incidents_EscalationResponse(a.(*EscalationResponse), b.(*incidents.EscalationResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.EscalationResponse)(nil), (*EscalationResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_EscalationResponse_To_v1alpha1_EscalationResponse(a.(*incidents.EscalationResponse), b.(*EscalationResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Notification)(nil), (*incidents.Notification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Notification_To_incidents_Notification(a.(*Notification), b.(*incidents.Notification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.Notification)(nil), (*Notification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_Notification_To_v1alpha1_Notification(a.(*incidents.Notification), b.(*Notification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationReceiver)(nil), (*incidents.NotificationReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NotificationReceiver_To_incidents_NotificationReceiver(a.(*NotificationReceiver), b.(*incidents.NotificationReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.NotificationReceiver)(nil), (*NotificationReceiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_NotificationReceiver_To_v1alpha1_NotificationReceiver(a.(*incidents.NotificationReceiver), b.(*NotificationReceiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationRequest)(nil), (*incidents.NotificationRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NotificationRequest_To_incidents_NotificationRequest(a.(*NotificationRequest), b.(*incidents.NotificationRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.NotificationRequest)(nil), (*NotificationRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_NotificationRequest_To_v1alpha1_NotificationRequest(a.(*incidents.NotificationRequest), b.(*NotificationRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationResponse)(nil), (*incidents.NotificationResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NotificationResponse_To_incidents_NotificationResponse(a.(*NotificationResponse), b.(*incidents.NotificationResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.NotificationResponse)(nil), (*NotificationResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_NotificationResponse_To_v1alpha1_NotificationResponse(a.(*incidents.NotificationResponse), b.(*NotificationResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Report)(nil), (*incidents.Report)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Report_To_incidents_Report(a.(*Report), b.(*incidents.Report), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.Report)(nil), (*Report)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_Report_To_v1alpha1_Report(a.(*incidents.Report), b.(*Report), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReportRequest)(nil), (*incidents.ReportRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReportRequest_To_incidents_ReportRequest(a.(*ReportRequest), b.(*incidents.ReportRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.ReportRequest)(nil), (*ReportRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_ReportRequest_To_v1alpha1_ReportRequest(a.(*incidents.ReportRequest), b.(*ReportRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReportResponse)(nil), (*incidents.ReportResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReportResponse_To_incidents_ReportResponse(a.(*ReportResponse), b.(*incidents.ReportResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.ReportResponse)(nil), (*ReportResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_ReportResponse_To_v1alpha1_ReportResponse(a.(*incidents.ReportResponse), b.(*ReportResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Resolution)(nil), (*incidents.Resolution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Resolution_To_incidents_Resolution(a.(*Resolution), b.(*incidents.Resolution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.Resolution)(nil), (*Resolution)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_Resolution_To_v1alpha1_Resolution(a.(*incidents.Resolution), b.(*Resolution), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResolutionRequest)(nil), (*incidents.ResolutionRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolutionRequest_To_incidents_ResolutionRequest(a.(*ResolutionRequest), b.(*incidents.ResolutionRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.ResolutionRequest)(nil), (*ResolutionRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_ResolutionRequest_To_v1alpha1_ResolutionRequest(a.(*incidents.ResolutionRequest), b.(*ResolutionRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResolutionResponse)(nil), (*incidents.ResolutionResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolutionResponse_To_incidents_ResolutionResponse(a.(*ResolutionResponse), b.(*incidents.ResolutionResponse), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*incidents.ResolutionResponse)(nil), (*ResolutionResponse)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_incidents_ResolutionResponse_To_v1alpha1_ResolutionResponse(a.(*incidents.ResolutionResponse), b.(*ResolutionResponse), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -98,9 +311,33 @@ func Convert_incidents_Acknowledgement_To_v1alpha1_Acknowledgement(in *incidents
 	return autoConvert_incidents_Acknowledgement_To_v1alpha1_Acknowledgement(in, out, s)
 }
 
+func autoConvert_v1alpha1_AcknowledgementList_To_incidents_AcknowledgementList(in *AcknowledgementList, out *incidents.AcknowledgementList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]incidents.Acknowledgement)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_AcknowledgementList_To_incidents_AcknowledgementList is an autogenerated conversion function.
+func Convert_v1alpha1_AcknowledgementList_To_incidents_AcknowledgementList(in *AcknowledgementList, out *incidents.AcknowledgementList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AcknowledgementList_To_incidents_AcknowledgementList(in, out, s)
+}
+
+func autoConvert_incidents_AcknowledgementList_To_v1alpha1_AcknowledgementList(in *incidents.AcknowledgementList, out *AcknowledgementList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Acknowledgement)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_incidents_AcknowledgementList_To_v1alpha1_AcknowledgementList is an autogenerated conversion function.
+func Convert_incidents_AcknowledgementList_To_v1alpha1_AcknowledgementList(in *incidents.AcknowledgementList, out *AcknowledgementList, s conversion.Scope) error {
+	return autoConvert_incidents_AcknowledgementList_To_v1alpha1_AcknowledgementList(in, out, s)
+}
+
 func autoConvert_v1alpha1_AcknowledgementRequest_To_incidents_AcknowledgementRequest(in *AcknowledgementRequest, out *incidents.AcknowledgementRequest, s conversion.Scope) error {
 	out.Comment = in.Comment
 	out.SkipNotify = in.SkipNotify
+	out.Expiry = (*v1.Time)(unsafe.Pointer(in.Expiry))
+	out.Sticky = in.Sticky
 	return nil
 }
 
@@ -112,6 +349,8 @@ func Convert_v1alpha1_AcknowledgementRequest_To_incidents_AcknowledgementRequest
 func autoConvert_incidents_AcknowledgementRequest_To_v1alpha1_AcknowledgementRequest(in *incidents.AcknowledgementRequest, out *AcknowledgementRequest, s conversion.Scope) error {
 	out.Comment = in.Comment
 	out.SkipNotify = in.SkipNotify
+	out.Expiry = (*v1.Time)(unsafe.Pointer(in.Expiry))
+	out.Sticky = in.Sticky
 	return nil
 }
 
@@ -122,6 +361,7 @@ func Convert_incidents_AcknowledgementRequest_To_v1alpha1_AcknowledgementRequest
 
 func autoConvert_v1alpha1_AcknowledgementResponse_To_incidents_AcknowledgementResponse(in *AcknowledgementResponse, out *incidents.AcknowledgementResponse, s conversion.Scope) error {
 	out.Timestamp = in.Timestamp
+	out.Author = in.Author
 	return nil
 }
 
@@ -132,6 +372,7 @@ func Convert_v1alpha1_AcknowledgementResponse_To_incidents_AcknowledgementRespon
 
 func autoConvert_incidents_AcknowledgementResponse_To_v1alpha1_AcknowledgementResponse(in *incidents.AcknowledgementResponse, out *AcknowledgementResponse, s conversion.Scope) error {
 	out.Timestamp = in.Timestamp
+	out.Author = in.Author
 	return nil
 }
 
@@ -139,3 +380,551 @@ func autoConvert_incidents_AcknowledgementResponse_To_v1alpha1_AcknowledgementRe
 func Convert_incidents_AcknowledgementResponse_To_v1alpha1_AcknowledgementResponse(in *incidents.AcknowledgementResponse, out *AcknowledgementResponse, s conversion.Scope) error {
 	return autoConvert_incidents_AcknowledgementResponse_To_v1alpha1_AcknowledgementResponse(in, out, s)
 }
+
+func autoConvert_v1alpha1_AlertReport_To_incidents_AlertReport(in *AlertReport, out *incidents.AlertReport, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Incidents = in.Incidents
+	out.Acknowledged = in.Acknowledged
+	out.Recovered = in.Recovered
+	out.Downtime = in.Downtime
+	out.Availability = in.Availability
+	out.MeanTimeToAcknowledge = (*v1.Duration)(unsafe.Pointer(in.MeanTimeToAcknowledge))
+	out.MeanTimeToRecover = (*v1.Duration)(unsafe.Pointer(in.MeanTimeToRecover))
+	return nil
+}
+
+// Convert_v1alpha1_AlertReport_To_incidents_AlertReport is an autogenerated conversion function.
+func Convert_v1alpha1_AlertReport_To_incidents_AlertReport(in *AlertReport, out *incidents.AlertReport, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertReport_To_incidents_AlertReport(in, out, s)
+}
+
+func autoConvert_incidents_AlertReport_To_v1alpha1_AlertReport(in *incidents.AlertReport, out *AlertReport, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Incidents = in.Incidents
+	out.Acknowledged = in.Acknowledged
+	out.Recovered = in.Recovered
+	out.Downtime = in.Downtime
+	out.Availability = in.Availability
+	out.MeanTimeToAcknowledge = (*v1.Duration)(unsafe.Pointer(in.MeanTimeToAcknowledge))
+	out.MeanTimeToRecover = (*v1.Duration)(unsafe.Pointer(in.MeanTimeToRecover))
+	return nil
+}
+
+// Convert_incidents_AlertReport_To_v1alpha1_AlertReport is an autogenerated conversion function.
+func Convert_incidents_AlertReport_To_v1alpha1_AlertReport(in *incidents.AlertReport, out *AlertReport, s conversion.Scope) error {
+	return autoConvert_incidents_AlertReport_To_v1alpha1_AlertReport(in, out, s)
+}
+
+func autoConvert_v1alpha1_Assignment_To_incidents_Assignment(in *Assignment, out *incidents.Assignment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_AssignmentRequest_To_incidents_AssignmentRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AssignmentResponse_To_incidents_AssignmentResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Assignment_To_incidents_Assignment is an autogenerated conversion function.
+func Convert_v1alpha1_Assignment_To_incidents_Assignment(in *Assignment, out *incidents.Assignment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Assignment_To_incidents_Assignment(in, out, s)
+}
+
+func autoConvert_incidents_Assignment_To_v1alpha1_Assignment(in *incidents.Assignment, out *Assignment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_incidents_AssignmentRequest_To_v1alpha1_AssignmentRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_incidents_AssignmentResponse_To_v1alpha1_AssignmentResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_incidents_Assignment_To_v1alpha1_Assignment is an autogenerated conversion function.
+func Convert_incidents_Assignment_To_v1alpha1_Assignment(in *incidents.Assignment, out *Assignment, s conversion.Scope) error {
+	return autoConvert_incidents_Assignment_To_v1alpha1_Assignment(in, out, s)
+}
+
+func autoConvert_v1alpha1_AssignmentRequest_To_incidents_AssignmentRequest(in *AssignmentRequest, out *incidents.AssignmentRequest, s conversion.Scope) error {
+	out.Assignee = in.Assignee
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_v1alpha1_AssignmentRequest_To_incidents_AssignmentRequest is an autogenerated conversion function.
+func Convert_v1alpha1_AssignmentRequest_To_incidents_AssignmentRequest(in *AssignmentRequest, out *incidents.AssignmentRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AssignmentRequest_To_incidents_AssignmentRequest(in, out, s)
+}
+
+func autoConvert_incidents_AssignmentRequest_To_v1alpha1_AssignmentRequest(in *incidents.AssignmentRequest, out *AssignmentRequest, s conversion.Scope) error {
+	out.Assignee = in.Assignee
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_incidents_AssignmentRequest_To_v1alpha1_AssignmentRequest is an autogenerated conversion function.
+func Convert_incidents_AssignmentRequest_To_v1alpha1_AssignmentRequest(in *incidents.AssignmentRequest, out *AssignmentRequest, s conversion.Scope) error {
+	return autoConvert_incidents_AssignmentRequest_To_v1alpha1_AssignmentRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_AssignmentResponse_To_incidents_AssignmentResponse(in *AssignmentResponse, out *incidents.AssignmentResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	return nil
+}
+
+// Convert_v1alpha1_AssignmentResponse_To_incidents_AssignmentResponse is an autogenerated conversion function.
+func Convert_v1alpha1_AssignmentResponse_To_incidents_AssignmentResponse(in *AssignmentResponse, out *incidents.AssignmentResponse, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AssignmentResponse_To_incidents_AssignmentResponse(in, out, s)
+}
+
+func autoConvert_incidents_AssignmentResponse_To_v1alpha1_AssignmentResponse(in *incidents.AssignmentResponse, out *AssignmentResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	return nil
+}
+
+// Convert_incidents_AssignmentResponse_To_v1alpha1_AssignmentResponse is an autogenerated conversion function.
+func Convert_incidents_AssignmentResponse_To_v1alpha1_AssignmentResponse(in *incidents.AssignmentResponse, out *AssignmentResponse, s conversion.Scope) error {
+	return autoConvert_incidents_AssignmentResponse_To_v1alpha1_AssignmentResponse(in, out, s)
+}
+
+func autoConvert_v1alpha1_Comment_To_incidents_Comment(in *Comment, out *incidents.Comment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_CommentRequest_To_incidents_CommentRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_CommentResponse_To_incidents_CommentResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Comment_To_incidents_Comment is an autogenerated conversion function.
+func Convert_v1alpha1_Comment_To_incidents_Comment(in *Comment, out *incidents.Comment, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Comment_To_incidents_Comment(in, out, s)
+}
+
+func autoConvert_incidents_Comment_To_v1alpha1_Comment(in *incidents.Comment, out *Comment, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_incidents_CommentRequest_To_v1alpha1_CommentRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_incidents_CommentResponse_To_v1alpha1_CommentResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_incidents_Comment_To_v1alpha1_Comment is an autogenerated conversion function.
+func Convert_incidents_Comment_To_v1alpha1_Comment(in *incidents.Comment, out *Comment, s conversion.Scope) error {
+	return autoConvert_incidents_Comment_To_v1alpha1_Comment(in, out, s)
+}
+
+func autoConvert_v1alpha1_CommentRequest_To_incidents_CommentRequest(in *CommentRequest, out *incidents.CommentRequest, s conversion.Scope) error {
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_v1alpha1_CommentRequest_To_incidents_CommentRequest is an autogenerated conversion function.
+func Convert_v1alpha1_CommentRequest_To_incidents_CommentRequest(in *CommentRequest, out *incidents.CommentRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CommentRequest_To_incidents_CommentRequest(in, out, s)
+}
+
+func autoConvert_incidents_CommentRequest_To_v1alpha1_CommentRequest(in *incidents.CommentRequest, out *CommentRequest, s conversion.Scope) error {
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_incidents_CommentRequest_To_v1alpha1_CommentRequest is an autogenerated conversion function.
+func Convert_incidents_CommentRequest_To_v1alpha1_CommentRequest(in *incidents.CommentRequest, out *CommentRequest, s conversion.Scope) error {
+	return autoConvert_incidents_CommentRequest_To_v1alpha1_CommentRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_CommentResponse_To_incidents_CommentResponse(in *CommentResponse, out *incidents.CommentResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	return nil
+}
+
+// Convert_v1alpha1_CommentResponse_To_incidents_CommentResponse is an autogenerated conversion function.
+func Convert_v1alpha1_CommentResponse_To_incidents_CommentResponse(in *CommentResponse, out *incidents.CommentResponse, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CommentResponse_To_incidents_CommentResponse(in, out, s)
+}
+
+func autoConvert_incidents_CommentResponse_To_v1alpha1_CommentResponse(in *incidents.CommentResponse, out *CommentResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	return nil
+}
+
+// Convert_incidents_CommentResponse_To_v1alpha1_CommentResponse is an autogenerated conversion function.
+func Convert_incidents_CommentResponse_To_v1alpha1_CommentResponse(in *incidents.CommentResponse, out *CommentResponse, s conversion.Scope) error {
+	return autoConvert_incidents_CommentResponse_To_v1alpha1_CommentResponse(in, out, s)
+}
+
+func autoConvert_v1alpha1_Escalation_To_incidents_Escalation(in *Escalation, out *incidents.Escalation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_EscalationRequest_To_incidents_EscalationRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_EscalationResponse_To_incidents_EscalationResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Escalation_To_incidents_Escalation is an autogenerated conversion function.
+func Convert_v1alpha1_Escalation_To_incidents_Escalation(in *Escalation, out *incidents.Escalation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Escalation_To_incidents_Escalation(in, out, s)
+}
+
+func autoConvert_incidents_Escalation_To_v1alpha1_Escalation(in *incidents.Escalation, out *Escalation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_incidents_EscalationRequest_To_v1alpha1_EscalationRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_incidents_EscalationResponse_To_v1alpha1_EscalationResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_incidents_Escalation_To_v1alpha1_Escalation is an autogenerated conversion function.
+func Convert_incidents_Escalation_To_v1alpha1_Escalation(in *incidents.Escalation, out *Escalation, s conversion.Scope) error {
+	return autoConvert_incidents_Escalation_To_v1alpha1_Escalation(in, out, s)
+}
+
+func autoConvert_v1alpha1_EscalationRequest_To_incidents_EscalationRequest(in *EscalationRequest, out *incidents.EscalationRequest, s conversion.Scope) error {
+	out.Comment = in.Comment
+	out.EscalateAfter = (*v1.Duration)(unsafe.Pointer(in.EscalateAfter))
+	return nil
+}
+
+// Convert_v1alpha1_EscalationRequest_To_incidents_EscalationRequest is an autogenerated conversion function.
+func Convert_v1alpha1_EscalationRequest_To_incidents_EscalationRequest(in *EscalationRequest, out *incidents.EscalationRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EscalationRequest_To_incidents_EscalationRequest(in, out, s)
+}
+
+func autoConvert_incidents_EscalationRequest_To_v1alpha1_EscalationRequest(in *incidents.EscalationRequest, out *EscalationRequest, s conversion.Scope) error {
+	out.Comment = in.Comment
+	out.EscalateAfter = (*v1.Duration)(unsafe.Pointer(in.EscalateAfter))
+	return nil
+}
+
+// Convert_incidents_EscalationRequest_To_v1alpha1_EscalationRequest is an autogenerated conversion function.
+func Convert_incidents_EscalationRequest_To_v1alpha1_EscalationRequest(in *incidents.EscalationRequest, out *EscalationRequest, s conversion.Scope) error {
+	return autoConvert_incidents_EscalationRequest_To_v1alpha1_EscalationRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_EscalationResponse_To_incidents_EscalationResponse(in *EscalationResponse, out *incidents.EscalationResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	out.EscalateAfter = in.EscalateAfter
+	out.Receivers = *(*[]incidents.NotificationReceiver)(unsafe.Pointer(&in.Receivers))
+	return nil
+}
+
+// Convert_v1alpha1_EscalationResponse_To_incidents_EscalationResponse is an autogenerated conversion function.
+func Convert_v1alpha1_EscalationResponse_To_incidents_EscalationResponse(in *EscalationResponse, out *incidents.EscalationResponse, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EscalationResponse_To_incidents_EscalationResponse(in, out, s)
+}
+
+func autoConvert_incidents_EscalationResponse_To_v1alpha1_EscalationResponse(in *incidents.EscalationResponse, out *EscalationResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	out.EscalateAfter = in.EscalateAfter
+	out.Receivers = *(*[]NotificationReceiver)(unsafe.Pointer(&in.Receivers))
+	return nil
+}
+
+// Convert_incidents_EscalationResponse_To_v1alpha1_EscalationResponse is an autogenerated conversion function.
+func Convert_incidents_EscalationResponse_To_v1alpha1_EscalationResponse(in *incidents.EscalationResponse, out *EscalationResponse, s conversion.Scope) error {
+	return autoConvert_incidents_EscalationResponse_To_v1alpha1_EscalationResponse(in, out, s)
+}
+
+func autoConvert_v1alpha1_Notification_To_incidents_Notification(in *Notification, out *incidents.Notification, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NotificationRequest_To_incidents_NotificationRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_NotificationResponse_To_incidents_NotificationResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Notification_To_incidents_Notification is an autogenerated conversion function.
+func Convert_v1alpha1_Notification_To_incidents_Notification(in *Notification, out *incidents.Notification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Notification_To_incidents_Notification(in, out, s)
+}
+
+func autoConvert_incidents_Notification_To_v1alpha1_Notification(in *incidents.Notification, out *Notification, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_incidents_NotificationRequest_To_v1alpha1_NotificationRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_incidents_NotificationResponse_To_v1alpha1_NotificationResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_incidents_Notification_To_v1alpha1_Notification is an autogenerated conversion function.
+func Convert_incidents_Notification_To_v1alpha1_Notification(in *incidents.Notification, out *Notification, s conversion.Scope) error {
+	return autoConvert_incidents_Notification_To_v1alpha1_Notification(in, out, s)
+}
+
+func autoConvert_v1alpha1_NotificationReceiver_To_incidents_NotificationReceiver(in *NotificationReceiver, out *incidents.NotificationReceiver, s conversion.Scope) error {
+	out.Route = in.Route
+	out.Notifier = in.Notifier
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_v1alpha1_NotificationReceiver_To_incidents_NotificationReceiver is an autogenerated conversion function.
+func Convert_v1alpha1_NotificationReceiver_To_incidents_NotificationReceiver(in *NotificationReceiver, out *incidents.NotificationReceiver, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NotificationReceiver_To_incidents_NotificationReceiver(in, out, s)
+}
+
+func autoConvert_incidents_NotificationReceiver_To_v1alpha1_NotificationReceiver(in *incidents.NotificationReceiver, out *NotificationReceiver, s conversion.Scope) error {
+	out.Route = in.Route
+	out.Notifier = in.Notifier
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	return nil
+}
+
+// Convert_incidents_NotificationReceiver_To_v1alpha1_NotificationReceiver is an autogenerated conversion function.
+func Convert_incidents_NotificationReceiver_To_v1alpha1_NotificationReceiver(in *incidents.NotificationReceiver, out *NotificationReceiver, s conversion.Scope) error {
+	return autoConvert_incidents_NotificationReceiver_To_v1alpha1_NotificationReceiver(in, out, s)
+}
+
+func autoConvert_v1alpha1_NotificationRequest_To_incidents_NotificationRequest(in *NotificationRequest, out *incidents.NotificationRequest, s conversion.Scope) error {
+	out.Alert = in.Alert
+	out.Host = in.Host
+	out.Type = in.Type
+	out.State = in.State
+	out.Output = in.Output
+	out.Time = in.Time
+	out.Author = in.Author
+	out.Comment = in.Comment
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_v1alpha1_NotificationRequest_To_incidents_NotificationRequest is an autogenerated conversion function.
+func Convert_v1alpha1_NotificationRequest_To_incidents_NotificationRequest(in *NotificationRequest, out *incidents.NotificationRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NotificationRequest_To_incidents_NotificationRequest(in, out, s)
+}
+
+func autoConvert_incidents_NotificationRequest_To_v1alpha1_NotificationRequest(in *incidents.NotificationRequest, out *NotificationRequest, s conversion.Scope) error {
+	out.Alert = in.Alert
+	out.Host = in.Host
+	out.Type = in.Type
+	out.State = in.State
+	out.Output = in.Output
+	out.Time = in.Time
+	out.Author = in.Author
+	out.Comment = in.Comment
+	out.DryRun = in.DryRun
+	return nil
+}
+
+// Convert_incidents_NotificationRequest_To_v1alpha1_NotificationRequest is an autogenerated conversion function.
+func Convert_incidents_NotificationRequest_To_v1alpha1_NotificationRequest(in *incidents.NotificationRequest, out *NotificationRequest, s conversion.Scope) error {
+	return autoConvert_incidents_NotificationRequest_To_v1alpha1_NotificationRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_NotificationResponse_To_incidents_NotificationResponse(in *NotificationResponse, out *incidents.NotificationResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Receivers = *(*[]incidents.NotificationReceiver)(unsafe.Pointer(&in.Receivers))
+	out.SuppressedBy = in.SuppressedBy
+	out.DigestTargets = in.DigestTargets
+	return nil
+}
+
+// Convert_v1alpha1_NotificationResponse_To_incidents_NotificationResponse is an autogenerated conversion function.
+func Convert_v1alpha1_NotificationResponse_To_incidents_NotificationResponse(in *NotificationResponse, out *incidents.NotificationResponse, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NotificationResponse_To_incidents_NotificationResponse(in, out, s)
+}
+
+func autoConvert_incidents_NotificationResponse_To_v1alpha1_NotificationResponse(in *incidents.NotificationResponse, out *NotificationResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Receivers = *(*[]NotificationReceiver)(unsafe.Pointer(&in.Receivers))
+	out.SuppressedBy = in.SuppressedBy
+	out.DigestTargets = in.DigestTargets
+	return nil
+}
+
+// Convert_incidents_NotificationResponse_To_v1alpha1_NotificationResponse is an autogenerated conversion function.
+func Convert_incidents_NotificationResponse_To_v1alpha1_NotificationResponse(in *incidents.NotificationResponse, out *NotificationResponse, s conversion.Scope) error {
+	return autoConvert_incidents_NotificationResponse_To_v1alpha1_NotificationResponse(in, out, s)
+}
+
+func autoConvert_v1alpha1_Report_To_incidents_Report(in *Report, out *incidents.Report, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ReportRequest_To_incidents_ReportRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ReportResponse_To_incidents_ReportResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Report_To_incidents_Report is an autogenerated conversion function.
+func Convert_v1alpha1_Report_To_incidents_Report(in *Report, out *incidents.Report, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Report_To_incidents_Report(in, out, s)
+}
+
+func autoConvert_incidents_Report_To_v1alpha1_Report(in *incidents.Report, out *Report, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_incidents_ReportRequest_To_v1alpha1_ReportRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_incidents_ReportResponse_To_v1alpha1_ReportResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_incidents_Report_To_v1alpha1_Report is an autogenerated conversion function.
+func Convert_incidents_Report_To_v1alpha1_Report(in *incidents.Report, out *Report, s conversion.Scope) error {
+	return autoConvert_incidents_Report_To_v1alpha1_Report(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReportRequest_To_incidents_ReportRequest(in *ReportRequest, out *incidents.ReportRequest, s conversion.Scope) error {
+	out.From = in.From
+	out.To = (*v1.Time)(unsafe.Pointer(in.To))
+	out.Alerts = *(*[]string)(unsafe.Pointer(&in.Alerts))
+	return nil
+}
+
+// Convert_v1alpha1_ReportRequest_To_incidents_ReportRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ReportRequest_To_incidents_ReportRequest(in *ReportRequest, out *incidents.ReportRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReportRequest_To_incidents_ReportRequest(in, out, s)
+}
+
+func autoConvert_incidents_ReportRequest_To_v1alpha1_ReportRequest(in *incidents.ReportRequest, out *ReportRequest, s conversion.Scope) error {
+	out.From = in.From
+	out.To = (*v1.Time)(unsafe.Pointer(in.To))
+	out.Alerts = *(*[]string)(unsafe.Pointer(&in.Alerts))
+	return nil
+}
+
+// Convert_incidents_ReportRequest_To_v1alpha1_ReportRequest is an autogenerated conversion function.
+func Convert_incidents_ReportRequest_To_v1alpha1_ReportRequest(in *incidents.ReportRequest, out *ReportRequest, s conversion.Scope) error {
+	return autoConvert_incidents_ReportRequest_To_v1alpha1_ReportRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReportResponse_To_incidents_ReportResponse(in *ReportResponse, out *incidents.ReportResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.From = in.From
+	out.To = in.To
+	if err := Convert_v1alpha1_AlertReport_To_incidents_AlertReport(&in.Summary, &out.Summary, s); err != nil {
+		return err
+	}
+	out.Alerts = *(*[]incidents.AlertReport)(unsafe.Pointer(&in.Alerts))
+	return nil
+}
+
+// Convert_v1alpha1_ReportResponse_To_incidents_ReportResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ReportResponse_To_incidents_ReportResponse(in *ReportResponse, out *incidents.ReportResponse, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReportResponse_To_incidents_ReportResponse(in, out, s)
+}
+
+func autoConvert_incidents_ReportResponse_To_v1alpha1_ReportResponse(in *incidents.ReportResponse, out *ReportResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.From = in.From
+	out.To = in.To
+	if err := Convert_incidents_AlertReport_To_v1alpha1_AlertReport(&in.Summary, &out.Summary, s); err != nil {
+		return err
+	}
+	out.Alerts = *(*[]AlertReport)(unsafe.Pointer(&in.Alerts))
+	return nil
+}
+
+// Convert_incidents_ReportResponse_To_v1alpha1_ReportResponse is an autogenerated conversion function.
+func Convert_incidents_ReportResponse_To_v1alpha1_ReportResponse(in *incidents.ReportResponse, out *ReportResponse, s conversion.Scope) error {
+	return autoConvert_incidents_ReportResponse_To_v1alpha1_ReportResponse(in, out, s)
+}
+
+func autoConvert_v1alpha1_Resolution_To_incidents_Resolution(in *Resolution, out *incidents.Resolution, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ResolutionRequest_To_incidents_ResolutionRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ResolutionResponse_To_incidents_ResolutionResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Resolution_To_incidents_Resolution is an autogenerated conversion function.
+func Convert_v1alpha1_Resolution_To_incidents_Resolution(in *Resolution, out *incidents.Resolution, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Resolution_To_incidents_Resolution(in, out, s)
+}
+
+func autoConvert_incidents_Resolution_To_v1alpha1_Resolution(in *incidents.Resolution, out *Resolution, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_incidents_ResolutionRequest_To_v1alpha1_ResolutionRequest(&in.Request, &out.Request, s); err != nil {
+		return err
+	}
+	if err := Convert_incidents_ResolutionResponse_To_v1alpha1_ResolutionResponse(&in.Response, &out.Response, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_incidents_Resolution_To_v1alpha1_Resolution is an autogenerated conversion function.
+func Convert_incidents_Resolution_To_v1alpha1_Resolution(in *incidents.Resolution, out *Resolution, s conversion.Scope) error {
+	return autoConvert_incidents_Resolution_To_v1alpha1_Resolution(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResolutionRequest_To_incidents_ResolutionRequest(in *ResolutionRequest, out *incidents.ResolutionRequest, s conversion.Scope) error {
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_v1alpha1_ResolutionRequest_To_incidents_ResolutionRequest is an autogenerated conversion function.
+func Convert_v1alpha1_ResolutionRequest_To_incidents_ResolutionRequest(in *ResolutionRequest, out *incidents.ResolutionRequest, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolutionRequest_To_incidents_ResolutionRequest(in, out, s)
+}
+
+func autoConvert_incidents_ResolutionRequest_To_v1alpha1_ResolutionRequest(in *incidents.ResolutionRequest, out *ResolutionRequest, s conversion.Scope) error {
+	out.Comment = in.Comment
+	return nil
+}
+
+// Convert_incidents_ResolutionRequest_To_v1alpha1_ResolutionRequest is an autogenerated conversion function.
+func Convert_incidents_ResolutionRequest_To_v1alpha1_ResolutionRequest(in *incidents.ResolutionRequest, out *ResolutionRequest, s conversion.Scope) error {
+	return autoConvert_incidents_ResolutionRequest_To_v1alpha1_ResolutionRequest(in, out, s)
+}
+
+func autoConvert_v1alpha1_ResolutionResponse_To_incidents_ResolutionResponse(in *ResolutionResponse, out *incidents.ResolutionResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	return nil
+}
+
+// Convert_v1alpha1_ResolutionResponse_To_incidents_ResolutionResponse is an autogenerated conversion function.
+func Convert_v1alpha1_ResolutionResponse_To_incidents_ResolutionResponse(in *ResolutionResponse, out *incidents.ResolutionResponse, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolutionResponse_To_incidents_ResolutionResponse(in, out, s)
+}
+
+func autoConvert_incidents_ResolutionResponse_To_v1alpha1_ResolutionResponse(in *incidents.ResolutionResponse, out *ResolutionResponse, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Author = in.Author
+	return nil
+}
+
+// Convert_incidents_ResolutionResponse_To_v1alpha1_ResolutionResponse is an autogenerated conversion function.
+func Convert_incidents_ResolutionResponse_To_v1alpha1_ResolutionResponse(in *incidents.ResolutionResponse, out *ResolutionResponse, s conversion.Scope) error {
+	return autoConvert_incidents_ResolutionResponse_To_v1alpha1_ResolutionResponse(in, out, s)
+}
@@ -21,6 +21,7 @@ limitations under the License.
 package incidents
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,7 +30,7 @@ func (in *Acknowledgement) DeepCopyInto(out *Acknowledgement) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Request = in.Request
+	in.Request.DeepCopyInto(&out.Request)
 	in.Response.DeepCopyInto(&out.Response)
 	return
 }
@@ -52,9 +53,46 @@ func (in *Acknowledgement) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AcknowledgementList) DeepCopyInto(out *AcknowledgementList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Acknowledgement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AcknowledgementList.
+func (in *AcknowledgementList) DeepCopy() *AcknowledgementList {
+	if in == nil {
+		return nil
+	}
+	out := new(AcknowledgementList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AcknowledgementList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AcknowledgementRequest) DeepCopyInto(out *AcknowledgementRequest) {
 	*out = *in
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -84,3 +122,458 @@ func (in *AcknowledgementResponse) DeepCopy() *AcknowledgementResponse {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertReport) DeepCopyInto(out *AlertReport) {
+	*out = *in
+	out.Downtime = in.Downtime
+	if in.MeanTimeToAcknowledge != nil {
+		in, out := &in.MeanTimeToAcknowledge, &out.MeanTimeToAcknowledge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MeanTimeToRecover != nil {
+		in, out := &in.MeanTimeToRecover, &out.MeanTimeToRecover
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertReport.
+func (in *AlertReport) DeepCopy() *AlertReport {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Assignment) DeepCopyInto(out *Assignment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Request = in.Request
+	in.Response.DeepCopyInto(&out.Response)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Assignment.
+func (in *Assignment) DeepCopy() *Assignment {
+	if in == nil {
+		return nil
+	}
+	out := new(Assignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Assignment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssignmentRequest) DeepCopyInto(out *AssignmentRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssignmentRequest.
+func (in *AssignmentRequest) DeepCopy() *AssignmentRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(AssignmentRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssignmentResponse) DeepCopyInto(out *AssignmentResponse) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssignmentResponse.
+func (in *AssignmentResponse) DeepCopy() *AssignmentResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(AssignmentResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Comment) DeepCopyInto(out *Comment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Request = in.Request
+	in.Response.DeepCopyInto(&out.Response)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Comment.
+func (in *Comment) DeepCopy() *Comment {
+	if in == nil {
+		return nil
+	}
+	out := new(Comment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Comment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommentRequest) DeepCopyInto(out *CommentRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentRequest.
+func (in *CommentRequest) DeepCopy() *CommentRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(CommentRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CommentResponse) DeepCopyInto(out *CommentResponse) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CommentResponse.
+func (in *CommentResponse) DeepCopy() *CommentResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(CommentResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Escalation) DeepCopyInto(out *Escalation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Request.DeepCopyInto(&out.Request)
+	in.Response.DeepCopyInto(&out.Response)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Escalation.
+func (in *Escalation) DeepCopy() *Escalation {
+	if in == nil {
+		return nil
+	}
+	out := new(Escalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Escalation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationRequest) DeepCopyInto(out *EscalationRequest) {
+	*out = *in
+	if in.EscalateAfter != nil {
+		in, out := &in.EscalateAfter, &out.EscalateAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationRequest.
+func (in *EscalationRequest) DeepCopy() *EscalationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationResponse) DeepCopyInto(out *EscalationResponse) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	out.EscalateAfter = in.EscalateAfter
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]NotificationReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationResponse.
+func (in *EscalationResponse) DeepCopy() *EscalationResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Notification) DeepCopyInto(out *Notification) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Request.DeepCopyInto(&out.Request)
+	in.Response.DeepCopyInto(&out.Response)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Notification.
+func (in *Notification) DeepCopy() *Notification {
+	if in == nil {
+		return nil
+	}
+	out := new(Notification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Notification) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationReceiver) DeepCopyInto(out *NotificationReceiver) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationReceiver.
+func (in *NotificationReceiver) DeepCopy() *NotificationReceiver {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationReceiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRequest) DeepCopyInto(out *NotificationRequest) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRequest.
+func (in *NotificationRequest) DeepCopy() *NotificationRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationResponse) DeepCopyInto(out *NotificationResponse) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]NotificationReceiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationResponse.
+func (in *NotificationResponse) DeepCopy() *NotificationResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Report) DeepCopyInto(out *Report) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Request.DeepCopyInto(&out.Request)
+	in.Response.DeepCopyInto(&out.Response)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Report.
+func (in *Report) DeepCopy() *Report {
+	if in == nil {
+		return nil
+	}
+	out := new(Report)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Report) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportRequest) DeepCopyInto(out *ReportRequest) {
+	*out = *in
+	in.From.DeepCopyInto(&out.From)
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = (*in).DeepCopy()
+	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportRequest.
+func (in *ReportRequest) DeepCopy() *ReportRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReportResponse) DeepCopyInto(out *ReportResponse) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	in.From.DeepCopyInto(&out.From)
+	in.To.DeepCopyInto(&out.To)
+	in.Summary.DeepCopyInto(&out.Summary)
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]AlertReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReportResponse.
+func (in *ReportResponse) DeepCopy() *ReportResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ReportResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Resolution) DeepCopyInto(out *Resolution) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Request = in.Request
+	in.Response.DeepCopyInto(&out.Response)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Resolution.
+func (in *Resolution) DeepCopy() *Resolution {
+	if in == nil {
+		return nil
+	}
+	out := new(Resolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Resolution) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolutionRequest) DeepCopyInto(out *ResolutionRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolutionRequest.
+func (in *ResolutionRequest) DeepCopy() *ResolutionRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolutionRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolutionResponse) DeepCopyInto(out *ResolutionResponse) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolutionResponse.
+func (in *ResolutionResponse) DeepCopy() *ResolutionResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolutionResponse)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,5 @@
+// +k8s:deepcopy-gen=package
+
+// Package monitoring is the internal version of the API.
+// +groupName=monitoring.appscode.com
+package monitoring
@@ -0,0 +1,194 @@
+package monitoring
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterAlert struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   ClusterAlertSpec
+	Status AlertStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterAlertList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []ClusterAlert
+}
+
+type ClusterAlertSpec struct {
+	TemplateRef           *core.LocalObjectReference
+	Check                 string
+	CheckInterval         metav1.Duration
+	AlertInterval         metav1.Duration
+	MaxCheckAttempts      int32
+	RetryInterval         metav1.Duration
+	EnableFlapping        bool
+	FlappingThresholdLow  int32
+	FlappingThresholdHigh int32
+	NotificationDelay     metav1.Duration
+	NotifierSecretName    string
+	Receivers             []Receiver
+	Vars                  map[string]string
+	Paused                bool
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NodeAlert struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   NodeAlertSpec
+	Status AlertStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NodeAlertList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []NodeAlert
+}
+
+type NodeAlertSpec struct {
+	// Nil selector matches all nodes
+	Selector              *metav1.LabelSelector
+	NodeName              *string
+	TemplateRef           *core.LocalObjectReference
+	Check                 string
+	CheckInterval         metav1.Duration
+	AlertInterval         metav1.Duration
+	MaxCheckAttempts      int32
+	RetryInterval         metav1.Duration
+	EnableFlapping        bool
+	FlappingThresholdLow  int32
+	FlappingThresholdHigh int32
+	NotificationDelay     metav1.Duration
+	Grouping              *NotificationGrouping
+	NotifierSecretName    string
+	Receivers             []Receiver
+	Vars                  map[string]string
+	DependsOn             []AlertDependency
+	Paused                bool
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PodAlert struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	Spec   PodAlertSpec
+	Status AlertStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PodAlertList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []PodAlert
+}
+
+type PodAlertSpec struct {
+	Selector              *metav1.LabelSelector
+	PodName               *string
+	NamespaceSelector     *metav1.LabelSelector
+	TemplateRef           *core.LocalObjectReference
+	Check                 string
+	CheckInterval         metav1.Duration
+	AlertInterval         metav1.Duration
+	MaxCheckAttempts      int32
+	RetryInterval         metav1.Duration
+	EnableFlapping        bool
+	FlappingThresholdLow  int32
+	FlappingThresholdHigh int32
+	NotificationDelay     metav1.Duration
+	Grouping              *NotificationGrouping
+	NotifierSecretName    string
+	Receivers             []Receiver
+	Vars                  map[string]string
+	DependsOn             []AlertDependency
+	Paused                bool
+}
+
+type IcingaState string
+
+type Receiver struct {
+	State          IcingaState
+	To             []string
+	Notifier       string
+	OnCallSchedule string
+	EscalateAfter  *metav1.Duration
+	SecretRef      *LocalSecretReference
+}
+
+type LocalSecretReference struct {
+	Name string
+}
+
+type AlertTemplateReference struct {
+	Name string
+}
+
+type AlertDependency struct {
+	Kind string
+	Name string
+}
+
+type NotificationGrouping struct {
+	By        []string
+	GroupWait metav1.Duration
+}
+
+type AlertStatus struct {
+	ObservedGeneration int64
+	Conditions         []AlertCondition
+	MatchedTargets     int32
+	Targets            []TargetStatus
+	NotificationGroups []NotificationGroup
+}
+
+type AlertConditionType string
+
+type AlertCondition struct {
+	Type               AlertConditionType
+	Status             core.ConditionStatus
+	LastTransitionTime metav1.Time
+	Reason             string
+	Message            string
+}
+
+type TargetStatus struct {
+	Name            string
+	State           string
+	LastCheckOutput string
+	LastCheckTime   *metav1.Time
+}
+
+type NotificationGroup struct {
+	Labels            map[string]string
+	FirstTimestamp    metav1.Time
+	NotifiedTimestamp *metav1.Time
+	NotifiedStates    []string
+	Targets           []GroupedTarget
+}
+
+type GroupedTarget struct {
+	Name          string
+	State         string
+	CheckOutput   string
+	LastTimestamp metav1.Time
+	Notified      bool
+}
@@ -0,0 +1,143 @@
+package v1alpha1
+
+import (
+	"fmt"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindAlertTemplate     = "AlertTemplate"
+	ResourcePluralAlertTemplate   = "alerttemplates"
+	ResourceSingularAlertTemplate = "alerttemplate"
+)
+
+// +genclient
+// +genclient:skipVerbs=updateStatus
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertTemplate holds defaults for ClusterAlerts, NodeAlerts and PodAlerts in its namespace that
+// reference it via templateRef. Fields set in an alert override the ones in its template.
+type AlertTemplate struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the AlertTemplate.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec AlertTemplateSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AlertTemplateList is a collection of AlertTemplate.
+type AlertTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of AlertTemplate.
+	Items []AlertTemplate `json:"items"`
+}
+
+// AlertTemplateSpec describes the defaults of alerts that reference the AlertTemplate.
+type AlertTemplateSpec struct {
+	// Icinga CheckCommand name
+	Check string `json:"check,omitempty"`
+
+	// How frequently Icinga Service will be checked
+	CheckInterval metav1.Duration `json:"checkInterval,omitempty"`
+
+	// How frequently notifications will be send
+	AlertInterval metav1.Duration `json:"alertInterval,omitempty"`
+
+	// Secret containing notifier credentials
+	NotifierSecretName string `json:"notifierSecretName,omitempty"`
+
+	// Receivers are used by alerts that have no receivers of their own
+	Receivers []Receiver `json:"receivers,omitempty"`
+
+	// Vars contains Icinga Service variables to be used in CheckCommand.
+	// Vars of an alert are merged with these, vars of the alert take precedence.
+	Vars map[string]string `json:"vars,omitempty"`
+}
+
+// TemplatedAlert is an Alert that can take its defaults from an AlertTemplate.
+type TemplatedAlert interface {
+	Alert
+	GetTemplateRef() *AlertTemplateReference
+	SetTemplateDefaults(t *AlertTemplate)
+}
+
+func (t AlertTemplate) IsValid() error {
+	if t.Spec.Check != "" {
+		_, isCluster := ClusterCommands.Get(t.Spec.Check)
+		_, isNode := NodeCommands.Get(t.Spec.Check)
+		_, isPod := PodCommands.Get(t.Spec.Check)
+		if !isCluster && !isNode && !isPod {
+			return fmt.Errorf("%s is not a valid check command", t.Spec.Check)
+		}
+	}
+	if t.Spec.CheckInterval.Duration < 0 {
+		return fmt.Errorf("checkInterval can't be negative")
+	}
+	if t.Spec.AlertInterval.Duration < 0 {
+		return fmt.Errorf("alertInterval can't be negative")
+	}
+	for _, rcv := range t.Spec.Receivers {
+		if rcv.Notifier == "" {
+			return fmt.Errorf("notifier of receiver for state %s is empty", rcv.State)
+		}
+		if rcv.EscalateAfter != nil && rcv.EscalateAfter.Duration < 0 {
+			return fmt.Errorf("escalateAfter of receiver for state %s can't be negative", rcv.State)
+		}
+	}
+	return nil
+}
+
+// setDefaults sets the fields of an alert that are not set from this template.
+func (t AlertTemplate) setDefaults(check *string, checkInterval, alertInterval *metav1.Duration, notifierSecretName *string, receivers *[]Receiver, vars *map[string]string) {
+	if *check == "" {
+		*check = t.Spec.Check
+	}
+	if checkInterval.Duration == 0 {
+		*checkInterval = t.Spec.CheckInterval
+	}
+	if alertInterval.Duration == 0 {
+		*alertInterval = t.Spec.AlertInterval
+	}
+	if *notifierSecretName == "" {
+		*notifierSecretName = t.Spec.NotifierSecretName
+	}
+	if len(*receivers) == 0 && len(t.Spec.Receivers) > 0 {
+		*receivers = make([]Receiver, len(t.Spec.Receivers))
+		for i := range t.Spec.Receivers {
+			t.Spec.Receivers[i].DeepCopyInto(&(*receivers)[i])
+		}
+	}
+	if len(t.Spec.Vars) > 0 {
+		merged := make(map[string]string, len(t.Spec.Vars)+len(*vars))
+		for k, v := range t.Spec.Vars {
+			merged[k] = v
+		}
+		for k, v := range *vars {
+			merged[k] = v
+		}
+		*vars = merged
+	}
+}
+
+func (t AlertTemplate) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindAlertTemplate,
+		Namespace:       t.Namespace,
+		Name:            t.Name,
+		UID:             t.UID,
+		ResourceVersion: t.ResourceVersion,
+	}
+}
@@ -0,0 +1,61 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetTemplateDefaults(t *testing.T) {
+	tpl := &AlertTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: "demo"},
+		Spec: AlertTemplateSpec{
+			Check:              CheckPodStatus,
+			CheckInterval:      metav1.Duration{Duration: time.Minute},
+			AlertInterval:      metav1.Duration{Duration: 5 * time.Minute},
+			NotifierSecretName: "notifier-config",
+			Receivers: []Receiver{
+				{State: "Critical", To: []string{"oncall@example.com"}, Notifier: "Mailgun"},
+			},
+			Vars: map[string]string{
+				"warning":  "70",
+				"critical": "95",
+			},
+		},
+	}
+
+	alert := &PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-status", Namespace: "demo"},
+		Spec: PodAlertSpec{
+			TemplateRef:   &AlertTemplateReference{Name: "payments"},
+			CheckInterval: metav1.Duration{Duration: 30 * time.Second},
+			Vars: map[string]string{
+				"critical": "90",
+			},
+		},
+	}
+	alert.SetTemplateDefaults(tpl)
+
+	assert.Equal(t, CheckPodStatus, alert.Spec.Check)
+	assert.Equal(t, 30*time.Second, alert.Spec.CheckInterval.Duration)
+	assert.Equal(t, 5*time.Minute, alert.Spec.AlertInterval.Duration)
+	assert.Equal(t, "notifier-config", alert.GetNotifierSecretName())
+	assert.Equal(t, tpl.Spec.Receivers, alert.GetReceivers())
+	assert.Equal(t, map[string]string{"warning": "70", "critical": "90"}, alert.Spec.Vars)
+
+	// receivers of alert are not merged with the ones of template
+	alert.Spec.Receivers = []Receiver{{State: "Warning", To: []string{"team@example.com"}, Notifier: "Mailgun"}}
+	alert.SetTemplateDefaults(tpl)
+	assert.Len(t, alert.GetReceivers(), 1)
+	assert.Equal(t, "Warning", alert.GetReceivers()[0].State)
+
+	// template is not modified by alerts that use it
+	alert.Spec.Receivers = nil
+	alert.SetTemplateDefaults(tpl)
+	alert.Spec.Receivers[0].To[0] = "changed@example.com"
+	alert.Spec.Vars["warning"] = "50"
+	assert.Equal(t, "oncall@example.com", tpl.Spec.Receivers[0].To[0])
+	assert.Equal(t, "70", tpl.Spec.Vars["warning"])
+}
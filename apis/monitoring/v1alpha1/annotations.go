@@ -2,4 +2,7 @@ package v1alpha1
 
 const (
 	AnnotationKeyAlerts = "monitoring.appscode.com/alerts"
+
+	// NodeAlert selector set via v1beta1 API that can't be represented in v1alpha1
+	AnnotationKeyNodeSelector = "monitoring.appscode.com/node-selector"
 )
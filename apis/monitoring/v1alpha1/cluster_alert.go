@@ -17,7 +17,6 @@ const (
 )
 
 // +genclient
-// +genclient:skipVerbs=updateStatus
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -30,6 +29,11 @@ type ClusterAlert struct {
 	// Spec is the desired state of the ClusterAlert.
 	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
 	Spec ClusterAlertSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the ClusterAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -47,6 +51,9 @@ type ClusterAlertList struct {
 
 // ClusterAlertSpec describes the ClusterAlert the user wishes to create.
 type ClusterAlertSpec struct {
+	// Name of AlertTemplate in the namespace of this alert that holds defaults for this alert
+	TemplateRef *AlertTemplateReference `json:"templateRef,omitempty"`
+
 	// Icinga CheckCommand name
 	Check string `json:"check,omitempty"`
 
@@ -56,6 +63,25 @@ type ClusterAlertSpec struct {
 	// How frequently notifications will be send
 	AlertInterval metav1.Duration `json:"alertInterval,omitempty"`
 
+	// Number of times Icinga Service is checked in a soft problem state before it changes to a hard
+	// state and notifications are sent
+	MaxCheckAttempts int32 `json:"maxCheckAttempts,omitempty"`
+
+	// How frequently Icinga Service will be checked while it is in a soft problem state
+	RetryInterval metav1.Duration `json:"retryInterval,omitempty"`
+
+	// Indicates that Icinga detects flapping of the service state
+	EnableFlapping bool `json:"enableFlapping,omitempty"`
+
+	// Flapping ends when the percentage of state changes falls below this threshold
+	FlappingThresholdLow int32 `json:"flappingThresholdLow,omitempty"`
+
+	// Flapping starts when the percentage of state changes rises above this threshold
+	FlappingThresholdHigh int32 `json:"flappingThresholdHigh,omitempty"`
+
+	// How long to wait before the first notification of a problem is sent
+	NotificationDelay metav1.Duration `json:"notificationDelay,omitempty"`
+
 	// Secret containing notifier credentials
 	NotifierSecretName string `json:"notifierSecretName,omitempty"`
 
@@ -71,7 +97,7 @@ type ClusterAlertSpec struct {
 	Paused bool `json:"paused,omitempty"`
 }
 
-var _ Alert = &ClusterAlert{}
+var _ TemplatedAlert = &ClusterAlert{}
 
 func (a ClusterAlert) GetName() string {
 	return a.Name
@@ -93,6 +119,14 @@ func (a ClusterAlert) GetAlertInterval() time.Duration {
 	return a.Spec.AlertInterval.Duration
 }
 
+func (a ClusterAlert) GetTemplateRef() *AlertTemplateReference {
+	return a.Spec.TemplateRef
+}
+
+func (a *ClusterAlert) SetTemplateDefaults(t *AlertTemplate) {
+	t.setDefaults(&a.Spec.Check, &a.Spec.CheckInterval, &a.Spec.AlertInterval, &a.Spec.NotifierSecretName, &a.Spec.Receivers, &a.Spec.Vars)
+}
+
 func (a ClusterAlert) IsValid(kc kubernetes.Interface) error {
 	if a.Spec.Paused {
 		return nil
@@ -118,6 +152,13 @@ func (a ClusterAlert) IsValid(kc kubernetes.Interface) error {
 		if !found {
 			return fmt.Errorf("state '%s' is unsupported for check command %s", rcv.State, a.Spec.Check)
 		}
+		if rcv.EscalateAfter != nil && rcv.EscalateAfter.Duration < 0 {
+			return fmt.Errorf("escalateAfter of receiver for state '%s' can't be negative", rcv.State)
+		}
+	}
+
+	if err := validateCheckAttempts(a.Spec.MaxCheckAttempts, a.Spec.RetryInterval, a.Spec.FlappingThresholdLow, a.Spec.FlappingThresholdHigh, a.Spec.NotificationDelay); err != nil {
+		return err
 	}
 
 	return checkNotifiers(kc, a)
@@ -0,0 +1,153 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/appscode/searchlight/apis/monitoring"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+)
+
+// Check commands were named with underscores before Searchlight 6.0.0
+var legacyCheckCommands = map[string]string{
+	"pod_status":       CheckPodStatus,
+	"pod_volume":       CheckPodVolume,
+	"pod_exec":         CheckPodExec,
+	"node_volume":      CheckNodeVolume,
+	"node_status":      CheckNodeStatus,
+	"component_status": CheckComponentStatus,
+	"json_path":        CheckJsonPath,
+	"node_exists":      CheckNodeExists,
+	"pod_exists":       CheckPodExists,
+	"ca_cert":          CheckCACert,
+}
+
+func upgradeCheckCommand(check string) string {
+	if cmd, found := legacyCheckCommands[check]; found {
+		return cmd
+	}
+	return check
+}
+
+func Convert_v1alpha1_ClusterAlertSpec_To_monitoring_ClusterAlertSpec(in *ClusterAlertSpec, out *monitoring.ClusterAlertSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_ClusterAlertSpec_To_monitoring_ClusterAlertSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Check = upgradeCheckCommand(in.Check)
+	return nil
+}
+
+func Convert_v1alpha1_PodAlertSpec_To_monitoring_PodAlertSpec(in *PodAlertSpec, out *monitoring.PodAlertSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_PodAlertSpec_To_monitoring_PodAlertSpec(in, out, s); err != nil {
+		return err
+	}
+	out.Check = upgradeCheckCommand(in.Check)
+	return nil
+}
+
+func Convert_v1alpha1_NodeAlertSpec_To_monitoring_NodeAlertSpec(in *NodeAlertSpec, out *monitoring.NodeAlertSpec, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_NodeAlertSpec_To_monitoring_NodeAlertSpec(in, out, s); err != nil {
+		return err
+	}
+	if len(in.Selector) > 0 {
+		out.Selector = &metav1.LabelSelector{
+			MatchLabels: in.Selector,
+		}
+	} else {
+		out.Selector = nil
+	}
+	out.Check = upgradeCheckCommand(in.Check)
+
+	// mountpoint variable of node-volume check was renamed to mountPoint in Searchlight 6.0.0
+	if out.Check == CheckNodeVolume {
+		if mp, found := in.Vars["mountpoint"]; found {
+			vars := make(map[string]string, len(in.Vars))
+			for k, v := range in.Vars {
+				vars[k] = v
+			}
+			delete(vars, "mountpoint")
+			vars["mountPoint"] = mp
+			out.Vars = vars
+		}
+	}
+	return nil
+}
+
+// Selectors that can't be expressed as map[string]string are only preserved in the
+// AnnotationKeyNodeSelector annotation of v1alpha1 NodeAlert.
+func Convert_monitoring_NodeAlertSpec_To_v1alpha1_NodeAlertSpec(in *monitoring.NodeAlertSpec, out *NodeAlertSpec, s conversion.Scope) error {
+	if err := autoConvert_monitoring_NodeAlertSpec_To_v1alpha1_NodeAlertSpec(in, out, s); err != nil {
+		return err
+	}
+	if in.Selector != nil {
+		out.Selector = in.Selector.MatchLabels
+	} else {
+		out.Selector = nil
+	}
+	return nil
+}
+
+func Convert_v1alpha1_NodeAlert_To_monitoring_NodeAlert(in *NodeAlert, out *monitoring.NodeAlert, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_NodeAlert_To_monitoring_NodeAlert(in, out, s); err != nil {
+		return err
+	}
+	if data, found := in.Annotations[AnnotationKeyNodeSelector]; found {
+		var selector metav1.LabelSelector
+		if err := json.Unmarshal([]byte(data), &selector); err != nil {
+			return err
+		}
+		out.Spec.Selector = &selector
+		out.Annotations = removeAnnotation(in.Annotations, AnnotationKeyNodeSelector)
+	}
+	return nil
+}
+
+func Convert_monitoring_NodeAlert_To_v1alpha1_NodeAlert(in *monitoring.NodeAlert, out *NodeAlert, s conversion.Scope) error {
+	if err := autoConvert_monitoring_NodeAlert_To_v1alpha1_NodeAlert(in, out, s); err != nil {
+		return err
+	}
+	out.Annotations = removeAnnotation(in.Annotations, AnnotationKeyNodeSelector)
+	if sel := in.Spec.Selector; sel != nil && (len(sel.MatchExpressions) > 0 || len(sel.MatchLabels) == 0) {
+		data, err := json.Marshal(sel)
+		if err != nil {
+			return err
+		}
+		annotations := make(map[string]string, len(out.Annotations)+1)
+		for k, v := range out.Annotations {
+			annotations[k] = v
+		}
+		annotations[AnnotationKeyNodeSelector] = string(data)
+		out.Annotations = annotations
+	}
+	return nil
+}
+
+// removeAnnotation returns a copy of annotations without key. Input map is not modified,
+// since it is shared with the object being converted.
+func removeAnnotation(annotations map[string]string, key string) map[string]string {
+	if _, found := annotations[key]; !found {
+		return annotations
+	}
+	out := make(map[string]string, len(annotations))
+	for k, v := range annotations {
+		if k != key {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Receiver states were matched case-insensitively before Searchlight 6.0.0
+func Convert_v1alpha1_Receiver_To_monitoring_Receiver(in *Receiver, out *monitoring.Receiver, s conversion.Scope) error {
+	if err := autoConvert_v1alpha1_Receiver_To_monitoring_Receiver(in, out, s); err != nil {
+		return err
+	}
+	for _, state := range icingaStates {
+		if strings.EqualFold(in.State, state) {
+			out.State = monitoring.IcingaState(state)
+			break
+		}
+	}
+	return nil
+}
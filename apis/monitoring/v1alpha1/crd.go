@@ -1,16 +1,31 @@
 package v1alpha1
 
 import (
+	"github.com/appscode/go/types"
+	"github.com/appscode/searchlight/apis/monitoring/v1beta1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	crdutils "kmodules.xyz/client-go/apiextensions/v1beta1"
 )
 
 var (
 	EnableStatusSubresource bool
+	EnableConversionWebhook bool
+	// Validate values of maps in crds. additionalProperties is only allowed in crd validation since Kubernetes 1.11,
+	// independent of status subresource being used.
+	EnableAdditionalProperties bool
+	// Notifier Secret of Searchlight operator, used by receivers without any notifier Secret
+	DefaultNotifierSecret *NotifierSecretReference
+	// Namespace of Searchlight operator. Only PodAlerts in this namespace can select pods of other namespaces.
+	OperatorNamespace string
+)
+
+const (
+	ConversionWebhookGroup = "conversion.monitoring.appscode.com"
+	ConversionWebhookPath  = "/apis/" + ConversionWebhookGroup + "/v1alpha1/conversionreviews"
 )
 
 func (a ClusterAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
-	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
 		Group:         SchemeGroupVersion.Group,
 		Plural:        ResourcePluralClusterAlert,
 		Singular:      ResourceSingularClusterAlert,
@@ -38,11 +53,21 @@ func (a ClusterAlert) CustomResourceDefinition() *apiextensions.CustomResourceDe
 				Type:     "string",
 				JSONPath: ".spec.check",
 			},
+			{
+				Name:     "Interval",
+				Type:     "string",
+				JSONPath: ".spec.checkInterval",
+			},
 			{
 				Name:     "Paused",
 				Type:     "boolean",
 				JSONPath: ".spec.paused",
 			},
+			{
+				Name:     "State",
+				Type:     "string",
+				JSONPath: ".status.targets[0].state",
+			},
 			{
 				Name:     "Age",
 				Type:     "date",
@@ -50,10 +75,12 @@ func (a ClusterAlert) CustomResourceDefinition() *apiextensions.CustomResourceDe
 			},
 		},
 	})
+	crd.Spec.Validation = templatedAlertSchema(crd.Spec.Validation)
+	return withConversionWebhook(crd, "github.com/appscode/searchlight/apis/monitoring/v1beta1.ClusterAlert")
 }
 
 func (a NodeAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
-	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
 		Group:         SchemeGroupVersion.Group,
 		Plural:        ResourcePluralNodeAlert,
 		Singular:      ResourceSingularNodeAlert,
@@ -81,11 +108,21 @@ func (a NodeAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefin
 				Type:     "string",
 				JSONPath: ".spec.check",
 			},
+			{
+				Name:     "Interval",
+				Type:     "string",
+				JSONPath: ".spec.checkInterval",
+			},
 			{
 				Name:     "Paused",
 				Type:     "boolean",
 				JSONPath: ".spec.paused",
 			},
+			{
+				Name:     "Targets",
+				Type:     "integer",
+				JSONPath: ".status.matchedTargets",
+			},
 			{
 				Name:     "Age",
 				Type:     "date",
@@ -93,10 +130,12 @@ func (a NodeAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefin
 			},
 		},
 	})
+	crd.Spec.Validation = templatedAlertSchema(crd.Spec.Validation)
+	return withConversionWebhook(crd, "github.com/appscode/searchlight/apis/monitoring/v1beta1.NodeAlert")
 }
 
 func (a PodAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
-	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
 		Group:         SchemeGroupVersion.Group,
 		Plural:        ResourcePluralPodAlert,
 		Singular:      ResourceSingularPodAlert,
@@ -124,11 +163,136 @@ func (a PodAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefini
 				Type:     "string",
 				JSONPath: ".spec.check",
 			},
+			{
+				Name:     "Interval",
+				Type:     "string",
+				JSONPath: ".spec.checkInterval",
+			},
+			{
+				Name:     "Paused",
+				Type:     "boolean",
+				JSONPath: ".spec.paused",
+			},
+			{
+				Name:     "Targets",
+				Type:     "integer",
+				JSONPath: ".status.matchedTargets",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+	crd.Spec.Validation = templatedAlertSchema(crd.Spec.Validation)
+	return withConversionWebhook(crd, "github.com/appscode/searchlight/apis/monitoring/v1beta1.PodAlert")
+}
+
+func (a ServiceAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralServiceAlert,
+		Singular:      ResourceSingularServiceAlert,
+		Kind:          ResourceKindServiceAlert,
+		ShortNames:    []string{"sva"},
+		Categories:    []string{"monitoring", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "searchlight"},
+		},
+		SpecDefinitionName:      "github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlert",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: EnableStatusSubresource,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "CheckCommand",
+				Type:     "string",
+				JSONPath: ".spec.check",
+			},
+			{
+				Name:     "Interval",
+				Type:     "string",
+				JSONPath: ".spec.checkInterval",
+			},
+			{
+				Name:     "Paused",
+				Type:     "boolean",
+				JSONPath: ".spec.paused",
+			},
+			{
+				Name:     "Targets",
+				Type:     "integer",
+				JSONPath: ".status.matchedTargets",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+	crd.Spec.Validation = alertSchema(crd.Spec.Validation)
+	return crd
+}
+
+func (a WorkloadAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralWorkloadAlert,
+		Singular:      ResourceSingularWorkloadAlert,
+		Kind:          ResourceKindWorkloadAlert,
+		ShortNames:    []string{"woa"},
+		Categories:    []string{"monitoring", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "searchlight"},
+		},
+		SpecDefinitionName:      "github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlert",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: EnableStatusSubresource,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Kind",
+				Type:     "string",
+				JSONPath: ".spec.workloadKind",
+			},
+			{
+				Name:     "CheckCommand",
+				Type:     "string",
+				JSONPath: ".spec.check",
+			},
+			{
+				Name:     "Interval",
+				Type:     "string",
+				JSONPath: ".spec.checkInterval",
+			},
 			{
 				Name:     "Paused",
 				Type:     "boolean",
 				JSONPath: ".spec.paused",
 			},
+			{
+				Name:     "Targets",
+				Type:     "integer",
+				JSONPath: ".status.matchedTargets",
+			},
 			{
 				Name:     "Age",
 				Type:     "date",
@@ -136,10 +300,233 @@ func (a PodAlert) CustomResourceDefinition() *apiextensions.CustomResourceDefini
 			},
 		},
 	})
+	crd.Spec.Validation = workloadAlertSchema(crd.Spec.Validation)
+	return crd
+}
+
+func (a Downtime) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralDowntime,
+		Singular:      ResourceSingularDowntime,
+		Kind:          ResourceKindDowntime,
+		ShortNames:    []string{"dt"},
+		Categories:    []string{"monitoring", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "searchlight"},
+		},
+		SpecDefinitionName:      "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Downtime",
+		EnableValidation:        true,
+		GetOpenAPIDefinitions:   GetOpenAPIDefinitions,
+		EnableStatusSubresource: EnableStatusSubresource,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Active",
+				Type:     "boolean",
+				JSONPath: ".status.active",
+			},
+			{
+				Name:     "WindowStart",
+				Type:     "date",
+				JSONPath: ".status.windowStart",
+			},
+			{
+				Name:     "WindowEnd",
+				Type:     "date",
+				JSONPath: ".status.windowEnd",
+			},
+			{
+				Name:     "Alerts",
+				Type:     "integer",
+				JSONPath: ".status.matchedAlerts",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+	crd.Spec.Validation = downtimeSchema(crd.Spec.Validation)
+	return crd
+}
+
+func (a Silence) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralSilence,
+		Singular:      ResourceSingularSilence,
+		Kind:          ResourceKindSilence,
+		ShortNames:    []string{"sil"},
+		Categories:    []string{"monitoring", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "searchlight"},
+		},
+		SpecDefinitionName:    "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Silence",
+		EnableValidation:      true,
+		GetOpenAPIDefinitions: GetOpenAPIDefinitions,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "EndsAt",
+				Type:     "date",
+				JSONPath: ".spec.endsAt",
+			},
+			{
+				Name:     "CreatedBy",
+				Type:     "string",
+				JSONPath: ".spec.createdBy",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+	crd.Spec.Validation = silenceSchema(crd.Spec.Validation)
+	return crd
+}
+
+func (a AlertTemplate) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralAlertTemplate,
+		Singular:      ResourceSingularAlertTemplate,
+		Kind:          ResourceKindAlertTemplate,
+		ShortNames:    []string{"at"},
+		Categories:    []string{"monitoring", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "searchlight"},
+		},
+		SpecDefinitionName:    "github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplate",
+		EnableValidation:      true,
+		GetOpenAPIDefinitions: GetOpenAPIDefinitions,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "CheckCommand",
+				Type:     "string",
+				JSONPath: ".spec.check",
+			},
+			{
+				Name:     "Interval",
+				Type:     "string",
+				JSONPath: ".spec.checkInterval",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+	crd.Spec.Validation = alertTemplateSchema(crd.Spec.Validation)
+	return crd
+}
+
+func (a OnCallSchedule) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralOnCallSchedule,
+		Singular:      ResourceSingularOnCallSchedule,
+		Kind:          ResourceKindOnCallSchedule,
+		ShortNames:    []string{"oncall"},
+		Categories:    []string{"monitoring", "appscode", "all"},
+		ResourceScope: string(apiextensions.NamespaceScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "searchlight"},
+		},
+		SpecDefinitionName:    "github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallSchedule",
+		EnableValidation:      true,
+		GetOpenAPIDefinitions: GetOpenAPIDefinitions,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "TimeZone",
+				Type:     "string",
+				JSONPath: ".spec.timeZone",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+	crd.Spec.Validation = onCallScheduleSchema(crd.Spec.Validation)
+	return crd
+}
+
+func (a NotificationPolicy) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
+		Group:         SchemeGroupVersion.Group,
+		Plural:        ResourcePluralNotificationPolicy,
+		Singular:      ResourceSingularNotificationPolicy,
+		Kind:          ResourceKindNotificationPolicy,
+		ShortNames:    []string{"np"},
+		Categories:    []string{"monitoring", "appscode", "all"},
+		ResourceScope: string(apiextensions.ClusterScoped),
+		Versions: []apiextensions.CustomResourceDefinitionVersion{
+			{
+				Name:    SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Labels: crdutils.Labels{
+			LabelsMap: map[string]string{"app": "searchlight"},
+		},
+		SpecDefinitionName:    "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicy",
+		EnableValidation:      true,
+		GetOpenAPIDefinitions: GetOpenAPIDefinitions,
+		AdditionalPrinterColumns: []apiextensions.CustomResourceColumnDefinition{
+			{
+				Name:     "Mode",
+				Type:     "string",
+				JSONPath: ".spec.mode",
+			},
+			{
+				Name:     "Age",
+				Type:     "date",
+				JSONPath: ".metadata.creationTimestamp",
+			},
+		},
+	})
+	crd.Spec.Validation = notificationPolicySchema(crd.Spec.Validation)
+	return crd
 }
 
 func (a Incident) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
-	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
 		Group:         SchemeGroupVersion.Group,
 		Plural:        ResourcePluralIncident,
 		Singular:      ResourceSingularIncident,
@@ -173,10 +560,12 @@ func (a Incident) CustomResourceDefinition() *apiextensions.CustomResourceDefini
 			},
 		},
 	})
+	crd.Spec.Validation = incidentSchema(crd.Spec.Validation)
+	return crd
 }
 
 func (a SearchlightPlugin) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
-	return crdutils.NewCustomResourceDefinition(crdutils.Config{
+	crd := crdutils.NewCustomResourceDefinition(crdutils.Config{
 		Group:         SchemeGroupVersion.Group,
 		Plural:        ResourcePluralSearchlightPlugin,
 		Singular:      ResourceSingularSearchlightPlugin,
@@ -211,4 +600,43 @@ func (a SearchlightPlugin) CustomResourceDefinition() *apiextensions.CustomResou
 			},
 		},
 	})
+	crd.Spec.Validation = pluginSchema(crd.Spec.Validation)
+	return crd
+}
+
+// withConversionWebhook serves alerts in both v1alpha1 and v1beta1, when conversion webhook is enabled.
+// Objects are stored in v1beta1 and converted by Searchlight apiserver. Caller must set CABundle.
+func withConversionWebhook(crd *apiextensions.CustomResourceDefinition, v1beta1DefinitionName string) *apiextensions.CustomResourceDefinition {
+	if !EnableConversionWebhook {
+		return crd
+	}
+
+	crd.Spec.Version = v1beta1.SchemeGroupVersion.Version
+	crd.Spec.Versions = []apiextensions.CustomResourceDefinitionVersion{
+		{
+			Name:    v1beta1.SchemeGroupVersion.Version,
+			Served:  true,
+			Storage: true,
+			Schema:  v1beta1AlertSchema(crdutils.GetCustomResourceValidation(v1beta1DefinitionName, v1beta1.GetOpenAPIDefinitions, nil)),
+		},
+		{
+			Name:    SchemeGroupVersion.Version,
+			Served:  true,
+			Storage: false,
+			Schema:  crd.Spec.Validation,
+		},
+	}
+	crd.Spec.Validation = nil
+	crd.Spec.Conversion = &apiextensions.CustomResourceConversion{
+		Strategy: apiextensions.WebhookConverter,
+		WebhookClientConfig: &apiextensions.WebhookClientConfig{
+			Service: &apiextensions.ServiceReference{
+				Namespace: "default",
+				Name:      "kubernetes",
+				Path:      types.StringP(ConversionWebhookPath),
+			},
+		},
+		ConversionReviewVersions: []string{"v1beta1"},
+	}
+	return crd
 }
@@ -0,0 +1,307 @@
+package v1alpha1
+
+import (
+	"strconv"
+	"strings"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+)
+
+// Validation schemas of crds are generated from openapi definitions. Constraints that can't be
+// expressed via openapi-gen are added here, so that bad objects are rejected by the API server
+// even when the validating webhook is not running.
+
+var (
+	icingaStates     = []string{"OK", "Warning", "Critical", "Unknown"}
+	conditionStates  = []string{"True", "False", "Unknown"}
+	alertKinds       = []string{ResourceKindClusterAlert, ResourceKindNodeAlert, ResourceKindPodAlert, ResourceKindServiceAlert, ResourceKindWorkloadAlert}
+	workloadKinds    = []string{WorkloadKindDeployment, WorkloadKindStatefulSet, WorkloadKindDaemonSet}
+	varTypes         = []string{string(VarTypeInteger), string(VarTypeNumber), string(VarTypeBoolean), string(VarTypeString), string(VarTypeDuration)}
+	notificationType = []string{string(NotificationProblem), string(NotificationAcknowledgement), string(NotificationRecovery), string(NotificationCustom), string(NotificationFlappingStart), string(NotificationFlappingEnd), string(NotificationEscalation), string(NotificationAssignment), string(NotificationResolution)}
+	correlationTypes = []string{string(CorrelationNode), string(CorrelationWorkload), string(CorrelationTimeWindow)}
+)
+
+type schemaFunc func(*apiextensions.JSONSchemaProps)
+
+// receiverSchema requires either to or onCallSchedule in receivers.
+var receiverSchema = all(requiredAnyOf("to", "onCallSchedule"), required("state", "notifier"))
+
+// receiverStateSchema accepts receiver states of v1alpha1 in any case, as they were matched
+// case-insensitively before crd validation. v1beta1 requires them in the case of icingaStates.
+var receiverStateSchema = caseInsensitiveEnum(icingaStates...)
+
+// updateSchema applies fn to the schema of field at path. "[]" selects items of an array.
+func updateSchema(s *apiextensions.JSONSchemaProps, fn schemaFunc, path ...string) {
+	if len(path) == 0 {
+		fn(s)
+		return
+	}
+	if path[0] == "[]" {
+		if s.Items != nil && s.Items.Schema != nil {
+			updateSchema(s.Items.Schema, fn, path[1:]...)
+		}
+		return
+	}
+	if p, found := s.Properties[path[0]]; found {
+		updateSchema(&p, fn, path[1:]...)
+		s.Properties[path[0]] = p
+	}
+}
+
+func enumJSON(values ...string) []apiextensions.JSON {
+	out := make([]apiextensions.JSON, 0, len(values))
+	for _, v := range values {
+		out = append(out, apiextensions.JSON{Raw: []byte(strconv.Quote(v))})
+	}
+	return out
+}
+
+func enum(values ...string) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		s.Enum = enumJSON(values...)
+	}
+}
+
+// caseInsensitiveEnum allows values in any case, matching them like strings.EqualFold.
+func caseInsensitiveEnum(values ...string) schemaFunc {
+	alternatives := make([]string, 0, len(values))
+	for _, v := range values {
+		var b strings.Builder
+		for _, r := range v {
+			if lower, upper := strings.ToLower(string(r)), strings.ToUpper(string(r)); lower != upper {
+				b.WriteString("[" + upper + lower + "]")
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		alternatives = append(alternatives, b.String())
+	}
+	return func(s *apiextensions.JSONSchemaProps) {
+		s.Pattern = "^(" + strings.Join(alternatives, "|") + ")$"
+	}
+}
+
+func format(f string) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		s.Format = f
+	}
+}
+
+func between(min, max float64) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		s.Minimum = &min
+		s.Maximum = &max
+	}
+}
+
+func required(fields ...string) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		s.Required = fields
+	}
+}
+
+// all applies fns in order.
+func all(fns ...schemaFunc) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		for _, fn := range fns {
+			fn(s)
+		}
+	}
+}
+
+// requiredAnyOf requires at least one of fields.
+func requiredAnyOf(fields ...string) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		s.Required = nil
+		s.AnyOf = make([]apiextensions.JSONSchemaProps, 0, len(fields))
+		for _, f := range fields {
+			s.AnyOf = append(s.AnyOf, apiextensions.JSONSchemaProps{Required: []string{f}})
+		}
+	}
+}
+
+// mapOf sets the schema of values of a map, if EnableAdditionalProperties is set.
+func mapOf(value apiextensions.JSONSchemaProps) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		if EnableAdditionalProperties {
+			s.AdditionalProperties = &apiextensions.JSONSchemaPropsOrBool{
+				Allows: true,
+				Schema: &value,
+			}
+		}
+	}
+}
+
+// structuralSchema removes properties of metadata, since structural schemas can only restrict
+// name and generateName of metadata. Then fns are applied to the fields at their paths.
+func structuralSchema(v *apiextensions.CustomResourceValidation, fns map[string]schemaFunc, specRequired bool) *apiextensions.CustomResourceValidation {
+	if v == nil || v.OpenAPIV3Schema == nil {
+		return v
+	}
+	s := v.OpenAPIV3Schema
+	updateSchema(s, func(meta *apiextensions.JSONSchemaProps) {
+		*meta = apiextensions.JSONSchemaProps{Type: "object"}
+	}, "metadata")
+	if specRequired {
+		s.Required = []string{"spec"}
+	}
+	for path, fn := range fns {
+		updateSchema(s, fn, strings.Split(path, ".")...)
+	}
+	return v
+}
+
+// alertSchema is used for ClusterAlert, NodeAlert, PodAlert, ServiceAlert and WorkloadAlert.
+func alertSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec":                                          required("check"),
+		"spec.checkInterval":                            format("duration"),
+		"spec.alertInterval":                            format("duration"),
+		"spec.retryInterval":                            format("duration"),
+		"spec.notificationDelay":                        format("duration"),
+		"spec.grouping.groupWait":                       format("duration"),
+		"spec.flappingThresholdLow":                     between(0, 100),
+		"spec.flappingThresholdHigh":                    between(0, 100),
+		"spec.receivers.[]":                             receiverSchema,
+		"spec.receivers.[].state":                       receiverStateSchema,
+		"spec.receivers.[].escalateAfter":               format("duration"),
+		"spec.vars":                                     mapOf(apiextensions.JSONSchemaProps{Type: "string"}),
+		"spec.dependsOn.[]":                             required("kind", "name"),
+		"spec.dependsOn.[].kind":                        enum(ResourceKindNodeAlert, ResourceKindClusterAlert),
+		"status.conditions.[].status":                   enum(conditionStates...),
+		"status.targets.[].state":                       enum(icingaStates...),
+		"status.notificationGroups.[].targets.[].state": enum(icingaStates...),
+	}, true)
+}
+
+// templatedAlertSchema is used for ClusterAlert, NodeAlert and PodAlert, whose check may be set in AlertTemplate.
+func templatedAlertSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	v = alertSchema(v)
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec":             requiredAnyOf("check", "templateRef"),
+		"spec.templateRef": required("name"),
+	}, true)
+}
+
+// v1beta1AlertSchema is used for alerts served in v1beta1, where receiver states are IcingaStates.
+func v1beta1AlertSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	v = templatedAlertSchema(v)
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec.receivers.[].state": func(s *apiextensions.JSONSchemaProps) {
+			s.Pattern = ""
+			s.Enum = enumJSON(icingaStates...)
+		},
+	}, true)
+}
+
+func alertTemplateSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec.checkInterval":              format("duration"),
+		"spec.alertInterval":              format("duration"),
+		"spec.receivers.[]":               receiverSchema,
+		"spec.receivers.[].state":         receiverStateSchema,
+		"spec.receivers.[].escalateAfter": format("duration"),
+		"spec.vars":                       mapOf(apiextensions.JSONSchemaProps{Type: "string"}),
+	}, true)
+}
+
+func workloadAlertSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	v = alertSchema(v)
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec":              required("check", "workloadKind"),
+		"spec.workloadKind": enum(workloadKinds...),
+	}, true)
+}
+
+func downtimeSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec":                     required("startTime", "endTime", "comment"),
+		"spec.alertKind":           enum(alertKinds...),
+		"spec.recurrence":          required("interval"),
+		"spec.recurrence.interval": format("duration"),
+	}, true)
+}
+
+func silenceSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec": required("selector", "endsAt"),
+	}, true)
+}
+
+func incidentSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	return structuralSchema(v, map[string]schemaFunc{
+		"status.lastNotificationType":           enum(notificationType...),
+		"status.notifications.[].type":          enum(notificationType...),
+		"status.notifications.[].state":         enum(icingaStates...),
+		"status.notifications.[].escalateAfter": format("duration"),
+		"status.deliveries.[].type":             enum(notificationType...),
+		"status.deliveries.[].phase":            enum(string(DeliveryPhaseSent), string(DeliveryPhasePending), string(DeliveryPhaseFailed)),
+		"status.parentRef.correlatedBy":         enum(correlationTypes...),
+		"status.children.[].correlatedBy":       enum(correlationTypes...),
+	}, false)
+}
+
+func onCallScheduleSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec":                          required("layers"),
+		"spec.layers.[]":                required("users", "start"),
+		"spec.layers.[].rotationLength": format("duration"),
+		"spec.overrides.[]":             required("users", "start", "end"),
+	}, true)
+}
+
+func notificationPolicySchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	v = structuralSchema(v, map[string]schemaFunc{
+		"spec":                                      required("routes"),
+		"spec.mode":                                 enum(string(NotificationPolicyModeAppend), string(NotificationPolicyModeReplace)),
+		"spec.notifierSecret":                       required("namespace", "name"),
+		"spec.routes.[]":                            requiredAnyOf("receivers", "routes"),
+		"spec.routes.[].groupWait":                  format("duration"),
+		"spec.routes.[].match.alertKinds.[]":        enum(alertKinds...),
+		"spec.routes.[].match.states.[]":            enum(icingaStates...),
+		"spec.routes.[].receivers.[]":               receiverSchema,
+		"spec.routes.[].receivers.[].state":         enum(icingaStates...),
+		"spec.routes.[].receivers.[].escalateAfter": format("duration"),
+	}, true)
+	// child routes are validated once the schema of their parent is complete
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec.routes.[]": childRoutes(maxRouteDepth),
+	}, true)
+}
+
+// Levels of child routes validated by crd schema. Deeper routes are validated by the webhook.
+const maxRouteDepth = 3
+
+// childRoutes sets the schema of child routes of a route to the schema of route, nested for depth levels.
+// openapi definitions of child routes are not generated, since schemas of crds can't refer to themselves.
+func childRoutes(depth int) schemaFunc {
+	return func(s *apiextensions.JSONSchemaProps) {
+		if depth == 0 {
+			return
+		}
+		child := s.DeepCopy()
+		childRoutes(depth - 1)(child)
+		s.Properties["routes"] = apiextensions.JSONSchemaProps{
+			Description: "Child routes are evaluated in order for notifications selected by this route, like the routes of policy. Receivers of this route are notified, if none of them selects a notification.",
+			Type:        "array",
+			Items:       &apiextensions.JSONSchemaPropsOrArray{Schema: child},
+		}
+	}
+}
+
+func pluginSchema(v *apiextensions.CustomResourceValidation) *apiextensions.CustomResourceValidation {
+	return structuralSchema(v, map[string]schemaFunc{
+		"spec":               required("command", "alertKinds", "states"),
+		"spec.alertKinds.[]": enum(alertKinds...),
+		"spec.states.[]":     enum(icingaStates...),
+		"spec.arguments.vars.fields": mapOf(apiextensions.JSONSchemaProps{
+			Type:     "object",
+			Required: []string{"type"},
+			Properties: map[string]apiextensions.JSONSchemaProps{
+				"description": {Type: "string"},
+				"type":        {Type: "string", Enum: enumJSON(varTypes...)},
+			},
+		}),
+		"spec.arguments.host": mapOf(apiextensions.JSONSchemaProps{Type: "string"}),
+	}, true)
+}
@@ -0,0 +1,56 @@
+package v1alpha1
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+)
+
+func receiverStateProps(v *apiextensions.CustomResourceValidation) apiextensions.JSONSchemaProps {
+	return v.OpenAPIV3Schema.Properties["spec"].Properties["receivers"].Items.Schema.Properties["state"]
+}
+
+func TestReceiverStateSchema(t *testing.T) {
+	s := receiverStateProps(PodAlert{}.CustomResourceDefinition().Spec.Validation)
+	assert.Empty(t, s.Enum)
+	pattern := regexp.MustCompile(s.Pattern)
+	for _, state := range []string{"OK", "ok", "Warning", "WARNING", "Critical", "critical", "Unknown", "unknown"} {
+		assert.True(t, pattern.MatchString(state), state)
+	}
+	for _, state := range []string{"", "crit", "Critical ", "Error"} {
+		assert.False(t, pattern.MatchString(state), state)
+	}
+
+	EnableConversionWebhook = true
+	defer func() { EnableConversionWebhook = false }()
+	crd := PodAlert{}.CustomResourceDefinition()
+	for _, v := range crd.Spec.Versions {
+		s := receiverStateProps(v.Schema)
+		if v.Name == SchemeGroupVersion.Version {
+			assert.NotEmpty(t, s.Pattern)
+			assert.Empty(t, s.Enum)
+		} else {
+			assert.Empty(t, s.Pattern)
+			assert.Equal(t, enumJSON(icingaStates...), s.Enum)
+		}
+	}
+}
+
+func TestMapOf(t *testing.T) {
+	vars := func() apiextensions.JSONSchemaProps {
+		return ClusterAlert{}.CustomResourceDefinition().Spec.Validation.OpenAPIV3Schema.Properties["spec"].Properties["vars"]
+	}
+
+	// status subresource doesn't decide about additionalProperties
+	EnableStatusSubresource = true
+	defer func() { EnableStatusSubresource = false }()
+	assert.Nil(t, vars().AdditionalProperties)
+
+	EnableAdditionalProperties = true
+	defer func() { EnableAdditionalProperties = false }()
+	if p := vars().AdditionalProperties; assert.NotNil(t, p) {
+		assert.Equal(t, "string", p.Schema.Type)
+	}
+}
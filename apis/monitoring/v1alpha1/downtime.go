@@ -0,0 +1,212 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	ResourceKindDowntime     = "Downtime"
+	ResourcePluralDowntime   = "downtimes"
+	ResourceSingularDowntime = "downtime"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Downtime is a maintenance window for alerts. Icinga downtimes are scheduled for the services of
+// selected alerts, and notifications for those alerts are suppressed while the window is in progress.
+type Downtime struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the Downtime.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec DowntimeSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the Downtime.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	// +optional
+	Status DowntimeStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DowntimeList is a collection of Downtime.
+type DowntimeList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of Downtime.
+	Items []Downtime `json:"items"`
+}
+
+// DowntimeSpec describes the maintenance window the user wishes to schedule. It is applied to
+// alerts in the namespace of the Downtime.
+type DowntimeSpec struct {
+	// Kind of alerts this downtime is applied to, such as PodAlert.
+	// Alerts of all kinds are selected, if not set.
+	// +optional
+	AlertKind string `json:"alertKind,omitempty"`
+
+	// Name of the alert this downtime is applied to
+	// +optional
+	AlertName *string `json:"alertName,omitempty"`
+
+	// Selector of alerts this downtime is applied to
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Start of the first maintenance window
+	StartTime metav1.Time `json:"startTime"`
+
+	// End of the first maintenance window
+	EndTime metav1.Time `json:"endTime"`
+
+	// Recurrence repeats the maintenance window. Each window has the same length as the first one.
+	// +optional
+	Recurrence *DowntimeRecurrence `json:"recurrence,omitempty"`
+
+	// Reason for the downtime. It is shown in Icinga and recorded in Incidents.
+	Comment string `json:"comment"`
+}
+
+type DowntimeRecurrence struct {
+	// Time between the start of two maintenance windows, such as 24h or 168h
+	Interval metav1.Duration `json:"interval"`
+
+	// No maintenance window is started after this time
+	// +optional
+	Until *metav1.Time `json:"until,omitempty"`
+}
+
+// DowntimeStatus is the most recently observed status of a Downtime.
+type DowntimeStatus struct {
+	// ObservedGeneration is the most recent generation observed for this Downtime. It corresponds to the
+	// Downtime's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Indicates that a maintenance window is in progress
+	// +optional
+	Active bool `json:"active,omitempty"`
+
+	// Start of the maintenance window in progress or the next one.
+	// Not set when no maintenance window is left.
+	// +optional
+	WindowStart *metav1.Time `json:"windowStart,omitempty"`
+
+	// End of the maintenance window in progress or the next one.
+	// +optional
+	WindowEnd *metav1.Time `json:"windowEnd,omitempty"`
+
+	// Number of alerts this downtime is applied to
+	// +optional
+	MatchedAlerts int32 `json:"matchedAlerts,omitempty"`
+}
+
+func (d Downtime) IsValid() error {
+	if d.Spec.AlertKind != "" && !isAlertKind(d.Spec.AlertKind) {
+		return fmt.Errorf("%s is not a valid alert kind", d.Spec.AlertKind)
+	}
+	if d.Spec.AlertName != nil && d.Spec.Selector != nil {
+		return fmt.Errorf("can't specify both alert name and selector")
+	}
+	if d.Spec.AlertName == nil && d.Spec.Selector == nil {
+		return fmt.Errorf("specify either alert name or selector")
+	}
+	if d.Spec.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(d.Spec.Selector); err != nil {
+			return err
+		}
+	}
+	if !d.Spec.EndTime.After(d.Spec.StartTime.Time) {
+		return fmt.Errorf("endTime must be after startTime")
+	}
+	if r := d.Spec.Recurrence; r != nil {
+		if r.Interval.Duration < d.Spec.EndTime.Sub(d.Spec.StartTime.Time) {
+			return fmt.Errorf("recurrence interval %s is shorter than the maintenance window", r.Interval.Duration)
+		}
+	}
+	if d.Spec.Comment == "" {
+		return fmt.Errorf("comment must not be empty")
+	}
+	return nil
+}
+
+func isAlertKind(kind string) bool {
+	for _, k := range alertKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Window returns the maintenance window in progress at t, or the next one to start after t.
+// ok is false, if no maintenance window is left.
+func (d Downtime) Window(t time.Time) (start, end time.Time, ok bool) {
+	start = d.Spec.StartTime.Time
+	length := d.Spec.EndTime.Sub(start)
+
+	r := d.Spec.Recurrence
+	if r == nil || r.Interval.Duration <= 0 {
+		return start, start.Add(length), t.Before(start.Add(length))
+	}
+
+	if t.After(start) {
+		n := t.Sub(start) / r.Interval.Duration
+		start = start.Add(n * r.Interval.Duration)
+		if !t.Before(start.Add(length)) {
+			start = start.Add(r.Interval.Duration)
+		}
+	}
+	if r.Until != nil && start.After(r.Until.Time) {
+		return start, start.Add(length), false
+	}
+	return start, start.Add(length), true
+}
+
+// IsActive returns true, if a maintenance window is in progress at t.
+func (d Downtime) IsActive(t time.Time) bool {
+	start, _, ok := d.Window(t)
+	return ok && !t.Before(start)
+}
+
+// Selects returns true, if this downtime is applied to the alert of given kind.
+func (d Downtime) Selects(kind string, alert metav1.Object) bool {
+	if alert.GetNamespace() != d.Namespace {
+		return false
+	}
+	if d.Spec.AlertKind != "" && d.Spec.AlertKind != kind {
+		return false
+	}
+	if d.Spec.AlertName != nil {
+		return *d.Spec.AlertName == alert.GetName()
+	}
+	sel, err := metav1.LabelSelectorAsSelector(d.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return sel.Matches(labels.Set(alert.GetLabels()))
+}
+
+func (d Downtime) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindDowntime,
+		Namespace:       d.Namespace,
+		Name:            d.Name,
+		UID:             d.UID,
+		ResourceVersion: d.ResourceVersion,
+	}
+}
@@ -0,0 +1,73 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDowntimeWindow(t *testing.T) {
+	start := time.Date(2019, 1, 7, 2, 0, 0, 0, time.UTC)
+	until := metav1.NewTime(start.Add(14 * 24 * time.Hour))
+	alertName := "pod-exec"
+	d := Downtime{
+		Spec: DowntimeSpec{
+			AlertName: &alertName,
+			StartTime: metav1.NewTime(start),
+			EndTime:   metav1.NewTime(start.Add(2 * time.Hour)),
+			Recurrence: &DowntimeRecurrence{
+				Interval: metav1.Duration{Duration: 7 * 24 * time.Hour},
+				Until:    &until,
+			},
+			Comment: "weekly database maintenance",
+		},
+	}
+	assert.Nil(t, d.IsValid())
+
+	cases := []struct {
+		name   string
+		at     time.Time
+		start  time.Time
+		ok     bool
+		active bool
+	}{
+		{"before first window", start.Add(-time.Hour), start, true, false},
+		{"in first window", start.Add(time.Hour), start, true, true},
+		{"end of first window", start.Add(2 * time.Hour), start.Add(7 * 24 * time.Hour), true, false},
+		{"in second window", start.Add(7*24*time.Hour + time.Minute), start.Add(7 * 24 * time.Hour), true, true},
+		{"in last window", start.Add(14*24*time.Hour + time.Hour), start.Add(14 * 24 * time.Hour), true, true},
+		{"after last window", start.Add(15 * 24 * time.Hour), start.Add(21 * 24 * time.Hour), false, false},
+	}
+	for _, c := range cases {
+		s, e, ok := d.Window(c.at)
+		assert.Equal(t, c.ok, ok, c.name)
+		if c.ok {
+			assert.Equal(t, c.start, s, c.name)
+			assert.Equal(t, c.start.Add(2*time.Hour), e, c.name)
+		}
+		assert.Equal(t, c.active, d.IsActive(c.at), c.name)
+	}
+
+	d.Spec.Recurrence = nil
+	_, _, ok := d.Window(start.Add(3 * time.Hour))
+	assert.False(t, ok)
+}
+
+func TestDowntimeSelects(t *testing.T) {
+	d := Downtime{
+		ObjectMeta: metav1.ObjectMeta{Name: "maintenance", Namespace: "demo"},
+		Spec: DowntimeSpec{
+			AlertKind: ResourceKindPodAlert,
+			Selector:  &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+		},
+	}
+	alert := &metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo", Labels: map[string]string{"team": "payments"}}
+
+	assert.True(t, d.Selects(ResourceKindPodAlert, alert))
+	assert.False(t, d.Selects(ResourceKindNodeAlert, alert))
+
+	alert.Namespace = "default"
+	assert.False(t, d.Selects(ResourceKindPodAlert, alert))
+}
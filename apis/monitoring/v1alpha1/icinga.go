@@ -1,10 +1,12 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 
 	"gomodules.xyz/notify/unified"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -15,6 +17,16 @@ const (
 	CheckPodExec   = "pod-exec"
 )
 
+const (
+	CheckServiceEndpoints = "service-endpoints"
+)
+
+const (
+	CheckWorkloadRollout     = "workload-rollout"
+	CheckWorkloadReplicas    = "workload-replicas"
+	CheckWorkloadUnavailable = "workload-unavailable"
+)
+
 const (
 	CheckNodeVolume = "node-volume"
 	CheckNodeStatus = "node-status"
@@ -62,28 +74,55 @@ type IcingaCommand struct {
 }
 
 var (
-	PodCommands     = &Registry{reg: map[string]IcingaCommand{}}
-	NodeCommands    = &Registry{reg: map[string]IcingaCommand{}}
-	ClusterCommands = &Registry{reg: map[string]IcingaCommand{}}
+	PodCommands      = &Registry{reg: map[string]IcingaCommand{}}
+	ServiceCommands  = &Registry{reg: map[string]IcingaCommand{}}
+	WorkloadCommands = &Registry{reg: map[string]IcingaCommand{}}
+	NodeCommands     = &Registry{reg: map[string]IcingaCommand{}}
+	ClusterCommands  = &Registry{reg: map[string]IcingaCommand{}}
 )
 
+// checkNotifiers checks that the notifier of every receiver of alert is configured in its notifier Secret.
+// Receivers use the Secret of their secretRef, notifierSecretName of alert or the default notifier Secret,
+// in that order.
 func checkNotifiers(kc kubernetes.Interface, alert Alert) error {
-	if alert.GetNotifierSecretName() == "" && len(alert.GetReceivers()) == 0 {
-		return nil
-	}
-	secret, err := kc.CoreV1().Secrets(alert.GetNamespace()).Get(alert.GetNotifierSecretName(), metav1.GetOptions{})
-	if err != nil {
-		return err
+	secrets := map[NotifierSecretReference]*core.Secret{}
+	if alert.GetNotifierSecretName() != "" {
+		secret, err := kc.CoreV1().Secrets(alert.GetNamespace()).Get(alert.GetNotifierSecretName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		secrets[NotifierSecretReference{Namespace: alert.GetNamespace(), Name: alert.GetNotifierSecretName()}] = secret
 	}
+
 	for _, r := range alert.GetReceivers() {
-		_, err = unified.LoadVia(r.Notifier, func(key string) (value string, found bool) {
+		var ref NotifierSecretReference
+		switch {
+		case r.SecretRef != nil:
+			ref = NotifierSecretReference{Namespace: alert.GetNamespace(), Name: r.SecretRef.Name}
+		case alert.GetNotifierSecretName() != "":
+			ref = NotifierSecretReference{Namespace: alert.GetNamespace(), Name: alert.GetNotifierSecretName()}
+		case DefaultNotifierSecret != nil:
+			ref = *DefaultNotifierSecret
+		default:
+			return fmt.Errorf("notifier secret of receiver for state %s is not set", r.State)
+		}
+
+		secret, ok := secrets[ref]
+		if !ok {
+			var err error
+			if secret, err = kc.CoreV1().Secrets(ref.Namespace).Get(ref.Name, metav1.GetOptions{}); err != nil {
+				return err
+			}
+			secrets[ref] = secret
+		}
+		_, err := unified.LoadVia(r.Notifier, func(key string) (value string, found bool) {
 			var bytes []byte
 			bytes, found = secret.Data[key]
 			value = string(bytes)
 			return
 		})
 		if err != nil {
-			return err
+			return fmt.Errorf("invalid notifier of receiver for state %s in secret %s/%s. Reason: %v", r.State, ref.Namespace, ref.Name, err)
 		}
 	}
 	return nil
@@ -97,6 +136,10 @@ func AlertType(t string) IncidentNotificationType {
 		return NotificationAcknowledgement
 	case "RECOVERY":
 		return NotificationRecovery
+	case "FLAPPINGSTART":
+		return NotificationFlappingStart
+	case "FLAPPINGEND":
+		return NotificationFlappingEnd
 	default:
 		return NotificationCustom
 	}
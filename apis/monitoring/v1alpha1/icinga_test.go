@@ -0,0 +1,48 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCheckNotifiers(t *testing.T) {
+	slack := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments-slack", Namespace: "demo"},
+		Data:       map[string][]byte{"SLACK_AUTH_TOKEN": []byte("token")},
+	}
+	smtp := &core.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "searchlight-operator", Namespace: "kube-system"},
+		Data: map[string][]byte{
+			"SMTP_HOST":     []byte("smtp.example.com"),
+			"SMTP_PORT":     []byte("587"),
+			"SMTP_USERNAME": []byte("searchlight"),
+			"SMTP_PASSWORD": []byte("password"),
+			"SMTP_FROM":     []byte("searchlight@example.com"),
+		},
+	}
+	kc := kfake.NewSimpleClientset(slack, smtp)
+	alert := &ClusterAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-cert", Namespace: "demo"},
+		Spec: ClusterAlertSpec{
+			Receivers: []Receiver{
+				{State: "Critical", To: []string{"#payments"}, Notifier: "Slack", SecretRef: &LocalSecretReference{Name: "payments-slack"}},
+				{State: "Warning", To: []string{"ops@example.com"}, Notifier: "SMTP"},
+			},
+		},
+	}
+
+	// receiver without secret
+	assert.EqualError(t, checkNotifiers(kc, alert), "notifier secret of receiver for state Warning is not set")
+
+	DefaultNotifierSecret = &NotifierSecretReference{Namespace: "kube-system", Name: "searchlight-operator"}
+	defer func() { DefaultNotifierSecret = nil }()
+	assert.NoError(t, checkNotifiers(kc, alert))
+
+	// notifier secret of alert is preferred to the default one
+	alert.Spec.NotifierSecretName = "payments-slack"
+	assert.Error(t, checkNotifiers(kc, alert))
+}
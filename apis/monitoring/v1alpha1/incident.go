@@ -1,6 +1,10 @@
 package v1alpha1
 
 import (
+	"strings"
+	"time"
+
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -8,6 +12,9 @@ const (
 	ResourceKindIncident     = "Incident"
 	ResourcePluralIncident   = "incidents"
 	ResourceSingularIncident = "incident"
+
+	// Finalizer of incidents that are archived by Searchlight operator, before they are deleted
+	IncidentArchiveFinalizer = "monitoring.appscode.com/archive"
 )
 
 // +genclient
@@ -32,6 +39,64 @@ type IncidentStatus struct {
 	// Notifications for the incident, such as problem or acknowledgement.
 	// +optional
 	Notifications []IncidentNotification `json:"notifications,omitempty"`
+
+	// Latest delivery of each type of notification to each receiver. Pending deliveries are the
+	// outbox of the incident, retried by Searchlight operator.
+	// +optional
+	Deliveries []NotificationDelivery `json:"deliveries,omitempty"`
+
+	// User the incident is assigned to
+	// +optional
+	Assignee string `json:"assignee,omitempty"`
+
+	// Parent incident this incident is correlated under. Notifications of the incident are not sent
+	// to receivers, while its parent is open.
+	// +optional
+	ParentRef *CorrelatedIncidentReference `json:"parentRef,omitempty"`
+
+	// Incidents correlated under this incident
+	// +optional
+	Children []CorrelatedIncidentReference `json:"children,omitempty"`
+
+	// Routes of NotificationPolicies whose receivers are not notified of the current problem yet, as
+	// it has not lasted for their groupWait. Searchlight operator notifies them, once the wait expires.
+	// +optional
+	WaitingRoutes []WaitingRoute `json:"waitingRoutes,omitempty"`
+
+	// The time at which receivers of the next escalation step are notified of the current problem,
+	// unless it is acknowledged or recovers before. Searchlight operator escalates the incident then.
+	// +optional
+	NextEscalationTimestamp *metav1.Time `json:"nextEscalationTimestamp,omitempty"`
+}
+
+// WaitingRoute is a route of NotificationPolicy, whose receivers are notified of a problem after its groupWait.
+type WaitingRoute struct {
+	// NotificationPolicy/route, such as default/pager
+	Route string `json:"route"`
+	// The time at which receivers of route are notified, unless the problem recovers before
+	NotifyTimestamp metav1.Time `json:"notifyTimestamp"`
+}
+
+type IncidentCorrelationType string
+
+// These are the possible correlations of incidents.
+const (
+	// Incident of a pod is correlated under the incident of its node
+	CorrelationNode IncidentCorrelationType = "Node"
+	// Incident of a pod is correlated under the incident of its workload
+	CorrelationWorkload IncidentCorrelationType = "Workload"
+	// Incident is correlated under an incident whose problem started shortly before
+	CorrelationTimeWindow IncidentCorrelationType = "TimeWindow"
+)
+
+// CorrelatedIncidentReference refers to an incident correlated with another incident.
+type CorrelatedIncidentReference struct {
+	// Namespace of the incident
+	Namespace string `json:"namespace"`
+	// Name of the incident
+	Name string `json:"name"`
+	// What the incidents share, one of Node, Workload or TimeWindow
+	CorrelatedBy IncidentCorrelationType `json:"correlatedBy"`
 }
 
 type IncidentNotificationType string
@@ -42,6 +107,11 @@ const (
 	NotificationAcknowledgement IncidentNotificationType = "Acknowledgement"
 	NotificationRecovery        IncidentNotificationType = "Recovery"
 	NotificationCustom          IncidentNotificationType = "Custom"
+	NotificationFlappingStart   IncidentNotificationType = "FlappingStart"
+	NotificationFlappingEnd     IncidentNotificationType = "FlappingEnd"
+	NotificationEscalation      IncidentNotificationType = "Escalation"
+	NotificationAssignment      IncidentNotificationType = "Assignment"
+	NotificationResolution      IncidentNotificationType = "Resolution"
 )
 
 type IncidentNotification struct {
@@ -64,6 +134,146 @@ type IncidentNotification struct {
 	LastTimestamp metav1.Time `json:"lastTimestamp,omitempty"`
 	// state of incident, such as Critical, Warning, OK, Unknown
 	LastState string `json:"state"`
+	// Kind/name of the object, such as Downtime/db-upgrade, that suppressed this notification.
+	// Suppressed notifications are not sent to receivers.
+	// +optional
+	SuppressedBy string `json:"suppressedBy,omitempty"`
+	// Escalation step, such as 15m, whose receivers were notified. Set for Escalation notifications.
+	// +optional
+	EscalateAfter *metav1.Duration `json:"escalateAfter,omitempty"`
+	// User the incident was assigned to. Set for Assignment notifications.
+	// +optional
+	Assignee string `json:"assignee,omitempty"`
+}
+
+type DeliveryPhase string
+
+// These are the possible phases of a delivery.
+const (
+	// Notification was sent to receiver
+	DeliveryPhaseSent DeliveryPhase = "Sent"
+	// Notification could not be sent yet and is retried by Searchlight operator
+	DeliveryPhasePending DeliveryPhase = "Pending"
+	// Notification could not be sent in any of the attempts
+	DeliveryPhaseFailed DeliveryPhase = "Failed"
+)
+
+// NotificationDelivery is the delivery of a notification to a receiver.
+type NotificationDelivery struct {
+	// Type of notification, such as Problem
+	Type IncidentNotificationType `json:"type"`
+	// Notifier used to send notification, such as Mailgun
+	Notifier string `json:"notifier"`
+	// Contacts notification is sent to
+	To []string `json:"to"`
+	// Phase of delivery, one of Sent, Pending or Failed
+	Phase DeliveryPhase `json:"phase"`
+	// Number of attempts to send notification
+	Attempts int32 `json:"attempts"`
+	// Error of the last failed attempt
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+	// The time at which notification was last attempted to be sent
+	LastAttemptTimestamp metav1.Time `json:"lastAttemptTimestamp"`
+	// The time after which a pending delivery is attempted again
+	// +optional
+	NextAttemptTimestamp *metav1.Time `json:"nextAttemptTimestamp,omitempty"`
+	// Secret containing notifier credentials
+	// +optional
+	NotifierSecret *NotifierSecretReference `json:"notifierSecret,omitempty"`
+	// Rendered notification, kept while delivery is pending
+	// +optional
+	Message *NotificationMessage `json:"message,omitempty"`
+}
+
+// NotificationMessage is a rendered notification.
+type NotificationMessage struct {
+	// Subject of emails
+	// +optional
+	Subject string `json:"subject,omitempty"`
+	// Body of emails, or message of SMS, chat and push notifications
+	Body string `json:"body"`
+	// Indicates that the body of emails is HTML
+	// +optional
+	HTML bool `json:"html,omitempty"`
+}
+
+// SetDelivery adds a delivery or replaces the one of the same type of notification to the same receiver.
+func (s *IncidentStatus) SetDelivery(d NotificationDelivery) {
+	for i, cur := range s.Deliveries {
+		if cur.Type == d.Type && cur.Notifier == d.Notifier && strings.Join(cur.To, ",") == strings.Join(d.To, ",") {
+			s.Deliveries[i] = d
+			return
+		}
+	}
+	s.Deliveries = append(s.Deliveries, d)
+}
+
+// HasChild returns true, if the incident in namespace with name is correlated under this incident.
+func (s IncidentStatus) HasChild(namespace, name string) bool {
+	for _, c := range s.Children {
+		if c.Namespace == namespace && c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPendingDeliveries returns true, if any notification of incident is waiting to be sent again.
+func (s IncidentStatus) HasPendingDeliveries() bool {
+	for _, d := range s.Deliveries {
+		if d.Phase == DeliveryPhasePending {
+			return true
+		}
+	}
+	return false
+}
+
+// SetWaitingRoute adds route to the waiting routes of incident, unless it is waiting already.
+func (s *IncidentStatus) SetWaitingRoute(route WaitingRoute) {
+	for _, cur := range s.WaitingRoutes {
+		if cur.Route == route.Route {
+			return
+		}
+	}
+	s.WaitingRoutes = append(s.WaitingRoutes, route)
+}
+
+// RemoveWaitingRoute removes route from the waiting routes of incident.
+func (s *IncidentStatus) RemoveWaitingRoute(route string) {
+	for i, cur := range s.WaitingRoutes {
+		if cur.Route == route {
+			s.WaitingRoutes = append(s.WaitingRoutes[:i], s.WaitingRoutes[i+1:]...)
+			return
+		}
+	}
+}
+
+// DueWaitingRoutes returns the waiting routes of incident, whose receivers should be notified at now.
+func (s IncidentStatus) DueWaitingRoutes(now time.Time) []string {
+	var out []string
+	for _, r := range s.WaitingRoutes {
+		if !r.NotifyTimestamp.Time.After(now) {
+			out = append(out, r.Route)
+		}
+	}
+	return out
+}
+
+// EscalationDue returns true, if the next escalation step of incident should be notified at now.
+func (s IncidentStatus) EscalationDue(now time.Time) bool {
+	return s.NextEscalationTimestamp != nil && !s.NextEscalationTimestamp.Time.After(now)
+}
+
+func (i Incident) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindIncident,
+		Namespace:       i.Namespace,
+		Name:            i.Name,
+		UID:             i.UID,
+		ResourceVersion: i.ResourceVersion,
+	}
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -0,0 +1,60 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetDelivery(t *testing.T) {
+	var s IncidentStatus
+	mail := NotificationDelivery{Type: NotificationProblem, Notifier: "Mailgun", To: []string{"ops@example.com"}, Phase: DeliveryPhasePending, Attempts: 3}
+	s.SetDelivery(mail)
+	s.SetDelivery(NotificationDelivery{Type: NotificationProblem, Notifier: "Slack", To: []string{"#ops"}, Phase: DeliveryPhaseSent, Attempts: 1})
+	assert.Len(t, s.Deliveries, 2)
+	assert.True(t, s.HasPendingDeliveries())
+
+	// retry of a delivery replaces it
+	mail.Phase = DeliveryPhaseSent
+	mail.Attempts++
+	s.SetDelivery(mail)
+	assert.Len(t, s.Deliveries, 2)
+	assert.Equal(t, int32(4), s.Deliveries[0].Attempts)
+	assert.False(t, s.HasPendingDeliveries())
+
+	s.SetDelivery(NotificationDelivery{Type: NotificationRecovery, Notifier: "Mailgun", To: []string{"ops@example.com"}, Phase: DeliveryPhaseFailed})
+	assert.Len(t, s.Deliveries, 3)
+	assert.False(t, s.HasPendingDeliveries())
+}
+
+func TestWaitingRoutes(t *testing.T) {
+	now := time.Now()
+	var s IncidentStatus
+	s.SetWaitingRoute(WaitingRoute{Route: "default/pager", NotifyTimestamp: metav1.NewTime(now.Add(5 * time.Minute))})
+	s.SetWaitingRoute(WaitingRoute{Route: "default/chat", NotifyTimestamp: metav1.NewTime(now)})
+	// waiting routes keep the time of the problem they wait for
+	s.SetWaitingRoute(WaitingRoute{Route: "default/pager", NotifyTimestamp: metav1.NewTime(now.Add(10 * time.Minute))})
+	assert.Len(t, s.WaitingRoutes, 2)
+	assert.Equal(t, now.Add(5*time.Minute).Unix(), s.WaitingRoutes[0].NotifyTimestamp.Unix())
+
+	assert.Equal(t, []string{"default/chat"}, s.DueWaitingRoutes(now))
+	assert.Equal(t, []string{"default/pager", "default/chat"}, s.DueWaitingRoutes(now.Add(5*time.Minute)))
+
+	s.RemoveWaitingRoute("default/chat")
+	s.RemoveWaitingRoute("default/db")
+	assert.Len(t, s.WaitingRoutes, 1)
+	assert.Equal(t, "default/pager", s.WaitingRoutes[0].Route)
+}
+
+func TestEscalationDue(t *testing.T) {
+	now := time.Now()
+	var s IncidentStatus
+	assert.False(t, s.EscalationDue(now))
+
+	next := metav1.NewTime(now.Add(5 * time.Minute))
+	s.NextEscalationTimestamp = &next
+	assert.False(t, s.EscalationDue(now))
+	assert.True(t, s.EscalationDue(now.Add(5*time.Minute)))
+}
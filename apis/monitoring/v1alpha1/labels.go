@@ -4,5 +4,11 @@ const (
 	LabelKeyAlert            = "monitoring.appscode.com/alert"
 	LabelKeyAlertType        = "monitoring.appscode.com/alert-type"
 	LabelKeyObjectName       = "monitoring.appscode.com/object-name"
+	LabelKeyObjectNamespace  = "monitoring.appscode.com/object-namespace"
 	LabelKeyProblemRecovered = "monitoring.appscode.com/recovered"
+	// Set on incidents with notifications waiting to be sent again
+	LabelKeyDeliveryPending = "monitoring.appscode.com/delivery-pending"
+	// Set on incidents of pods with the node and workload of pod, when incidents are correlated by them
+	LabelKeyNodeName = "monitoring.appscode.com/node-name"
+	LabelKeyWorkload = "monitoring.appscode.com/workload"
 )
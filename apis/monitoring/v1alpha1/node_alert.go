@@ -1,12 +1,14 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -17,7 +19,6 @@ const (
 )
 
 // +genclient
-// +genclient:skipVerbs=updateStatus
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -30,6 +31,11 @@ type NodeAlert struct {
 	// Spec is the desired state of the NodeAlert.
 	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
 	Spec NodeAlertSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the NodeAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -51,6 +57,9 @@ type NodeAlertSpec struct {
 
 	NodeName *string `json:"nodeName,omitempty"`
 
+	// Name of AlertTemplate in the namespace of this alert that holds defaults for this alert
+	TemplateRef *AlertTemplateReference `json:"templateRef,omitempty"`
+
 	// Icinga CheckCommand name
 	Check string `json:"check,omitempty"`
 
@@ -60,6 +69,28 @@ type NodeAlertSpec struct {
 	// How frequently notifications will be send
 	AlertInterval metav1.Duration `json:"alertInterval,omitempty"`
 
+	// Number of times Icinga Service is checked in a soft problem state before it changes to a hard
+	// state and notifications are sent
+	MaxCheckAttempts int32 `json:"maxCheckAttempts,omitempty"`
+
+	// How frequently Icinga Service will be checked while it is in a soft problem state
+	RetryInterval metav1.Duration `json:"retryInterval,omitempty"`
+
+	// Indicates that Icinga detects flapping of the service state
+	EnableFlapping bool `json:"enableFlapping,omitempty"`
+
+	// Flapping ends when the percentage of state changes falls below this threshold
+	FlappingThresholdLow int32 `json:"flappingThresholdLow,omitempty"`
+
+	// Flapping starts when the percentage of state changes rises above this threshold
+	FlappingThresholdHigh int32 `json:"flappingThresholdHigh,omitempty"`
+
+	// How long to wait before the first notification of a problem is sent
+	NotificationDelay metav1.Duration `json:"notificationDelay,omitempty"`
+
+	// Grouping combines the notifications of targets of this alert into digests
+	Grouping *NotificationGrouping `json:"grouping,omitempty"`
+
 	// Secret containing notifier credentials
 	NotifierSecretName string `json:"notifierSecretName,omitempty"`
 
@@ -70,12 +101,16 @@ type NodeAlertSpec struct {
 	// Vars contains Icinga Service variables to be used in CheckCommand
 	Vars map[string]string `json:"vars,omitempty"`
 
+	// DependsOn contains parent alerts of this alert
+	DependsOn []AlertDependency `json:"dependsOn,omitempty"`
+
 	// Indicates that Check is paused
 	// Icinga Services are removed
 	Paused bool `json:"paused,omitempty"`
 }
 
-var _ Alert = &NodeAlert{}
+var _ TemplatedAlert = &NodeAlert{}
+var _ GroupedAlert = &NodeAlert{}
 
 func (a NodeAlert) GetName() string {
 	return a.Name
@@ -97,12 +132,21 @@ func (a NodeAlert) GetAlertInterval() time.Duration {
 	return a.Spec.AlertInterval.Duration
 }
 
+func (a NodeAlert) GetTemplateRef() *AlertTemplateReference {
+	return a.Spec.TemplateRef
+}
+
+func (a *NodeAlert) SetTemplateDefaults(t *AlertTemplate) {
+	t.setDefaults(&a.Spec.Check, &a.Spec.CheckInterval, &a.Spec.AlertInterval, &a.Spec.NotifierSecretName, &a.Spec.Receivers, &a.Spec.Vars)
+}
+
 func (a NodeAlert) IsValid(kc kubernetes.Interface) error {
 	if a.Spec.Paused {
 		return nil
 	}
 
-	if a.Spec.NodeName != nil && len(a.Spec.Selector) > 0 {
+	_, hasSelectorAnnotation := a.Annotations[AnnotationKeyNodeSelector]
+	if a.Spec.NodeName != nil && (len(a.Spec.Selector) > 0 || hasSelectorAnnotation) {
 		return fmt.Errorf("can't specify both node name and selector")
 	}
 
@@ -126,11 +170,39 @@ func (a NodeAlert) IsValid(kc kubernetes.Interface) error {
 		if !found {
 			return fmt.Errorf("state %s is unsupported for check command %s", rcv.State, a.Spec.Check)
 		}
+		if rcv.EscalateAfter != nil && rcv.EscalateAfter.Duration < 0 {
+			return fmt.Errorf("escalateAfter of receiver for state %s can't be negative", rcv.State)
+		}
+	}
+
+	if err := validateDependencies(a.Spec.DependsOn, ResourceKindClusterAlert); err != nil {
+		return err
+	}
+
+	if err := validateCheckAttempts(a.Spec.MaxCheckAttempts, a.Spec.RetryInterval, a.Spec.FlappingThresholdLow, a.Spec.FlappingThresholdHigh, a.Spec.NotificationDelay); err != nil {
+		return err
+	}
+
+	if err := a.Spec.Grouping.isValid(); err != nil {
+		return err
 	}
 
 	return checkNotifiers(kc, a)
 }
 
+// NodeSelector returns the selector for nodes checked by this alert. Selectors of v1beta1 NodeAlert
+// that can't be represented in v1alpha1 spec are read from AnnotationKeyNodeSelector annotation.
+func (a NodeAlert) NodeSelector() (labels.Selector, error) {
+	if data, found := a.Annotations[AnnotationKeyNodeSelector]; found {
+		var selector metav1.LabelSelector
+		if err := json.Unmarshal([]byte(data), &selector); err != nil {
+			return nil, err
+		}
+		return metav1.LabelSelectorAsSelector(&selector)
+	}
+	return labels.SelectorFromSet(a.Spec.Selector), nil
+}
+
 func (a NodeAlert) GetNotifierSecretName() string {
 	return a.Spec.NotifierSecretName
 }
@@ -139,6 +211,10 @@ func (a NodeAlert) GetReceivers() []Receiver {
 	return a.Spec.Receivers
 }
 
+func (a NodeAlert) GetGrouping() *NotificationGrouping {
+	return a.Spec.Grouping
+}
+
 func (a NodeAlert) ObjectReference() *core.ObjectReference {
 	return &core.ObjectReference{
 		APIVersion:      SchemeGroupVersion.String(),
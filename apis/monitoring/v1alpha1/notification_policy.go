@@ -0,0 +1,286 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	ResourceKindNotificationPolicy     = "NotificationPolicy"
+	ResourcePluralNotificationPolicy   = "notificationpolicies"
+	ResourceSingularNotificationPolicy = "notificationpolicy"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=updateStatus
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationPolicy routes notifications of alerts in all namespaces to receivers, in addition to
+// or instead of the receivers of alerts.
+type NotificationPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the NotificationPolicy.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec NotificationPolicySpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NotificationPolicyList is a collection of NotificationPolicy.
+type NotificationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of NotificationPolicy.
+	Items []NotificationPolicy `json:"items"`
+}
+
+type NotificationPolicyMode string
+
+const (
+	// Receivers of matching routes are notified together with the receivers of alert
+	NotificationPolicyModeAppend NotificationPolicyMode = "Append"
+	// Receivers of matching routes are notified instead of the receivers of alert
+	NotificationPolicyModeReplace NotificationPolicyMode = "Replace"
+)
+
+// NotificationPolicySpec describes how notifications are routed to receivers.
+type NotificationPolicySpec struct {
+	// Whether receivers of matching routes are notified in addition to (Append) or instead of (Replace)
+	// the receivers of alert. Default is Append.
+	// +optional
+	Mode NotificationPolicyMode `json:"mode,omitempty"`
+
+	// Secret containing notifier credentials for receivers of routes. Notifier secret of alert is used, if not set.
+	// +optional
+	NotifierSecret *NotifierSecretReference `json:"notifierSecret,omitempty"`
+
+	// Routes are evaluated in order. Evaluation stops at the first matching route, unless continue is set for it.
+	Routes []NotificationRoute `json:"routes"`
+}
+
+// NotifierSecretReference refers to a Secret containing notifier credentials.
+type NotifierSecretReference struct {
+	// Namespace of the Secret
+	Namespace string `json:"namespace"`
+
+	// Name of the Secret
+	Name string `json:"name"`
+}
+
+// NotificationRoute sends the notifications selected by its matcher to its receivers.
+type NotificationRoute struct {
+	// Name of the route, such as pager
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Match selects notifications for this route. Routes without any matcher select all notifications.
+	// +optional
+	Match NotificationMatcher `json:"match,omitempty"`
+
+	// Receivers of notifications selected by this route, but none of its child routes.
+	// Required, if the route has no child routes.
+	// +optional
+	Receivers []Receiver `json:"receivers,omitempty"`
+
+	// Whether routes after this one are evaluated, when it matches a notification
+	// +optional
+	Continue bool `json:"continue,omitempty"`
+
+	// How long a problem must last before receivers of this route are notified. Problems that
+	// recover sooner are not notified to them at all. Child routes inherit it, if they don't set it.
+	// +optional
+	GroupWait metav1.Duration `json:"groupWait,omitempty"`
+
+	// Child routes are evaluated in order for notifications selected by this route, like the routes of
+	// policy. Receivers of this route are notified, if none of them selects a notification.
+	// +optional
+	// +k8s:openapi-gen=false
+	Routes []NotificationRoute `json:"routes,omitempty"`
+}
+
+// NotificationMatcher selects notifications. A notification is selected, if it matches all the fields that are set.
+type NotificationMatcher struct {
+	// Namespaces of alerts. Shell patterns, such as prod-*, are supported.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// Kinds of alerts, such as PodAlert
+	// +optional
+	AlertKinds []string `json:"alertKinds,omitempty"`
+
+	// Names of alerts. Shell patterns, such as pod-*, are supported.
+	// +optional
+	AlertNames []string `json:"alertNames,omitempty"`
+
+	// States of notifications, such as Critical
+	// +optional
+	States []string `json:"states,omitempty"`
+
+	// Selector of labels of target pod, node, service or workload. Notifications of ClusterAlerts
+	// have no target labels.
+	// +optional
+	TargetSelector *metav1.LabelSelector `json:"targetSelector,omitempty"`
+}
+
+func (p NotificationPolicy) IsValid() error {
+	switch p.Spec.Mode {
+	case "", NotificationPolicyModeAppend, NotificationPolicyModeReplace:
+	default:
+		return fmt.Errorf("mode %s is unsupported", p.Spec.Mode)
+	}
+	if p.Spec.NotifierSecret != nil && (p.Spec.NotifierSecret.Namespace == "" || p.Spec.NotifierSecret.Name == "") {
+		return fmt.Errorf("notifierSecret must have both namespace and name")
+	}
+	if len(p.Spec.Routes) == 0 {
+		return fmt.Errorf("routes can't be empty")
+	}
+	return validateRoutes(p.Spec.Routes, "")
+}
+
+// validateRoutes validates routes and their child routes. Routes are identified by their index,
+// prefixed with the indices of their parents, such as 1.0 for the first child of second route.
+func validateRoutes(routes []NotificationRoute, prefix string) error {
+	for i, r := range routes {
+		id := prefix + strconv.Itoa(i)
+		if err := r.Match.isValid(); err != nil {
+			return fmt.Errorf("invalid matcher of route %s. Reason: %v", id, err)
+		}
+		if r.GroupWait.Duration < 0 {
+			return fmt.Errorf("groupWait of route %s can't be negative", id)
+		}
+		if len(r.Receivers) == 0 && len(r.Routes) == 0 {
+			return fmt.Errorf("route %s must have receivers or child routes", id)
+		}
+		for _, rcv := range r.Receivers {
+			if rcv.Notifier == "" {
+				return fmt.Errorf("notifier of receiver for state %s in route %s is empty", rcv.State, id)
+			}
+			if rcv.EscalateAfter != nil && rcv.EscalateAfter.Duration < 0 {
+				return fmt.Errorf("escalateAfter of receiver for state %s in route %s can't be negative", rcv.State, id)
+			}
+		}
+		if err := validateRoutes(r.Routes, id+"."); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m NotificationMatcher) isValid() error {
+	for _, pattern := range append(append([]string{}, m.Namespaces...), m.AlertNames...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %s", pattern)
+		}
+	}
+	for _, kind := range m.AlertKinds {
+		switch kind {
+		case ResourceKindClusterAlert, ResourceKindNodeAlert, ResourceKindPodAlert, ResourceKindServiceAlert, ResourceKindWorkloadAlert:
+		default:
+			return fmt.Errorf("alert kind %s is unsupported", kind)
+		}
+	}
+	if m.TargetSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(m.TargetSelector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Match returns the routes of this policy whose receivers get a notification of alert in state, about
+// a target with labels. Child routes are returned instead of their parent, if any of them matches.
+// Names of returned routes are the paths of routes, such as prod/db, and their groupWait is inherited
+// from their parents, if not set.
+func (p NotificationPolicy) Match(namespace, alertKind, alertName, state string, targetLabels map[string]string) []NotificationRoute {
+	return matchRoutes(p.Spec.Routes, "", metav1.Duration{}, namespace, alertKind, alertName, state, targetLabels)
+}
+
+func matchRoutes(routes []NotificationRoute, prefix string, groupWait metav1.Duration, namespace, alertKind, alertName, state string, targetLabels map[string]string) []NotificationRoute {
+	var out []NotificationRoute
+	for _, r := range routes {
+		if !r.Match.Matches(namespace, alertKind, alertName, state, targetLabels) {
+			continue
+		}
+		r.Name = prefix + r.Name
+		if r.GroupWait.Duration == 0 {
+			r.GroupWait = groupWait
+		}
+		if children := matchRoutes(r.Routes, r.Name+"/", r.GroupWait, namespace, alertKind, alertName, state, targetLabels); len(children) > 0 {
+			out = append(out, children...)
+		} else {
+			r.Routes = nil
+			out = append(out, r)
+		}
+		if !r.Continue {
+			break
+		}
+	}
+	return out
+}
+
+// Matches returns true, if a notification of alert in state, about a target with labels is selected.
+func (m NotificationMatcher) Matches(namespace, alertKind, alertName, state string, targetLabels map[string]string) bool {
+	if len(m.Namespaces) > 0 && !matchesAny(m.Namespaces, namespace) {
+		return false
+	}
+	if len(m.AlertKinds) > 0 && !sets.NewString(m.AlertKinds...).Has(alertKind) {
+		return false
+	}
+	if len(m.AlertNames) > 0 && !matchesAny(m.AlertNames, alertName) {
+		return false
+	}
+	if len(m.States) > 0 {
+		found := false
+		for _, s := range m.States {
+			if strings.EqualFold(s, state) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if m.TargetSelector != nil {
+		sel, err := metav1.LabelSelectorAsSelector(m.TargetSelector)
+		if err != nil || !sel.Matches(labels.Set(targetLabels)) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesAny(patterns []string, s string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (p NotificationPolicy) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindNotificationPolicy,
+		Name:            p.Name,
+		UID:             p.UID,
+		ResourceVersion: p.ResourceVersion,
+	}
+}
@@ -0,0 +1,132 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNotificationPolicyMatch(t *testing.T) {
+	p := NotificationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: NotificationPolicySpec{
+			Routes: []NotificationRoute{
+				{
+					Name: "pager",
+					Match: NotificationMatcher{
+						Namespaces: []string{"prod-*"},
+						States:     []string{"Critical"},
+					},
+					Receivers: []Receiver{{State: "Critical", To: []string{"+1-234-567-8901"}, Notifier: "Twilio"}},
+					Continue:  true,
+				},
+				{
+					Name: "database",
+					Match: NotificationMatcher{
+						AlertKinds:     []string{ResourceKindPodAlert},
+						TargetSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "postgres"}},
+					},
+					Receivers: []Receiver{{State: "Critical", To: []string{"dba@example.com"}, Notifier: "Mailgun"}},
+				},
+				{
+					Name:      "chat",
+					Receivers: []Receiver{{State: "Warning", To: []string{"#alerts"}, Notifier: "Slack"}},
+				},
+			},
+		},
+	}
+	assert.Nil(t, p.IsValid())
+
+	names := func(routes []NotificationRoute) []string {
+		var out []string
+		for _, r := range routes {
+			out = append(out, r.Name)
+		}
+		return out
+	}
+	postgres := map[string]string{"app": "postgres"}
+
+	assert.Equal(t, []string{"pager", "database"}, names(p.Match("prod-payments", ResourceKindPodAlert, "pod-exec", "Critical", postgres)))
+	assert.Equal(t, []string{"pager", "chat"}, names(p.Match("prod-payments", ResourceKindNodeAlert, "node-disk", "critical", nil)))
+	assert.Equal(t, []string{"database"}, names(p.Match("staging", ResourceKindPodAlert, "pod-exec", "Warning", postgres)))
+	assert.Equal(t, []string{"chat"}, names(p.Match("staging", ResourceKindClusterAlert, "ca-cert", "Warning", nil)))
+
+	p.Spec.Routes[0].Match.AlertKinds = []string{"Pod"}
+	assert.NotNil(t, p.IsValid())
+}
+
+func TestNotificationPolicyMatchChildRoutes(t *testing.T) {
+	p := NotificationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: NotificationPolicySpec{
+			Routes: []NotificationRoute{
+				{
+					Name:      "prod",
+					Match:     NotificationMatcher{Namespaces: []string{"prod-*"}},
+					GroupWait: metav1.Duration{Duration: 5 * time.Minute},
+					Receivers: []Receiver{{State: "Critical", To: []string{"ops@example.com"}, Notifier: "Mailgun"}},
+					Routes: []NotificationRoute{
+						{
+							Name:      "db",
+							Match:     NotificationMatcher{AlertNames: []string{"pg-*"}},
+							Receivers: []Receiver{{State: "Critical", To: []string{"dba@example.com"}, Notifier: "Mailgun"}},
+							Continue:  true,
+						},
+						{
+							Name:      "pager",
+							Match:     NotificationMatcher{States: []string{"Critical"}},
+							GroupWait: metav1.Duration{Duration: time.Minute},
+							Receivers: []Receiver{{State: "Critical", To: []string{"+1-234-567-8901"}, Notifier: "Twilio"}},
+						},
+					},
+				},
+				{
+					Name:      "chat",
+					Receivers: []Receiver{{State: "Warning", To: []string{"#alerts"}, Notifier: "Slack"}},
+				},
+			},
+		},
+	}
+	assert.Nil(t, p.IsValid())
+
+	type route struct {
+		name      string
+		groupWait time.Duration
+	}
+	routes := func(routes []NotificationRoute) []route {
+		var out []route
+		for _, r := range routes {
+			out = append(out, route{r.Name, r.GroupWait.Duration})
+		}
+		return out
+	}
+
+	cases := []struct {
+		name      string
+		namespace string
+		alertName string
+		state     string
+		expected  []route
+	}{
+		{"first match of children wins unless continue", "prod-payments", "pg-exec", "Critical", []route{{"prod/db", 5 * time.Minute}, {"prod/pager", time.Minute}}},
+		{"child route", "prod-payments", "pod-exec", "Critical", []route{{"prod/pager", time.Minute}}},
+		{"parent without matching child", "prod-payments", "pod-exec", "Warning", []route{{"prod", 5 * time.Minute}}},
+		{"parent stops evaluation", "prod-payments", "pg-exec", "Warning", []route{{"prod/db", 5 * time.Minute}}},
+		{"other route", "staging", "pg-exec", "Warning", []route{{"chat", 0}}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, routes(p.Match(c.namespace, ResourceKindPodAlert, c.alertName, c.state, nil)))
+		})
+	}
+
+	// routes need receivers, unless they have child routes
+	p.Spec.Routes[0].Receivers = nil
+	assert.Nil(t, p.IsValid())
+	p.Spec.Routes[0].Routes[1].Receivers = nil
+	assert.EqualError(t, p.IsValid(), "route 0.1 must have receivers or child routes")
+	p.Spec.Routes[0].Routes[1].Routes = []NotificationRoute{{Match: NotificationMatcher{AlertKinds: []string{"Pod"}}, Receivers: p.Spec.Routes[1].Receivers}}
+	assert.EqualError(t, p.IsValid(), "invalid matcher of route 0.1.0. Reason: alert kind Pod is unsupported")
+}
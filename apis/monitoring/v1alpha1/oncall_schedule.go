@@ -0,0 +1,248 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindOnCallSchedule     = "OnCallSchedule"
+	ResourcePluralOnCallSchedule   = "oncallschedules"
+	ResourceSingularOnCallSchedule = "oncallschedule"
+
+	// DefaultRotationLength is used for layers of OnCallSchedule without rotationLength.
+	DefaultRotationLength = 7 * 24 * time.Hour
+)
+
+// +genclient
+// +genclient:skipVerbs=updateStatus
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OnCallSchedule rotates the contacts who are on call. Receivers of alerts in its namespace
+// refer to it via onCallSchedule, so notifications are sent to whoever is on call at that time.
+type OnCallSchedule struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the OnCallSchedule.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec OnCallScheduleSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OnCallScheduleList is a collection of OnCallSchedule.
+type OnCallScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of OnCallSchedule.
+	Items []OnCallSchedule `json:"items"`
+}
+
+// OnCallScheduleSpec describes the rotations of an OnCallSchedule.
+type OnCallScheduleSpec struct {
+	// Name of the time zone of handoffs, such as America/New_York. Default is UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// Layers of rotation. The contacts on call in every layer that has started are on call together,
+	// such as a primary and a secondary on-call.
+	Layers []OnCallLayer `json:"layers"`
+
+	// Overrides put other contacts on call for a while, such as someone covering a vacation.
+	// +optional
+	Overrides []OnCallOverride `json:"overrides,omitempty"`
+}
+
+// OnCallLayer is a rotation of contacts who take turns to be on call.
+type OnCallLayer struct {
+	// Name of the layer, such as primary. Used by overrides to replace the contact of this layer.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Contacts in order of rotation, such as email addresses or phone numbers
+	Users []string `json:"users"`
+
+	// Time at which the first contact goes on call
+	Start metav1.Time `json:"start"`
+
+	// How long each contact stays on call, such as 24h. Default is one week.
+	// +optional
+	RotationLength metav1.Duration `json:"rotationLength,omitempty"`
+
+	// Time of day, such as 09:00, at which a contact hands off to the next one in the time zone
+	// of schedule. It is used when rotationLength is a multiple of 24h, and defaults to the time
+	// of day of start. Otherwise, handoffs happen every rotationLength from start.
+	// +optional
+	HandoffTime string `json:"handoffTime,omitempty"`
+}
+
+// OnCallOverride puts its contacts on call instead of the ones in layers, between start and end.
+type OnCallOverride struct {
+	// Name of the layer whose contact is replaced. Contacts of all layers are replaced, if not set.
+	// +optional
+	Layer string `json:"layer,omitempty"`
+
+	// Contacts on call during this override
+	Users []string `json:"users"`
+
+	// Time at which this override starts
+	Start metav1.Time `json:"start"`
+
+	// Time at which this override ends
+	End metav1.Time `json:"end"`
+}
+
+func (s OnCallSchedule) IsValid() error {
+	if _, err := s.location(); err != nil {
+		return err
+	}
+	if len(s.Spec.Layers) == 0 {
+		return fmt.Errorf("layers can't be empty")
+	}
+	layers := map[string]bool{}
+	for i, l := range s.Spec.Layers {
+		if len(l.Users) == 0 {
+			return fmt.Errorf("users of layer %d can't be empty", i)
+		}
+		if l.Start.IsZero() {
+			return fmt.Errorf("start of layer %d must be set", i)
+		}
+		if l.RotationLength.Duration < 0 {
+			return fmt.Errorf("rotationLength of layer %d can't be negative", i)
+		}
+		if l.HandoffTime != "" {
+			if _, err := time.Parse("15:04", l.HandoffTime); err != nil {
+				return fmt.Errorf("handoffTime of layer %d must be in HH:MM format", i)
+			}
+		}
+		if l.Name != "" {
+			layers[l.Name] = true
+		}
+	}
+	for i, o := range s.Spec.Overrides {
+		if len(o.Users) == 0 {
+			return fmt.Errorf("users of override %d can't be empty", i)
+		}
+		if !o.End.After(o.Start.Time) {
+			return fmt.Errorf("end of override %d must be after start", i)
+		}
+		if o.Layer != "" && !layers[o.Layer] {
+			return fmt.Errorf("override %d refers to unknown layer %s", i, o.Layer)
+		}
+	}
+	return nil
+}
+
+func (s OnCallSchedule) location() (*time.Location, error) {
+	if s.Spec.TimeZone == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(s.Spec.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timeZone %s. Reason: %v", s.Spec.TimeZone, err)
+	}
+	return loc, nil
+}
+
+// OnCall returns the contacts on call at t.
+func (s OnCallSchedule) OnCall(t time.Time) ([]string, error) {
+	loc, err := s.location()
+	if err != nil {
+		return nil, err
+	}
+	if o, found := s.override("", t); found {
+		return o.Users, nil
+	}
+
+	var contacts []string
+	seen := map[string]bool{}
+	for _, l := range s.Spec.Layers {
+		var users []string
+		if o, found := s.override(l.Name, t); l.Name != "" && found {
+			users = o.Users
+		} else if user, found := l.onCall(t, loc); found {
+			users = []string{user}
+		}
+		for _, u := range users {
+			if !seen[u] {
+				seen[u] = true
+				contacts = append(contacts, u)
+			}
+		}
+	}
+	return contacts, nil
+}
+
+// override returns the override of layer that is active at t.
+func (s OnCallSchedule) override(layer string, t time.Time) (OnCallOverride, bool) {
+	for _, o := range s.Spec.Overrides {
+		if o.Layer == layer && !t.Before(o.Start.Time) && t.Before(o.End.Time) {
+			return o, true
+		}
+	}
+	return OnCallOverride{}, false
+}
+
+// onCall returns the contact of layer on call at t, if the layer has started.
+func (l OnCallLayer) onCall(t time.Time, loc *time.Location) (string, bool) {
+	if len(l.Users) == 0 || t.Before(l.Start.Time) {
+		return "", false
+	}
+	return l.Users[l.rotation(t, loc)%len(l.Users)], true
+}
+
+// rotation returns the number of handoffs in layer from start until t.
+func (l OnCallLayer) rotation(t time.Time, loc *time.Location) int {
+	length := l.RotationLength.Duration
+	if length == 0 {
+		length = DefaultRotationLength
+	}
+	if length%(24*time.Hour) != 0 {
+		return int(t.Sub(l.Start.Time) / length)
+	}
+
+	// handoffs happen at the same time of day in loc, even if the offset of loc changes
+	days := int(length / (24 * time.Hour))
+	start := l.Start.In(loc)
+	hour, min := start.Hour(), start.Minute()
+	if h, err := time.Parse("15:04", l.HandoffTime); err == nil {
+		hour, min = h.Hour(), h.Minute()
+	}
+	first := time.Date(start.Year(), start.Month(), start.Day(), hour, min, 0, 0, loc)
+	if !first.After(start) {
+		first = first.AddDate(0, 0, days)
+	}
+	if t.Before(first) {
+		return 0
+	}
+
+	t = t.In(loc)
+	elapsed := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).
+		Sub(time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC))
+	n := int(elapsed/(24*time.Hour)) / days
+	if first.AddDate(0, 0, n*days).After(t) {
+		n--
+	}
+	return n + 1
+}
+
+func (s OnCallSchedule) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindOnCallSchedule,
+		Namespace:       s.Namespace,
+		Name:            s.Name,
+		UID:             s.UID,
+		ResourceVersion: s.ResourceVersion,
+	}
+}
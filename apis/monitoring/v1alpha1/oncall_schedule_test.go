@@ -0,0 +1,105 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestOnCall(t *testing.T) {
+	start := time.Date(2019, 1, 7, 9, 0, 0, 0, time.UTC)
+	s := OnCallSchedule{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments", Namespace: "demo"},
+		Spec: OnCallScheduleSpec{
+			Layers: []OnCallLayer{
+				{
+					Name:  "primary",
+					Users: []string{"alice@example.com", "bob@example.com", "carol@example.com"},
+					Start: metav1.NewTime(start),
+				},
+				{
+					Name:           "secondary",
+					Users:          []string{"dave@example.com", "erin@example.com"},
+					Start:          metav1.NewTime(start.Add(7 * 24 * time.Hour)),
+					RotationLength: metav1.Duration{Duration: 12 * time.Hour},
+				},
+			},
+			Overrides: []OnCallOverride{
+				{
+					Layer: "primary",
+					Users: []string{"frank@example.com"},
+					Start: metav1.NewTime(start.Add(28 * 24 * time.Hour)),
+					End:   metav1.NewTime(start.Add(29 * 24 * time.Hour)),
+				},
+				{
+					Users: []string{"grace@example.com"},
+					Start: metav1.NewTime(start.Add(35 * 24 * time.Hour)),
+					End:   metav1.NewTime(start.Add(36 * 24 * time.Hour)),
+				},
+			},
+		},
+	}
+	assert.Nil(t, s.IsValid())
+
+	cases := []struct {
+		name     string
+		at       time.Time
+		contacts []string
+	}{
+		{"before start", start.Add(-time.Minute), nil},
+		{"first rotation", start.Add(6 * 24 * time.Hour), []string{"alice@example.com"}},
+		{"handoff", start.Add(7 * 24 * time.Hour), []string{"bob@example.com", "dave@example.com"}},
+		{"second rotation of secondary", start.Add(7*24*time.Hour + 12*time.Hour), []string{"bob@example.com", "erin@example.com"}},
+		{"fourth rotation", start.Add(21*24*time.Hour + time.Hour), []string{"alice@example.com", "dave@example.com"}},
+		{"layer override", start.Add(28*24*time.Hour + 13*time.Hour), []string{"frank@example.com", "erin@example.com"}},
+		{"schedule override", start.Add(35*24*time.Hour + time.Hour), []string{"grace@example.com"}},
+	}
+	for _, c := range cases {
+		contacts, err := s.OnCall(c.at)
+		assert.Nil(t, err, c.name)
+		assert.Equal(t, c.contacts, contacts, c.name)
+	}
+
+	s.Spec.Overrides[0].Layer = "tertiary"
+	assert.NotNil(t, s.IsValid())
+}
+
+func TestOnCallHandoffTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	// daylight saving time starts in New York on 2019-03-10
+	s := OnCallSchedule{
+		Spec: OnCallScheduleSpec{
+			TimeZone: "America/New_York",
+			Layers: []OnCallLayer{
+				{
+					Users:          []string{"alice@example.com", "bob@example.com"},
+					Start:          metav1.NewTime(time.Date(2019, 3, 8, 17, 0, 0, 0, loc)),
+					RotationLength: metav1.Duration{Duration: 24 * time.Hour},
+					HandoffTime:    "09:00",
+				},
+			},
+		},
+	}
+	assert.Nil(t, s.IsValid())
+
+	cases := []struct {
+		at      time.Time
+		contact string
+	}{
+		{time.Date(2019, 3, 9, 8, 59, 0, 0, loc), "alice@example.com"},
+		{time.Date(2019, 3, 9, 9, 0, 0, 0, loc), "bob@example.com"},
+		{time.Date(2019, 3, 10, 8, 30, 0, 0, loc), "bob@example.com"},
+		{time.Date(2019, 3, 10, 9, 0, 0, 0, loc), "alice@example.com"},
+		{time.Date(2019, 3, 11, 9, 0, 0, 0, loc), "bob@example.com"},
+	}
+	for _, c := range cases {
+		contacts, err := s.OnCall(c.at)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{c.contact}, contacts, c.at.String())
+	}
+}
@@ -32,289 +32,272 @@ import (
 
 func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenAPIDefinition {
 	return map[string]common.OpenAPIDefinition{
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlert":          schema_searchlight_apis_monitoring_v1alpha1_ClusterAlert(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertList":      schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertList(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertSpec":      schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertSpec(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IcingaCommand":         schema_searchlight_apis_monitoring_v1alpha1_IcingaCommand(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Incident":              schema_searchlight_apis_monitoring_v1alpha1_Incident(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentList":          schema_searchlight_apis_monitoring_v1alpha1_IncidentList(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentNotification":  schema_searchlight_apis_monitoring_v1alpha1_IncidentNotification(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentStatus":        schema_searchlight_apis_monitoring_v1alpha1_IncidentStatus(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlert":             schema_searchlight_apis_monitoring_v1alpha1_NodeAlert(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertList":         schema_searchlight_apis_monitoring_v1alpha1_NodeAlertList(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertSpec":         schema_searchlight_apis_monitoring_v1alpha1_NodeAlertSpec(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginArguments":       schema_searchlight_apis_monitoring_v1alpha1_PluginArguments(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVarField":        schema_searchlight_apis_monitoring_v1alpha1_PluginVarField(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars":            schema_searchlight_apis_monitoring_v1alpha1_PluginVars(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlert":              schema_searchlight_apis_monitoring_v1alpha1_PodAlert(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertList":          schema_searchlight_apis_monitoring_v1alpha1_PodAlertList(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertSpec":          schema_searchlight_apis_monitoring_v1alpha1_PodAlertSpec(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver":              schema_searchlight_apis_monitoring_v1alpha1_Receiver(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Registry":              schema_searchlight_apis_monitoring_v1alpha1_Registry(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPlugin":     schema_searchlight_apis_monitoring_v1alpha1_SearchlightPlugin(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginList": schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginList(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginSpec": schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginSpec(ref),
-		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.WebhookServiceSpec":    schema_searchlight_apis_monitoring_v1alpha1_WebhookServiceSpec(ref),
-		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                  schema_apimachinery_pkg_api_resource_Quantity(ref),
-		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                               schema_apimachinery_pkg_api_resource_int64Amount(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                  schema_pkg_apis_meta_v1_APIGroup(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                              schema_pkg_apis_meta_v1_APIGroupList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                               schema_pkg_apis_meta_v1_APIResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                           schema_pkg_apis_meta_v1_APIResourceList(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                               schema_pkg_apis_meta_v1_APIVersions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                             schema_pkg_apis_meta_v1_CreateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                             schema_pkg_apis_meta_v1_DeleteOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                  schema_pkg_apis_meta_v1_Duration(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                             schema_pkg_apis_meta_v1_ExportOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                                    schema_pkg_apis_meta_v1_Fields(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                schema_pkg_apis_meta_v1_GetOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                 schema_pkg_apis_meta_v1_GroupKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                             schema_pkg_apis_meta_v1_GroupResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                              schema_pkg_apis_meta_v1_GroupVersion(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                  schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                          schema_pkg_apis_meta_v1_GroupVersionKind(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                      schema_pkg_apis_meta_v1_GroupVersionResource(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                               schema_pkg_apis_meta_v1_Initializer(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                              schema_pkg_apis_meta_v1_Initializers(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                             schema_pkg_apis_meta_v1_InternalEvent(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                             schema_pkg_apis_meta_v1_LabelSelector(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                  schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                      schema_pkg_apis_meta_v1_List(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                  schema_pkg_apis_meta_v1_ListMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                               schema_pkg_apis_meta_v1_ListOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                        schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                 schema_pkg_apis_meta_v1_MicroTime(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                schema_pkg_apis_meta_v1_ObjectMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                            schema_pkg_apis_meta_v1_OwnerReference(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                     schema_pkg_apis_meta_v1_Patch(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                              schema_pkg_apis_meta_v1_PatchOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                             schema_pkg_apis_meta_v1_Preconditions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                 schema_pkg_apis_meta_v1_RootPaths(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                 schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                    schema_pkg_apis_meta_v1_Status(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                               schema_pkg_apis_meta_v1_StatusCause(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                             schema_pkg_apis_meta_v1_StatusDetails(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                      schema_pkg_apis_meta_v1_Time(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                 schema_pkg_apis_meta_v1_Timestamp(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                  schema_pkg_apis_meta_v1_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                             schema_pkg_apis_meta_v1_UpdateOptions(ref),
-		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                schema_pkg_apis_meta_v1_WatchEvent(ref),
-		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                   schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
-		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                       schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
-		"k8s.io/apimachinery/pkg/runtime.Unknown":                                        schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
-		"k8s.io/apimachinery/pkg/version.Info":                                           schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertCondition":              schema_searchlight_apis_monitoring_v1alpha1_AlertCondition(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertDependency":             schema_searchlight_apis_monitoring_v1alpha1_AlertDependency(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus":                 schema_searchlight_apis_monitoring_v1alpha1_AlertStatus(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplate":               schema_searchlight_apis_monitoring_v1alpha1_AlertTemplate(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateList":           schema_searchlight_apis_monitoring_v1alpha1_AlertTemplateList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateReference":      schema_searchlight_apis_monitoring_v1alpha1_AlertTemplateReference(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateSpec":           schema_searchlight_apis_monitoring_v1alpha1_AlertTemplateSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlert":                schema_searchlight_apis_monitoring_v1alpha1_ClusterAlert(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertList":            schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertSpec":            schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.CorrelatedIncidentReference": schema_searchlight_apis_monitoring_v1alpha1_CorrelatedIncidentReference(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Downtime":                    schema_searchlight_apis_monitoring_v1alpha1_Downtime(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeList":                schema_searchlight_apis_monitoring_v1alpha1_DowntimeList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeRecurrence":          schema_searchlight_apis_monitoring_v1alpha1_DowntimeRecurrence(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeSpec":                schema_searchlight_apis_monitoring_v1alpha1_DowntimeSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeStatus":              schema_searchlight_apis_monitoring_v1alpha1_DowntimeStatus(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.GroupedTarget":               schema_searchlight_apis_monitoring_v1alpha1_GroupedTarget(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IcingaCommand":               schema_searchlight_apis_monitoring_v1alpha1_IcingaCommand(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Incident":                    schema_searchlight_apis_monitoring_v1alpha1_Incident(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentList":                schema_searchlight_apis_monitoring_v1alpha1_IncidentList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentNotification":        schema_searchlight_apis_monitoring_v1alpha1_IncidentNotification(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentStatus":              schema_searchlight_apis_monitoring_v1alpha1_IncidentStatus(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.LocalSecretReference":        schema_searchlight_apis_monitoring_v1alpha1_LocalSecretReference(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlert":                   schema_searchlight_apis_monitoring_v1alpha1_NodeAlert(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertList":               schema_searchlight_apis_monitoring_v1alpha1_NodeAlertList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertSpec":               schema_searchlight_apis_monitoring_v1alpha1_NodeAlertSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationDelivery":        schema_searchlight_apis_monitoring_v1alpha1_NotificationDelivery(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGroup":           schema_searchlight_apis_monitoring_v1alpha1_NotificationGroup(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping":        schema_searchlight_apis_monitoring_v1alpha1_NotificationGrouping(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationMatcher":         schema_searchlight_apis_monitoring_v1alpha1_NotificationMatcher(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationMessage":         schema_searchlight_apis_monitoring_v1alpha1_NotificationMessage(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicy":          schema_searchlight_apis_monitoring_v1alpha1_NotificationPolicy(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicyList":      schema_searchlight_apis_monitoring_v1alpha1_NotificationPolicyList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicySpec":      schema_searchlight_apis_monitoring_v1alpha1_NotificationPolicySpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationRoute":           schema_searchlight_apis_monitoring_v1alpha1_NotificationRoute(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotifierSecretReference":     schema_searchlight_apis_monitoring_v1alpha1_NotifierSecretReference(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallLayer":                 schema_searchlight_apis_monitoring_v1alpha1_OnCallLayer(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallOverride":              schema_searchlight_apis_monitoring_v1alpha1_OnCallOverride(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallSchedule":              schema_searchlight_apis_monitoring_v1alpha1_OnCallSchedule(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallScheduleList":          schema_searchlight_apis_monitoring_v1alpha1_OnCallScheduleList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallScheduleSpec":          schema_searchlight_apis_monitoring_v1alpha1_OnCallScheduleSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginArguments":             schema_searchlight_apis_monitoring_v1alpha1_PluginArguments(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVarField":              schema_searchlight_apis_monitoring_v1alpha1_PluginVarField(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars":                  schema_searchlight_apis_monitoring_v1alpha1_PluginVars(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlert":                    schema_searchlight_apis_monitoring_v1alpha1_PodAlert(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertList":                schema_searchlight_apis_monitoring_v1alpha1_PodAlertList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertSpec":                schema_searchlight_apis_monitoring_v1alpha1_PodAlertSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver":                    schema_searchlight_apis_monitoring_v1alpha1_Receiver(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Registry":                    schema_searchlight_apis_monitoring_v1alpha1_Registry(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPlugin":           schema_searchlight_apis_monitoring_v1alpha1_SearchlightPlugin(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginList":       schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginSpec":       schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlert":                schema_searchlight_apis_monitoring_v1alpha1_ServiceAlert(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlertList":            schema_searchlight_apis_monitoring_v1alpha1_ServiceAlertList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlertSpec":            schema_searchlight_apis_monitoring_v1alpha1_ServiceAlertSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Silence":                     schema_searchlight_apis_monitoring_v1alpha1_Silence(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SilenceList":                 schema_searchlight_apis_monitoring_v1alpha1_SilenceList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SilenceSpec":                 schema_searchlight_apis_monitoring_v1alpha1_SilenceSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.TargetStatus":                schema_searchlight_apis_monitoring_v1alpha1_TargetStatus(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.WaitingRoute":                schema_searchlight_apis_monitoring_v1alpha1_WaitingRoute(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.WebhookServiceSpec":          schema_searchlight_apis_monitoring_v1alpha1_WebhookServiceSpec(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlert":               schema_searchlight_apis_monitoring_v1alpha1_WorkloadAlert(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlertList":           schema_searchlight_apis_monitoring_v1alpha1_WorkloadAlertList(ref),
+		"github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlertSpec":           schema_searchlight_apis_monitoring_v1alpha1_WorkloadAlertSpec(ref),
+		"k8s.io/apimachinery/pkg/api/resource.Quantity":                                        schema_apimachinery_pkg_api_resource_Quantity(ref),
+		"k8s.io/apimachinery/pkg/api/resource.int64Amount":                                     schema_apimachinery_pkg_api_resource_int64Amount(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroup":                                        schema_pkg_apis_meta_v1_APIGroup(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIGroupList":                                    schema_pkg_apis_meta_v1_APIGroupList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResource":                                     schema_pkg_apis_meta_v1_APIResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIResourceList":                                 schema_pkg_apis_meta_v1_APIResourceList(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.APIVersions":                                     schema_pkg_apis_meta_v1_APIVersions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.CreateOptions":                                   schema_pkg_apis_meta_v1_CreateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.DeleteOptions":                                   schema_pkg_apis_meta_v1_DeleteOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Duration":                                        schema_pkg_apis_meta_v1_Duration(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ExportOptions":                                   schema_pkg_apis_meta_v1_ExportOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Fields":                                          schema_pkg_apis_meta_v1_Fields(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GetOptions":                                      schema_pkg_apis_meta_v1_GetOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind":                                       schema_pkg_apis_meta_v1_GroupKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupResource":                                   schema_pkg_apis_meta_v1_GroupResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersion":                                    schema_pkg_apis_meta_v1_GroupVersion(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionForDiscovery":                        schema_pkg_apis_meta_v1_GroupVersionForDiscovery(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionKind":                                schema_pkg_apis_meta_v1_GroupVersionKind(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.GroupVersionResource":                            schema_pkg_apis_meta_v1_GroupVersionResource(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializer":                                     schema_pkg_apis_meta_v1_Initializer(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Initializers":                                    schema_pkg_apis_meta_v1_Initializers(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.InternalEvent":                                   schema_pkg_apis_meta_v1_InternalEvent(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector":                                   schema_pkg_apis_meta_v1_LabelSelector(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelectorRequirement":                        schema_pkg_apis_meta_v1_LabelSelectorRequirement(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.List":                                            schema_pkg_apis_meta_v1_List(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta":                                        schema_pkg_apis_meta_v1_ListMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ListOptions":                                     schema_pkg_apis_meta_v1_ListOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ManagedFieldsEntry":                              schema_pkg_apis_meta_v1_ManagedFieldsEntry(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime":                                       schema_pkg_apis_meta_v1_MicroTime(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta":                                      schema_pkg_apis_meta_v1_ObjectMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.OwnerReference":                                  schema_pkg_apis_meta_v1_OwnerReference(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Patch":                                           schema_pkg_apis_meta_v1_Patch(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.PatchOptions":                                    schema_pkg_apis_meta_v1_PatchOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Preconditions":                                   schema_pkg_apis_meta_v1_Preconditions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.RootPaths":                                       schema_pkg_apis_meta_v1_RootPaths(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.ServerAddressByClientCIDR":                       schema_pkg_apis_meta_v1_ServerAddressByClientCIDR(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Status":                                          schema_pkg_apis_meta_v1_Status(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusCause":                                     schema_pkg_apis_meta_v1_StatusCause(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.StatusDetails":                                   schema_pkg_apis_meta_v1_StatusDetails(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Time":                                            schema_pkg_apis_meta_v1_Time(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.Timestamp":                                       schema_pkg_apis_meta_v1_Timestamp(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.TypeMeta":                                        schema_pkg_apis_meta_v1_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.UpdateOptions":                                   schema_pkg_apis_meta_v1_UpdateOptions(ref),
+		"k8s.io/apimachinery/pkg/apis/meta/v1.WatchEvent":                                      schema_pkg_apis_meta_v1_WatchEvent(ref),
+		"k8s.io/apimachinery/pkg/runtime.RawExtension":                                         schema_k8sio_apimachinery_pkg_runtime_RawExtension(ref),
+		"k8s.io/apimachinery/pkg/runtime.TypeMeta":                                             schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
+		"k8s.io/apimachinery/pkg/runtime.Unknown":                                              schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
+		"k8s.io/apimachinery/pkg/version.Info":                                                 schema_k8sio_apimachinery_pkg_version_Info(ref),
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_ClusterAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_AlertCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Type of alert condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Status of the condition, one of True, False, Unknown.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"spec": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the desired state of the ClusterAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
-							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertSpec"),
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_AlertDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAlertList is a collection of ClusterAlert.",
+				Description: "AlertDependency is a parent alert. Notifications of an alert are suppressed while the Icinga service of its parent alert is Critical or Unknown.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Kind of parent alert, such as NodeAlert or ClusterAlert",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Name of parent alert in the namespace of this alert",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ClusterAlert.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlert"),
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"items"},
+				Required: []string{"kind", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_AlertStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAlertSpec describes the ClusterAlert the user wishes to create.",
+				Description: "AlertStatus is the most recently observed status of a ClusterAlert, NodeAlert, PodAlert, ServiceAlert or WorkloadAlert.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"check": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Icinga CheckCommand name",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"checkInterval": {
-						SchemaProps: spec.SchemaProps{
-							Description: "How frequently Icinga Service will be checked",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"alertInterval": {
-						SchemaProps: spec.SchemaProps{
-							Description: "How frequently notifications will be send",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"notifierSecretName": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Secret containing notifier credentials",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ObservedGeneration is the most recent generation observed for this alert. It corresponds to the alert's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"receivers": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NotifierParams contains information to send notifications for Incident State, UserUid, Method",
+							Description: "Conditions represent the latest available observations of the alert's current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertCondition"),
 									},
 								},
 							},
 						},
 					},
-					"vars": {
+					"matchedTargets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Vars contains Icinga Service variables to be used in CheckCommand",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Number of targets (pods, nodes or cluster) this alert is applied to",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Current Icinga state of each target",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.TargetStatus"),
 									},
 								},
 							},
 						},
 					},
-					"paused": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Indicates that Check is paused Icinga Services are removed",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
-	}
-}
-
-func schema_searchlight_apis_monitoring_v1alpha1_IcingaCommand(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"Name": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"Vars": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"),
-						},
-					},
-					"States": {
+					"notificationGroups": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Groups of targets whose notifications are combined into digests",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGroup"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"Name", "Vars", "States"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertCondition", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGroup", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.TargetStatus"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_Incident(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_AlertTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AlertTemplate holds defaults for ClusterAlerts, NodeAlerts and PodAlerts in its namespace that reference it via templateRef. Fields set in an alert override the ones in its template.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -336,25 +319,25 @@ func schema_searchlight_apis_monitoring_v1alpha1_Incident(ref common.ReferenceCa
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"status": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Derived information about the incident.",
-							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentStatus"),
+							Description: "Spec is the desired state of the AlertTemplate. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_IncidentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_AlertTemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IncidentList is a collection of Incident.",
+				Description: "AlertTemplateList is a collection of AlertTemplate.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -379,12 +362,12 @@ func schema_searchlight_apis_monitoring_v1alpha1_IncidentList(ref common.Referen
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Incident.",
+							Description: "Items is the list of AlertTemplate.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Incident"),
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplate"),
 									},
 								},
 							},
@@ -395,108 +378,101 @@ func schema_searchlight_apis_monitoring_v1alpha1_IncidentList(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Incident", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_IncidentNotification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_AlertTemplateReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "AlertTemplateReference refers to an AlertTemplate in the namespace of an alert.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "incident notification type.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"checkOutput": {
-						SchemaProps: spec.SchemaProps{
-							Description: "brief output of check command for the incident",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"author": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name of user making comment",
+							Description: "Name of AlertTemplate",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"comment": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_AlertTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlertTemplateSpec describes the defaults of alerts that reference the AlertTemplate.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"check": {
 						SchemaProps: spec.SchemaProps{
-							Description: "comment made by user",
+							Description: "Icinga CheckCommand name",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"firstTimestamp": {
+					"checkInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The time at which this notification was first recorded. (Time of server receipt is in TypeMeta.)",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "How frequently Icinga Service will be checked",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"lastTimestamp": {
+					"alertInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The time at which the most recent occurrence of this notification was recorded.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "How frequently notifications will be send",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"state": {
+					"notifierSecretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "state of incident, such as Critical, Warning, OK, Unknown",
+							Description: "Secret containing notifier credentials",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"type", "state"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_searchlight_apis_monitoring_v1alpha1_IncidentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"lastNotificationType": {
+					"receivers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of last notification, such as problem, acknowledgement, recovery or custom",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Receivers are used by alerts that have no receivers of their own",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
+									},
+								},
+							},
 						},
 					},
-					"notifications": {
+					"vars": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Notifications for the incident, such as problem or acknowledgement.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Vars contains Icinga Service variables to be used in CheckCommand. Vars of an alert are merged with these, vars of the alert take precedence.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentNotification"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"lastNotificationType"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentNotification"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_NodeAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_ClusterAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -524,23 +500,29 @@ func schema_searchlight_apis_monitoring_v1alpha1_NodeAlert(ref common.ReferenceC
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the desired state of the NodeAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
-							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertSpec"),
+							Description: "Spec is the desired state of the ClusterAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the ClusterAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NodeAlertList is a collection of NodeAlert.",
+				Description: "ClusterAlertList is a collection of ClusterAlert.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -565,12 +547,12 @@ func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertList(ref common.Refere
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of NodeAlert.",
+							Description: "Items is the list of ClusterAlert.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlert"),
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlert"),
 									},
 								},
 							},
@@ -581,35 +563,21 @@ func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertList(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ClusterAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_ClusterAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NodeAlertSpec describes the NodeAlert the user wishes to create.",
+				Description: "ClusterAlertSpec describes the ClusterAlert the user wishes to create.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"selector": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"nodeName": {
+					"templateRef": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Name of AlertTemplate in the namespace of this alert that holds defaults for this alert",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateReference"),
 						},
 					},
 					"check": {
@@ -631,6 +599,46 @@ func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertSpec(ref common.Refere
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"maxCheckAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of times Icinga Service is checked in a soft problem state before it changes to a hard state and notifications are sent",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently Icinga Service will be checked while it is in a soft problem state",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"enableFlapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that Icinga detects flapping of the service state",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flappingThresholdLow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flapping ends when the percentage of state changes falls below this threshold",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flappingThresholdHigh": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flapping starts when the percentage of state changes rises above this threshold",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"notificationDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long to wait before the first notification of a problem is sent",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 					"notifierSecretName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Secret containing notifier credentials",
@@ -677,91 +685,2434 @@ func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertSpec(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateReference", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_PluginArguments(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_CorrelatedIncidentReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "CorrelatedIncidentReference refers to an incident correlated with another incident.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"vars": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"),
+							Description: "Namespace of the incident",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"host": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Name of the incident",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"correlatedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "What the incidents share, one of Node, Workload or TimeWindow",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name", "correlatedBy"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_Downtime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Downtime is a maintenance window for alerts. Icinga downtimes are scheduled for the services of selected alerts, and notifications for those alerts are suppressed while the window is in progress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the Downtime. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Downtime. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeSpec", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_DowntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DowntimeList is a collection of Downtime.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Downtime.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Downtime"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Downtime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_PluginVarField(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_DowntimeRecurrence(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"description": {
+					"interval": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Time between the start of two maintenance windows, such as 24h or 168h",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"type": {
+					"until": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "No maintenance window is started after this time",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"interval"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_PluginVars(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_DowntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DowntimeSpec describes the maintenance window the user wishes to schedule. It is applied to alerts in the namespace of the Downtime.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"fields": {
+					"alertKind": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVarField"),
-									},
-								},
-							},
+							Description: "Kind of alerts this downtime is applied to, such as PodAlert. Alerts of all kinds are selected, if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"alertName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the alert this downtime is applied to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector of alerts this downtime is applied to",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"startTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start of the first maintenance window",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End of the first maintenance window",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"recurrence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Recurrence repeats the maintenance window. Each window has the same length as the first one.",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeRecurrence"),
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason for the downtime. It is shown in Icinga and recorded in Incidents.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"startTime", "endTime", "comment"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.DowntimeRecurrence", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_DowntimeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DowntimeStatus is the most recently observed status of a Downtime.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Downtime. It corresponds to the Downtime's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"active": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that a maintenance window is in progress",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"windowStart": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Start of the maintenance window in progress or the next one. Not set when no maintenance window is left.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"windowEnd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End of the maintenance window in progress or the next one.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"matchedAlerts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of alerts this downtime is applied to",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_GroupedTarget(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GroupedTarget is a target of NotificationGroup.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the target object",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State of Icinga service, such as OK, Warning, Critical, Unknown",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checkOutput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Output of the check that was last reported",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the state of this target was last reported",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"notified": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the current state of this target was included in a digest",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "state", "lastTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_IcingaCommand(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"Name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"Vars": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"),
+						},
+					},
+					"States": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"Name", "Vars", "States"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_Incident(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Derived information about the incident.",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_IncidentList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IncidentList is a collection of Incident.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Incident.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Incident"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Incident", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_IncidentNotification(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "incident notification type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checkOutput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "brief output of check command for the incident",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"author": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of user making comment",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"comment": {
+						SchemaProps: spec.SchemaProps{
+							Description: "comment made by user",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"firstTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which this notification was first recorded. (Time of server receipt is in TypeMeta.)",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the most recent occurrence of this notification was recorded.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "state of incident, such as Critical, Warning, OK, Unknown",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"suppressedBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind/name of the object, such as Downtime/db-upgrade, that suppressed this notification. Suppressed notifications are not sent to receivers.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"escalateAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Escalation step, such as 15m, whose receivers were notified. Set for Escalation notifications.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"assignee": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User the incident was assigned to. Set for Assignment notifications.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "state"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_IncidentStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastNotificationType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of last notification, such as problem, acknowledgement, recovery or custom",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notifications": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Notifications for the incident, such as problem or acknowledgement.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentNotification"),
+									},
+								},
+							},
+						},
+					},
+					"deliveries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Latest delivery of each type of notification to each receiver. Pending deliveries are the outbox of the incident, retried by Searchlight operator.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationDelivery"),
+									},
+								},
+							},
+						},
+					},
+					"assignee": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User the incident is assigned to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"parentRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parent incident this incident is correlated under. Notifications of the incident are not sent to receivers, while its parent is open.",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.CorrelatedIncidentReference"),
+						},
+					},
+					"children": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Incidents correlated under this incident",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.CorrelatedIncidentReference"),
+									},
+								},
+							},
+						},
+					},
+					"waitingRoutes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes of NotificationPolicies whose receivers are not notified of the current problem yet, as it has not lasted for their groupWait. Searchlight operator notifies them, once the wait expires.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.WaitingRoute"),
+									},
+								},
+							},
+						},
+					},
+					"nextEscalationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which receivers of the next escalation step are notified of the current problem, unless it is acknowledged or recovers before. Searchlight operator escalates the incident then.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"lastNotificationType"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.CorrelatedIncidentReference", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.IncidentNotification", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationDelivery", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.WaitingRoute", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_LocalSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LocalSecretReference refers to a Secret in the namespace of an alert.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of Secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NodeAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the NodeAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the NodeAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeAlertList is a collection of NodeAlert.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of NodeAlert.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlert"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NodeAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NodeAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeAlertSpec describes the NodeAlert the user wishes to create.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of AlertTemplate in the namespace of this alert that holds defaults for this alert",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateReference"),
+						},
+					},
+					"check": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Icinga CheckCommand name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checkInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently Icinga Service will be checked",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"alertInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently notifications will be send",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxCheckAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of times Icinga Service is checked in a soft problem state before it changes to a hard state and notifications are sent",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently Icinga Service will be checked while it is in a soft problem state",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"enableFlapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that Icinga detects flapping of the service state",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flappingThresholdLow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flapping ends when the percentage of state changes falls below this threshold",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flappingThresholdHigh": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flapping starts when the percentage of state changes rises above this threshold",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"notificationDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long to wait before the first notification of a problem is sent",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"grouping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Grouping combines the notifications of targets of this alert into digests",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping"),
+						},
+					},
+					"notifierSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret containing notifier credentials",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifierParams contains information to send notifications for Incident State, UserUid, Method",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
+									},
+								},
+							},
+						},
+					},
+					"vars": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vars contains Icinga Service variables to be used in CheckCommand",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn contains parent alerts of this alert",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertDependency"),
+									},
+								},
+							},
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that Check is paused Icinga Services are removed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertDependency", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateReference", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationDelivery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationDelivery is the delivery of a notification to a receiver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of notification, such as Problem",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notifier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Notifier used to send notification, such as Mailgun",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contacts notification is sent to",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of delivery, one of Sent, Pending or Failed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of attempts to send notification",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error of the last failed attempt",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastAttemptTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which notification was last attempted to be sent",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"nextAttemptTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time after which a pending delivery is attempted again",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"notifierSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret containing notifier credentials",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotifierSecretReference"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rendered notification, kept while delivery is pending",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationMessage"),
+						},
+					},
+				},
+				Required: []string{"type", "notifier", "to", "phase", "attempts", "lastAttemptTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationMessage", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotifierSecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationGroup is the state of notifications of a group of targets. It is kept between notifications, until all targets of the group recover.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values of grouping labels of targets in this group",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"firstTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the first problem of a target in this group was reported",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"notifiedTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The time at which the last digest of this group was sent. Not set, while waiting for groupWait.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"notifiedStates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "States of targets in the digests sent for this group. Receivers of these states are notified, when all targets of the group recover.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets of this group that have a problem, or recovered since the last digest",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.GroupedTarget"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"firstTimestamp", "targets"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.GroupedTarget", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationGrouping(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationGrouping combines the notifications of targets of an alert into digests, so that a problem shared by many targets is notified once.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"by": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Label keys of targets. Targets with the same values of these labels are grouped together. All targets of alert are grouped together, if not set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"groupWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long to wait for problems of other targets of a group, before its first digest is sent, such as 2m",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationMatcher(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationMatcher selects notifications. A notification is selected, if it matches all the fields that are set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces of alerts. Shell patterns, such as prod-*, are supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"alertKinds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kinds of alerts, such as PodAlert",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"alertNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Names of alerts. Shell patterns, such as pod-*, are supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"states": {
+						SchemaProps: spec.SchemaProps{
+							Description: "States of notifications, such as Critical",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"targetSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector of labels of target pod, node, service or workload. Notifications of ClusterAlerts have no target labels.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationMessage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationMessage is a rendered notification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subject": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subject of emails",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body of emails, or message of SMS, chat and push notifications",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"html": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that the body of emails is HTML",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"body"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationPolicy routes notifications of alerts in all namespaces to receivers, in addition to or instead of the receivers of alerts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the NotificationPolicy. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicySpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationPolicyList is a collection of NotificationPolicy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of NotificationPolicy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicy"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationPolicySpec describes how notifications are routed to receivers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether receivers of matching routes are notified in addition to (Append) or instead of (Replace) the receivers of alert. Default is Append.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notifierSecret": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret containing notifier credentials for receivers of routes. Notifier secret of alert is used, if not set.",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotifierSecretReference"),
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes are evaluated in order. Evaluation stops at the first matching route, unless continue is set for it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationRoute"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"routes"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationRoute", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotifierSecretReference"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotificationRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotificationRoute sends the notifications selected by its matcher to its receivers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the route, such as pager",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"match": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Match selects notifications for this route. Routes without any matcher select all notifications.",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationMatcher"),
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Receivers of notifications selected by this route, but none of its child routes. Required, if the route has no child routes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
+									},
+								},
+							},
+						},
+					},
+					"continue": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether routes after this one are evaluated, when it matches a notification",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"groupWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long a problem must last before receivers of this route are notified. Problems that recover sooner are not notified to them at all. Child routes inherit it, if they don't set it.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationMatcher", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_NotifierSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NotifierSecretReference refers to a Secret containing notifier credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace of the Secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Secret",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_OnCallLayer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnCallLayer is a rotation of contacts who take turns to be on call.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the layer, such as primary. Used by overrides to replace the contact of this layer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"users": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contacts in order of rotation, such as email addresses or phone numbers",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time at which the first contact goes on call",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"rotationLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long each contact stays on call, such as 24h. Default is one week.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"handoffTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time of day, such as 09:00, at which a contact hands off to the next one in the time zone of schedule. It is used when rotationLength is a multiple of 24h, and defaults to the time of day of start. Otherwise, handoffs happen every rotationLength from start.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"users", "start"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_OnCallOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnCallOverride puts its contacts on call instead of the ones in layers, between start and end.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"layer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the layer whose contact is replaced. Contacts of all layers are replaced, if not set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"users": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contacts on call during this override",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time at which this override starts",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time at which this override ends",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"users", "start", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_OnCallSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnCallSchedule rotates the contacts who are on call. Receivers of alerts in its namespace refer to it via onCallSchedule, so notifications are sent to whoever is on call at that time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the OnCallSchedule. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallScheduleSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallScheduleSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_OnCallScheduleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnCallScheduleList is a collection of OnCallSchedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of OnCallSchedule.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallSchedule"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallSchedule", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_OnCallScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnCallScheduleSpec describes the rotations of an OnCallSchedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the time zone of handoffs, such as America/New_York. Default is UTC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"layers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Layers of rotation. The contacts on call in every layer that has started are on call together, such as a primary and a secondary on-call.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallLayer"),
+									},
+								},
+							},
+						},
+					},
+					"overrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Overrides put other contacts on call for a while, such as someone covering a vacation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallOverride"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"layers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallLayer", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.OnCallOverride"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_PluginArguments(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vars": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"),
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVars"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_PluginVarField(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_PluginVars(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fields": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVarField"),
+									},
+								},
+							},
+						},
+					},
+					"required": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"fields"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVarField"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_PodAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the PodAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the PodAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_PodAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodAlertList is a collection of PodAlert.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of PodAlert.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlert"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_PodAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodAlertSpec describes the PodAlert the user wishes to create.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceSelector selects namespaces of pods this alert is applied to. If not set, only pods in the namespace of this alert are selected. Only allowed for PodAlerts in the namespace of Searchlight operator.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"templateRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of AlertTemplate in the namespace of this alert that holds defaults for this alert",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateReference"),
+						},
+					},
+					"check": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Icinga CheckCommand name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checkInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently Icinga Service will be checked",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"alertInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently notifications will be send",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxCheckAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of times Icinga Service is checked in a soft problem state before it changes to a hard state and notifications are sent",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"retryInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently Icinga Service will be checked while it is in a soft problem state",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"enableFlapping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that Icinga detects flapping of the service state",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flappingThresholdLow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flapping ends when the percentage of state changes falls below this threshold",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flappingThresholdHigh": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Flapping starts when the percentage of state changes rises above this threshold",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"notificationDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long to wait before the first notification of a problem is sent",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"grouping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Grouping combines the notifications of targets of this alert into digests",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping"),
+						},
+					},
+					"notifierSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret containing notifier credentials",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifierParams contains information to send notifications for Incident State, UserUid, Method",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
+									},
+								},
+							},
+						},
+					},
+					"vars": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vars contains Icinga Service variables to be used in CheckCommand",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn contains parent alerts of this alert",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertDependency"),
+									},
+								},
+							},
+						},
+					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that Check is paused Icinga Services are removed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertDependency", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertTemplateReference", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_Receiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For which state notification will be sent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"to": {
+						SchemaProps: spec.SchemaProps{
+							Description: "To whom notification will be sent",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"notifier": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How this notification will be sent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"onCallSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of an OnCallSchedule in the namespace of alert. Contacts on call at the time of notification are notified in addition to the ones in To.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"escalateAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How long a problem must stay unacknowledged before notifications are sent to this receiver. Receivers without it are notified immediately.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret with the credentials of Notifier, in the namespace of alert. If not set, notifierSecretName of alert is used, or else the default notifier Secret of Searchlight operator.",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.LocalSecretReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.LocalSecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"reg": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.IcingaCommand"),
+									},
+								},
+							},
+						},
+					},
+					"mu": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("sync.RWMutex"),
+						},
+					},
+				},
+				Required: []string{"reg", "mu"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IcingaCommand", "sync.RWMutex"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the SearchlightPlugin. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SearchlightPluginList is a collection of SearchlightPlugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of SearchlightPlugin.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPlugin"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPlugin", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SearchlightPluginSpec describes the SearchlightPlugin the user wishes to create.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Check Command",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook provides a reference to the service for this SearchlightPlugin. It must communicate on port 80",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.WebhookServiceSpec"),
+						},
+					},
+					"alertKinds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertKinds refers to supports Alert kinds for this plugin",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"arguments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Supported arguments for SearchlightPlugin",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginArguments"),
+						},
+					},
+					"states": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Supported Icinga Service State",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"alertKinds", "states"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginArguments", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.WebhookServiceSpec"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_ServiceAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the desired state of the ServiceAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlertSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the ServiceAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_ServiceAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceAlertList is a collection of ServiceAlert.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ServiceAlert.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlert"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.ServiceAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_ServiceAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceAlertSpec describes the ServiceAlert the user wishes to create.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Selector of Services this alert is applied to",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"serviceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Service this alert is applied to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"check": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Icinga CheckCommand name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checkInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently Icinga Service will be checked",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"alertInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently notifications will be send",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"notifierSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret containing notifier credentials",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifierParams contains information to send notifications for Incident State, UserUid, Method",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
+									},
+								},
+							},
+						},
+					},
+					"grouping": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Grouping combines the notifications of targets of this alert into digests",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping"),
 						},
 					},
-					"required": {
+					"vars": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Vars contains Icinga Service variables to be used in CheckCommand",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -771,20 +3122,27 @@ func schema_searchlight_apis_monitoring_v1alpha1_PluginVars(ref common.Reference
 							},
 						},
 					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Indicates that Check is paused Icinga Services are removed",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"fields"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginVarField"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_PodAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_Silence(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Silence mutes notifications of alerts in its namespace that match its selector, until it expires. Unlike a Downtime, it does not create any Icinga object, so it also covers targets created after it.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -808,23 +3166,23 @@ func schema_searchlight_apis_monitoring_v1alpha1_PodAlert(ref common.ReferenceCa
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the desired state of the PodAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
-							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertSpec"),
+							Description: "Spec is the desired state of the Silence. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.SilenceSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SilenceSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_PodAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_SilenceList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodAlertList is a collection of PodAlert.",
+				Description: "SilenceList is a collection of Silence.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -849,12 +3207,12 @@ func schema_searchlight_apis_monitoring_v1alpha1_PodAlertList(ref common.Referen
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of PodAlert.",
+							Description: "Items is the list of Silence.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlert"),
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Silence"),
 									},
 								},
 							},
@@ -865,171 +3223,156 @@ func schema_searchlight_apis_monitoring_v1alpha1_PodAlertList(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PodAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Silence", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_PodAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_SilenceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodAlertSpec describes the PodAlert the user wishes to create.",
+				Description: "SilenceSpec describes the notifications the user wishes to mute.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "Selector of notifications to mute. It is matched against the labels of the target object (pod, node, service or workload) together with the labels monitoring.appscode.com/alert, monitoring.appscode.com/alert-type, monitoring.appscode.com/object-name and monitoring.appscode.com/object-namespace of the notification.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"podName": {
+					"startsAt": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Time at which this silence starts. Silence starts on creation, if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"check": {
+					"endsAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Icinga CheckCommand name",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Time at which this silence expires",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"checkInterval": {
+					"createdBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "How frequently Icinga Service will be checked",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Name of the user who created this silence",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"alertInterval": {
+					"comment": {
 						SchemaProps: spec.SchemaProps{
-							Description: "How frequently notifications will be send",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Reason for muting notifications",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"notifierSecretName": {
+				},
+				Required: []string{"selector", "endsAt"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_searchlight_apis_monitoring_v1alpha1_TargetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Secret containing notifier credentials",
+							Description: "Name of the target object. Empty for ClusterAlert.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"receivers": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NotifierParams contains information to send notifications for Incident State, UserUid, Method",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
-									},
-								},
-							},
+							Description: "Current state of Icinga service, such as OK, Warning, Critical, Unknown",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"vars": {
+					"lastCheckOutput": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Vars contains Icinga Service variables to be used in CheckCommand",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Output of the check that last reported a change of state",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"paused": {
+					"lastCheckTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Indicates that Check is paused Icinga Services are removed",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "The time at which the check that last reported a change of state was executed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_Receiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_WaitingRoute(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "WaitingRoute is a route of NotificationPolicy, whose receivers are notified of a problem after its groupWait.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"state": {
+					"route": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For which state notification will be sent",
+							Description: "NotificationPolicy/route, such as default/pager",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"to": {
-						SchemaProps: spec.SchemaProps{
-							Description: "To whom notification will be sent",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"notifier": {
+					"notifyTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "How this notification will be sent",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The time at which receivers of route are notified, unless the problem recovers before",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"route", "notifyTimestamp"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_WebhookServiceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"reg": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.IcingaCommand"),
-									},
-								},
-							},
+							Description: "Namespace is the namespace of the service",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"mu": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("sync.RWMutex"),
+							Description: "Name is the name of the service",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"reg", "mu"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.IcingaCommand", "sync.RWMutex"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_WorkloadAlert(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
@@ -1051,28 +3394,35 @@ func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPlugin(ref common.Re
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Standard object's metadata. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the desired state of the SearchlightPlugin. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
-							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginSpec"),
+							Description: "Spec is the desired state of the WorkloadAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlertSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the WorkloadAlert. More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPluginSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.AlertStatus", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlertSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_WorkloadAlertList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SearchlightPluginList is a collection of SearchlightPlugin.",
+				Description: "WorkloadAlertList is a collection of WorkloadAlert.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1097,12 +3447,12 @@ func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginList(ref commo
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of SearchlightPlugin.",
+							Description: "Items is the list of WorkloadAlert.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPlugin"),
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlert"),
 									},
 								},
 							},
@@ -1113,55 +3463,88 @@ func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginList(ref commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.SearchlightPlugin", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.WorkloadAlert", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_searchlight_apis_monitoring_v1alpha1_WorkloadAlertSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SearchlightPluginSpec describes the SearchlightPlugin the user wishes to create.",
+				Description: "WorkloadAlertSpec describes the WorkloadAlert the user wishes to create.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"command": {
+					"workloadKind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Check Command",
+							Description: "Kind of workloads this alert is applied to, one of Deployment, StatefulSet or DaemonSet",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"webhook": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Webhook provides a reference to the service for this SearchlightPlugin. It must communicate on port 80",
-							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.WebhookServiceSpec"),
+							Description: "Selector of workloads this alert is applied to",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"alertKinds": {
+					"workloadName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AlertKinds refers to supports Alert kinds for this plugin",
+							Description: "Name of the workload this alert is applied to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"check": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Icinga CheckCommand name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checkInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently Icinga Service will be checked",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"alertInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "How frequently notifications will be send",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"notifierSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Secret containing notifier credentials",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifierParams contains information to send notifications for Incident State, UserUid, Method",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver"),
 									},
 								},
 							},
 						},
 					},
-					"arguments": {
+					"grouping": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Supported arguments for SearchlightPlugin",
-							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginArguments"),
+							Description: "Grouping combines the notifications of targets of this alert into digests",
+							Ref:         ref("github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping"),
 						},
 					},
-					"states": {
+					"vars": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Supported Icinga Service State",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Vars contains Icinga Service variables to be used in CheckCommand",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -1171,39 +3554,19 @@ func schema_searchlight_apis_monitoring_v1alpha1_SearchlightPluginSpec(ref commo
 							},
 						},
 					},
-				},
-				Required: []string{"alertKinds", "states"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.PluginArguments", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.WebhookServiceSpec"},
-	}
-}
-
-func schema_searchlight_apis_monitoring_v1alpha1_WebhookServiceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the namespace of the service",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"name": {
+					"paused": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the service",
-							Type:        []string{"string"},
+							Description: "Indicates that Check is paused Icinga Services are removed",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"workloadKind"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/appscode/searchlight/apis/monitoring/v1alpha1.NotificationGrouping", "github.com/appscode/searchlight/apis/monitoring/v1alpha1.Receiver", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -17,7 +17,6 @@ const (
 )
 
 // +genclient
-// +genclient:skipVerbs=updateStatus
 // +k8s:openapi-gen=true
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -30,6 +29,11 @@ type PodAlert struct {
 	// Spec is the desired state of the PodAlert.
 	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
 	Spec PodAlertSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the PodAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertStatus `json:"status,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -51,6 +55,14 @@ type PodAlertSpec struct {
 
 	PodName *string `json:"podName,omitempty"`
 
+	// NamespaceSelector selects namespaces of pods this alert is applied to.
+	// If not set, only pods in the namespace of this alert are selected.
+	// Only allowed for PodAlerts in the namespace of Searchlight operator.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Name of AlertTemplate in the namespace of this alert that holds defaults for this alert
+	TemplateRef *AlertTemplateReference `json:"templateRef,omitempty"`
+
 	// Icinga CheckCommand name
 	Check string `json:"check,omitempty"`
 
@@ -60,6 +72,28 @@ type PodAlertSpec struct {
 	// How frequently notifications will be send
 	AlertInterval metav1.Duration `json:"alertInterval,omitempty"`
 
+	// Number of times Icinga Service is checked in a soft problem state before it changes to a hard
+	// state and notifications are sent
+	MaxCheckAttempts int32 `json:"maxCheckAttempts,omitempty"`
+
+	// How frequently Icinga Service will be checked while it is in a soft problem state
+	RetryInterval metav1.Duration `json:"retryInterval,omitempty"`
+
+	// Indicates that Icinga detects flapping of the service state
+	EnableFlapping bool `json:"enableFlapping,omitempty"`
+
+	// Flapping ends when the percentage of state changes falls below this threshold
+	FlappingThresholdLow int32 `json:"flappingThresholdLow,omitempty"`
+
+	// Flapping starts when the percentage of state changes rises above this threshold
+	FlappingThresholdHigh int32 `json:"flappingThresholdHigh,omitempty"`
+
+	// How long to wait before the first notification of a problem is sent
+	NotificationDelay metav1.Duration `json:"notificationDelay,omitempty"`
+
+	// Grouping combines the notifications of targets of this alert into digests
+	Grouping *NotificationGrouping `json:"grouping,omitempty"`
+
 	// Secret containing notifier credentials
 	NotifierSecretName string `json:"notifierSecretName,omitempty"`
 
@@ -70,12 +104,16 @@ type PodAlertSpec struct {
 	// Vars contains Icinga Service variables to be used in CheckCommand
 	Vars map[string]string `json:"vars,omitempty"`
 
+	// DependsOn contains parent alerts of this alert
+	DependsOn []AlertDependency `json:"dependsOn,omitempty"`
+
 	// Indicates that Check is paused
 	// Icinga Services are removed
 	Paused bool `json:"paused,omitempty"`
 }
 
-var _ Alert = &PodAlert{}
+var _ TemplatedAlert = &PodAlert{}
+var _ GroupedAlert = &PodAlert{}
 
 func (a PodAlert) GetName() string {
 	return a.Name
@@ -97,7 +135,19 @@ func (a PodAlert) GetAlertInterval() time.Duration {
 	return a.Spec.AlertInterval.Duration
 }
 
+func (a PodAlert) GetTemplateRef() *AlertTemplateReference {
+	return a.Spec.TemplateRef
+}
+
+func (a *PodAlert) SetTemplateDefaults(t *AlertTemplate) {
+	t.setDefaults(&a.Spec.Check, &a.Spec.CheckInterval, &a.Spec.AlertInterval, &a.Spec.NotifierSecretName, &a.Spec.Receivers, &a.Spec.Vars)
+}
+
 func (a PodAlert) IsValid(kc kubernetes.Interface) error {
+	// checks of pods in other namespaces run with the permissions of Searchlight operator
+	if a.Spec.NamespaceSelector != nil && (OperatorNamespace == "" || a.Namespace != OperatorNamespace) {
+		return fmt.Errorf("namespace selector is only allowed for PodAlerts in namespace %s of Searchlight operator", OperatorNamespace)
+	}
 	if a.Spec.Paused {
 		return nil
 	}
@@ -114,6 +164,15 @@ func (a PodAlert) IsValid(kc kubernetes.Interface) error {
 			return err
 		}
 	}
+	if a.Spec.NamespaceSelector != nil {
+		if a.Spec.PodName != nil {
+			return fmt.Errorf("can't specify both pod name and namespace selector")
+		}
+		_, err := metav1.LabelSelectorAsSelector(a.Spec.NamespaceSelector)
+		if err != nil {
+			return err
+		}
+	}
 
 	cmd, ok := PodCommands.Get(a.Spec.Check)
 	if !ok {
@@ -135,6 +194,21 @@ func (a PodAlert) IsValid(kc kubernetes.Interface) error {
 		if !found {
 			return fmt.Errorf("state %s is unsupported for check command %s", rcv.State, a.Spec.Check)
 		}
+		if rcv.EscalateAfter != nil && rcv.EscalateAfter.Duration < 0 {
+			return fmt.Errorf("escalateAfter of receiver for state %s can't be negative", rcv.State)
+		}
+	}
+
+	if err := validateDependencies(a.Spec.DependsOn, ResourceKindNodeAlert, ResourceKindClusterAlert); err != nil {
+		return err
+	}
+
+	if err := validateCheckAttempts(a.Spec.MaxCheckAttempts, a.Spec.RetryInterval, a.Spec.FlappingThresholdLow, a.Spec.FlappingThresholdHigh, a.Spec.NotificationDelay); err != nil {
+		return err
+	}
+
+	if err := a.Spec.Grouping.isValid(); err != nil {
+		return err
 	}
 
 	return checkNotifiers(kc, a)
@@ -148,6 +222,10 @@ func (a PodAlert) GetReceivers() []Receiver {
 	return a.Spec.Receivers
 }
 
+func (a PodAlert) GetGrouping() *NotificationGrouping {
+	return a.Spec.Grouping
+}
+
 func (a PodAlert) ObjectReference() *core.ObjectReference {
 	return &core.ObjectReference{
 		APIVersion:      SchemeGroupVersion.String(),
@@ -0,0 +1,41 @@
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodAlertNamespaceSelector(t *testing.T) {
+	PodCommands.Insert("pod-exec", IcingaCommand{Name: "pod-exec", States: []string{"OK", "Critical", "Unknown"}})
+	defer PodCommands.Delete("pod-exec")
+
+	alert := PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+		Spec: PodAlertSpec{
+			Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			NamespaceSelector: &metav1.LabelSelector{},
+			Check:             "pod-exec",
+		},
+	}
+
+	// namespace of operator is unknown
+	assert.Error(t, alert.IsValid(nil))
+
+	OperatorNamespace = "kube-system"
+	defer func() { OperatorNamespace = "" }()
+	assert.EqualError(t, alert.IsValid(nil), "namespace selector is only allowed for PodAlerts in namespace kube-system of Searchlight operator")
+
+	// paused alerts are rejected too, since they can be resumed
+	alert.Spec.Paused = true
+	assert.Error(t, alert.IsValid(nil))
+
+	alert.Namespace = "kube-system"
+	alert.Spec.Paused = false
+	assert.NoError(t, alert.IsValid(nil))
+
+	alert.Namespace = "demo"
+	alert.Spec.NamespaceSelector = nil
+	assert.NoError(t, alert.IsValid(nil))
+}
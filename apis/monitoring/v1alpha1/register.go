@@ -38,6 +38,20 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&NodeAlertList{},
 		&PodAlert{},
 		&PodAlertList{},
+		&ServiceAlert{},
+		&ServiceAlertList{},
+		&WorkloadAlert{},
+		&WorkloadAlertList{},
+		&Downtime{},
+		&DowntimeList{},
+		&Silence{},
+		&SilenceList{},
+		&AlertTemplate{},
+		&AlertTemplateList{},
+		&OnCallSchedule{},
+		&OnCallScheduleList{},
+		&NotificationPolicy{},
+		&NotificationPolicyList{},
 		&Incident{},
 		&IncidentList{},
 		&SearchlightPlugin{},
@@ -0,0 +1,180 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	ResourceKindServiceAlert     = "ServiceAlert"
+	ResourcePluralServiceAlert   = "servicealerts"
+	ResourceSingularServiceAlert = "servicealert"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ServiceAlert struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the ServiceAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec ServiceAlertSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the ServiceAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ServiceAlertList is a collection of ServiceAlert.
+type ServiceAlertList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ServiceAlert.
+	Items []ServiceAlert `json:"items"`
+}
+
+// ServiceAlertSpec describes the ServiceAlert the user wishes to create.
+type ServiceAlertSpec struct {
+	// Selector of Services this alert is applied to
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Name of the Service this alert is applied to
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// Icinga CheckCommand name
+	Check string `json:"check,omitempty"`
+
+	// How frequently Icinga Service will be checked
+	CheckInterval metav1.Duration `json:"checkInterval,omitempty"`
+
+	// How frequently notifications will be send
+	AlertInterval metav1.Duration `json:"alertInterval,omitempty"`
+
+	// Secret containing notifier credentials
+	NotifierSecretName string `json:"notifierSecretName,omitempty"`
+
+	// NotifierParams contains information to send notifications for Incident
+	// State, UserUid, Method
+	Receivers []Receiver `json:"receivers,omitempty"`
+
+	// Grouping combines the notifications of targets of this alert into digests
+	Grouping *NotificationGrouping `json:"grouping,omitempty"`
+
+	// Vars contains Icinga Service variables to be used in CheckCommand
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// Indicates that Check is paused
+	// Icinga Services are removed
+	Paused bool `json:"paused,omitempty"`
+}
+
+var _ GroupedAlert = &ServiceAlert{}
+
+func (a ServiceAlert) GetName() string {
+	return a.Name
+}
+
+func (a ServiceAlert) GetNamespace() string {
+	return a.Namespace
+}
+
+func (a ServiceAlert) Command() string {
+	return string(a.Spec.Check)
+}
+
+func (a ServiceAlert) GetCheckInterval() time.Duration {
+	return a.Spec.CheckInterval.Duration
+}
+
+func (a ServiceAlert) GetAlertInterval() time.Duration {
+	return a.Spec.AlertInterval.Duration
+}
+
+func (a ServiceAlert) IsValid(kc kubernetes.Interface) error {
+	if a.Spec.Paused {
+		return nil
+	}
+
+	if a.Spec.ServiceName != nil && a.Spec.Selector != nil {
+		return fmt.Errorf("can't specify both service name and selector")
+	}
+	if a.Spec.ServiceName == nil && a.Spec.Selector == nil {
+		return fmt.Errorf("specify either service name or selector")
+	}
+	if a.Spec.Selector != nil {
+		_, err := metav1.LabelSelectorAsSelector(a.Spec.Selector)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd, ok := ServiceCommands.Get(a.Spec.Check)
+	if !ok {
+		return fmt.Errorf("%s is not a valid service check command", a.Spec.Check)
+	}
+
+	if err := validateVariables(cmd.Vars, a.Spec.Vars); err != nil {
+		return err
+	}
+
+	for _, rcv := range a.Spec.Receivers {
+		found := false
+		for _, state := range cmd.States {
+			if strings.EqualFold(state, rcv.State) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("state %s is unsupported for check command %s", rcv.State, a.Spec.Check)
+		}
+		if rcv.EscalateAfter != nil && rcv.EscalateAfter.Duration < 0 {
+			return fmt.Errorf("escalateAfter of receiver for state %s can't be negative", rcv.State)
+		}
+	}
+
+	if err := a.Spec.Grouping.isValid(); err != nil {
+		return err
+	}
+
+	return checkNotifiers(kc, a)
+}
+
+func (a ServiceAlert) GetNotifierSecretName() string {
+	return a.Spec.NotifierSecretName
+}
+
+func (a ServiceAlert) GetReceivers() []Receiver {
+	return a.Spec.Receivers
+}
+
+func (a ServiceAlert) GetGrouping() *NotificationGrouping {
+	return a.Spec.Grouping
+}
+
+func (a ServiceAlert) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindServiceAlert,
+		Namespace:       a.Namespace,
+		Name:            a.Name,
+		UID:             a.UID,
+		ResourceVersion: a.ResourceVersion,
+	}
+}
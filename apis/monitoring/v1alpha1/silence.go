@@ -0,0 +1,113 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	ResourceKindSilence     = "Silence"
+	ResourcePluralSilence   = "silences"
+	ResourceSingularSilence = "silence"
+)
+
+// +genclient
+// +genclient:skipVerbs=updateStatus
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Silence mutes notifications of alerts in its namespace that match its selector, until it expires.
+// Unlike a Downtime, it does not create any Icinga object, so it also covers targets created after it.
+type Silence struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the Silence.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec SilenceSpec `json:"spec,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SilenceList is a collection of Silence.
+type SilenceList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of Silence.
+	Items []Silence `json:"items"`
+}
+
+// SilenceSpec describes the notifications the user wishes to mute.
+type SilenceSpec struct {
+	// Selector of notifications to mute. It is matched against the labels of the target object
+	// (pod, node, service or workload) together with the labels monitoring.appscode.com/alert,
+	// monitoring.appscode.com/alert-type, monitoring.appscode.com/object-name and
+	// monitoring.appscode.com/object-namespace of the notification.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// Time at which this silence starts. Silence starts on creation, if not set.
+	// +optional
+	StartsAt *metav1.Time `json:"startsAt,omitempty"`
+
+	// Time at which this silence expires
+	EndsAt metav1.Time `json:"endsAt"`
+
+	// Name of the user who created this silence
+	// +optional
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// Reason for muting notifications
+	// +optional
+	Comment string `json:"comment,omitempty"`
+}
+
+func (s Silence) IsValid() error {
+	if _, err := metav1.LabelSelectorAsSelector(&s.Spec.Selector); err != nil {
+		return err
+	}
+	if s.Spec.EndsAt.IsZero() {
+		return fmt.Errorf("endsAt must be set")
+	}
+	if s.Spec.StartsAt != nil && !s.Spec.EndsAt.After(s.Spec.StartsAt.Time) {
+		return fmt.Errorf("endsAt must be after startsAt")
+	}
+	return nil
+}
+
+// IsActive returns true, if this silence has started and not expired at t.
+func (s Silence) IsActive(t time.Time) bool {
+	start := s.CreationTimestamp.Time
+	if s.Spec.StartsAt != nil {
+		start = s.Spec.StartsAt.Time
+	}
+	return !t.Before(start) && t.Before(s.Spec.EndsAt.Time)
+}
+
+// Matches returns true, if labels of a notification are selected by this silence.
+func (s Silence) Matches(lbls map[string]string) bool {
+	sel, err := metav1.LabelSelectorAsSelector(&s.Spec.Selector)
+	if err != nil {
+		return false
+	}
+	return sel.Matches(labels.Set(lbls))
+}
+
+func (s Silence) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindSilence,
+		Namespace:       s.Namespace,
+		Name:            s.Name,
+		UID:             s.UID,
+		ResourceVersion: s.ResourceVersion,
+	}
+}
@@ -0,0 +1,142 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetCondition(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	synced := AlertCondition{Type: AlertConditionSynced, Status: core.ConditionTrue, LastTransitionTime: past}
+
+	cases := []struct {
+		name       string
+		conditions []AlertCondition
+		cond       AlertCondition
+		expected   AlertCondition
+		transition bool
+		count      int
+	}{
+		{
+			name:       "add condition",
+			cond:       AlertCondition{Type: AlertConditionSynced, Status: core.ConditionTrue},
+			expected:   AlertCondition{Type: AlertConditionSynced, Status: core.ConditionTrue},
+			transition: true,
+			count:      1,
+		},
+		{
+			name:       "add condition with transition time",
+			conditions: []AlertCondition{synced},
+			cond:       AlertCondition{Type: AlertConditionPaused, Status: core.ConditionFalse, LastTransitionTime: past},
+			expected:   AlertCondition{Type: AlertConditionPaused, Status: core.ConditionFalse, LastTransitionTime: past},
+			count:      2,
+		},
+		{
+			name:       "same status keeps transition time",
+			conditions: []AlertCondition{synced},
+			cond:       AlertCondition{Type: AlertConditionSynced, Status: core.ConditionTrue, Reason: "SuccessfulSync"},
+			expected:   AlertCondition{Type: AlertConditionSynced, Status: core.ConditionTrue, Reason: "SuccessfulSync", LastTransitionTime: past},
+			count:      1,
+		},
+		{
+			name:       "changed status updates transition time",
+			conditions: []AlertCondition{synced},
+			cond:       AlertCondition{Type: AlertConditionSynced, Status: core.ConditionFalse, Message: "connection refused"},
+			expected:   AlertCondition{Type: AlertConditionSynced, Status: core.ConditionFalse, Message: "connection refused"},
+			transition: true,
+			count:      1,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := AlertStatus{Conditions: append([]AlertCondition(nil), c.conditions...)}
+			s.SetCondition(c.cond)
+			assert.Len(t, s.Conditions, c.count)
+
+			_, cond := s.GetCondition(c.cond.Type)
+			if assert.NotNil(t, cond) {
+				if c.transition {
+					assert.True(t, cond.LastTransitionTime.After(past.Time))
+					c.expected.LastTransitionTime = cond.LastTransitionTime
+				}
+				assert.Equal(t, c.expected, *cond)
+			}
+		})
+	}
+}
+
+func TestSetTarget(t *testing.T) {
+	cases := []struct {
+		name     string
+		targets  []TargetStatus
+		target   TargetStatus
+		expected []TargetStatus
+	}{
+		{
+			name:     "add target",
+			target:   TargetStatus{Name: "node-1", State: "OK"},
+			expected: []TargetStatus{{Name: "node-1", State: "OK"}},
+		},
+		{
+			name:     "add another target",
+			targets:  []TargetStatus{{Name: "node-1", State: "OK"}},
+			target:   TargetStatus{Name: "node-2", State: "Critical"},
+			expected: []TargetStatus{{Name: "node-1", State: "OK"}, {Name: "node-2", State: "Critical"}},
+		},
+		{
+			name:     "update target",
+			targets:  []TargetStatus{{Name: "node-1", State: "OK"}, {Name: "node-2", State: "Critical"}},
+			target:   TargetStatus{Name: "node-2", State: "OK", LastCheckOutput: "Ready"},
+			expected: []TargetStatus{{Name: "node-1", State: "OK"}, {Name: "node-2", State: "OK", LastCheckOutput: "Ready"}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := AlertStatus{Targets: append([]TargetStatus(nil), c.targets...)}
+			s.SetTarget(c.target)
+			assert.Equal(t, c.expected, s.Targets)
+			assert.Equal(t, int32(len(c.expected)), s.MatchedTargets)
+		})
+	}
+}
+
+func TestRemoveTarget(t *testing.T) {
+	cases := []struct {
+		name     string
+		targets  []TargetStatus
+		target   string
+		removed  bool
+		expected []TargetStatus
+	}{
+		{
+			name:     "remove target",
+			targets:  []TargetStatus{{Name: "node-1"}, {Name: "node-2"}},
+			target:   "node-1",
+			removed:  true,
+			expected: []TargetStatus{{Name: "node-2"}},
+		},
+		{
+			name:     "missing target",
+			targets:  []TargetStatus{{Name: "node-1"}},
+			target:   "node-2",
+			expected: []TargetStatus{{Name: "node-1"}},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			s := AlertStatus{Targets: append([]TargetStatus(nil), c.targets...), MatchedTargets: int32(len(c.targets))}
+			assert.Equal(t, c.removed, s.RemoveTarget(c.target))
+			assert.Equal(t, c.expected, s.Targets)
+			assert.Equal(t, int32(len(c.expected)), s.MatchedTargets)
+		})
+	}
+}
+
+func TestPodTargetName(t *testing.T) {
+	assert.Equal(t, "payments-0", PodTargetName("demo", "demo", "payments-0"))
+	assert.Equal(t, "shop/payments-0", PodTargetName("demo", "shop", "payments-0"))
+}
@@ -1,5 +1,11 @@
 package v1alpha1
 
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type Receiver struct {
 	// For which state notification will be sent
 	State string `json:"state,omitempty"`
@@ -9,4 +15,114 @@ type Receiver struct {
 
 	// How this notification will be sent
 	Notifier string `json:"notifier,omitempty"`
+
+	// Name of an OnCallSchedule in the namespace of alert. Contacts on call at the time of
+	// notification are notified in addition to the ones in To.
+	OnCallSchedule string `json:"onCallSchedule,omitempty"`
+
+	// How long a problem must stay unacknowledged before notifications are sent to this receiver.
+	// Receivers without it are notified immediately.
+	EscalateAfter *metav1.Duration `json:"escalateAfter,omitempty"`
+
+	// Secret with the credentials of Notifier, in the namespace of alert. If not set, notifierSecretName
+	// of alert is used, or else the default notifier Secret of Searchlight operator.
+	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
+}
+
+// LocalSecretReference refers to a Secret in the namespace of an alert.
+type LocalSecretReference struct {
+	// Name of Secret
+	Name string `json:"name"`
+}
+
+// AlertTemplateReference refers to an AlertTemplate in the namespace of an alert.
+type AlertTemplateReference struct {
+	// Name of AlertTemplate
+	Name string `json:"name"`
+}
+
+// AlertDependency is a parent alert. Notifications of an alert are suppressed while
+// the Icinga service of its parent alert is Critical or Unknown.
+type AlertDependency struct {
+	// Kind of parent alert, such as NodeAlert or ClusterAlert
+	Kind string `json:"kind"`
+
+	// Name of parent alert in the namespace of this alert
+	Name string `json:"name"`
+}
+
+// NotificationGrouping combines the notifications of targets of an alert into digests, so that a
+// problem shared by many targets is notified once.
+type NotificationGrouping struct {
+	// Label keys of targets. Targets with the same values of these labels are grouped together.
+	// All targets of alert are grouped together, if not set.
+	By []string `json:"by,omitempty"`
+
+	// How long to wait for problems of other targets of a group, before its first digest is sent, such as 2m
+	GroupWait metav1.Duration `json:"groupWait,omitempty"`
+}
+
+// GroupedAlert is an alert for multiple targets, whose notifications can be grouped into digests.
+type GroupedAlert interface {
+	Alert
+	GetGrouping() *NotificationGrouping
+}
+
+func (g *NotificationGrouping) isValid() error {
+	if g == nil {
+		return nil
+	}
+	if g.GroupWait.Duration < 0 {
+		return fmt.Errorf("groupWait of grouping can't be negative")
+	}
+	for _, key := range g.By {
+		if key == "" {
+			return fmt.Errorf("label keys of grouping can't be empty")
+		}
+	}
+	return nil
+}
+
+// validateDependencies checks that parent alerts are of supported kinds.
+func validateDependencies(deps []AlertDependency, kinds ...string) error {
+	for _, dep := range deps {
+		if dep.Name == "" {
+			return fmt.Errorf("name of parent %s is empty", dep.Kind)
+		}
+		found := false
+		for _, kind := range kinds {
+			if dep.Kind == kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s can't be a parent alert, only %v are supported", dep.Kind, kinds)
+		}
+	}
+	return nil
+}
+
+// validateCheckAttempts checks the settings of soft states, flap detection and notification delay.
+// Zero values are not sent to Icinga, so the defaults of generic-service template are used.
+func validateCheckAttempts(maxCheckAttempts int32, retryInterval metav1.Duration, thresholdLow, thresholdHigh int32, notificationDelay metav1.Duration) error {
+	if maxCheckAttempts < 0 {
+		return fmt.Errorf("maxCheckAttempts can't be negative")
+	}
+	if retryInterval.Duration < 0 {
+		return fmt.Errorf("retryInterval can't be negative")
+	}
+	if notificationDelay.Duration < 0 {
+		return fmt.Errorf("notificationDelay can't be negative")
+	}
+	if thresholdLow < 0 || thresholdLow > 100 {
+		return fmt.Errorf("flappingThresholdLow must be between 0 and 100")
+	}
+	if thresholdHigh < 0 || thresholdHigh > 100 {
+		return fmt.Errorf("flappingThresholdHigh must be between 0 and 100")
+	}
+	if thresholdLow > 0 && thresholdHigh > 0 && thresholdLow >= thresholdHigh {
+		return fmt.Errorf("flappingThresholdLow must be less than flappingThresholdHigh")
+	}
+	return nil
 }
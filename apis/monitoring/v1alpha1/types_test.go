@@ -0,0 +1,45 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidateCheckAttempts(t *testing.T) {
+	cases := []struct {
+		name              string
+		maxCheckAttempts  int32
+		retryInterval     time.Duration
+		thresholdLow      int32
+		thresholdHigh     int32
+		notificationDelay time.Duration
+		err               string
+	}{
+		{name: "unset"},
+		{name: "valid", maxCheckAttempts: 3, retryInterval: 30 * time.Second, thresholdLow: 20, thresholdHigh: 30, notificationDelay: time.Minute},
+		{name: "only low threshold", thresholdLow: 20},
+		{name: "only high threshold", thresholdHigh: 30},
+		{name: "negative maxCheckAttempts", maxCheckAttempts: -1, err: "maxCheckAttempts can't be negative"},
+		{name: "negative retryInterval", retryInterval: -time.Second, err: "retryInterval can't be negative"},
+		{name: "negative notificationDelay", notificationDelay: -time.Second, err: "notificationDelay can't be negative"},
+		{name: "negative low threshold", thresholdLow: -1, err: "flappingThresholdLow must be between 0 and 100"},
+		{name: "low threshold above 100", thresholdLow: 101, err: "flappingThresholdLow must be between 0 and 100"},
+		{name: "negative high threshold", thresholdHigh: -1, err: "flappingThresholdHigh must be between 0 and 100"},
+		{name: "high threshold above 100", thresholdHigh: 101, err: "flappingThresholdHigh must be between 0 and 100"},
+		{name: "low threshold equals high", thresholdLow: 30, thresholdHigh: 30, err: "flappingThresholdLow must be less than flappingThresholdHigh"},
+		{name: "low threshold above high", thresholdLow: 40, thresholdHigh: 30, err: "flappingThresholdLow must be less than flappingThresholdHigh"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateCheckAttempts(c.maxCheckAttempts, metav1.Duration{Duration: c.retryInterval}, c.thresholdLow, c.thresholdHigh, metav1.Duration{Duration: c.notificationDelay})
+			if c.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, c.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	ResourceKindWorkloadAlert     = "WorkloadAlert"
+	ResourcePluralWorkloadAlert   = "workloadalerts"
+	ResourceSingularWorkloadAlert = "workloadalert"
+)
+
+const (
+	WorkloadKindDeployment  = "Deployment"
+	WorkloadKindStatefulSet = "StatefulSet"
+	WorkloadKindDaemonSet   = "DaemonSet"
+)
+
+func IsWorkloadKind(kind string) bool {
+	switch kind {
+	case WorkloadKindDeployment, WorkloadKindStatefulSet, WorkloadKindDaemonSet:
+		return true
+	}
+	return false
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type WorkloadAlert struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the WorkloadAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec WorkloadAlertSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the WorkloadAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkloadAlertList is a collection of WorkloadAlert.
+type WorkloadAlertList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of WorkloadAlert.
+	Items []WorkloadAlert `json:"items"`
+}
+
+// WorkloadAlertSpec describes the WorkloadAlert the user wishes to create.
+type WorkloadAlertSpec struct {
+	// Kind of workloads this alert is applied to, one of Deployment, StatefulSet or DaemonSet
+	WorkloadKind string `json:"workloadKind"`
+
+	// Selector of workloads this alert is applied to
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Name of the workload this alert is applied to
+	WorkloadName *string `json:"workloadName,omitempty"`
+
+	// Icinga CheckCommand name
+	Check string `json:"check,omitempty"`
+
+	// How frequently Icinga Service will be checked
+	CheckInterval metav1.Duration `json:"checkInterval,omitempty"`
+
+	// How frequently notifications will be send
+	AlertInterval metav1.Duration `json:"alertInterval,omitempty"`
+
+	// Secret containing notifier credentials
+	NotifierSecretName string `json:"notifierSecretName,omitempty"`
+
+	// NotifierParams contains information to send notifications for Incident
+	// State, UserUid, Method
+	Receivers []Receiver `json:"receivers,omitempty"`
+
+	// Grouping combines the notifications of targets of this alert into digests
+	Grouping *NotificationGrouping `json:"grouping,omitempty"`
+
+	// Vars contains Icinga Service variables to be used in CheckCommand
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// Indicates that Check is paused
+	// Icinga Services are removed
+	Paused bool `json:"paused,omitempty"`
+}
+
+var _ GroupedAlert = &WorkloadAlert{}
+
+func (a WorkloadAlert) GetName() string {
+	return a.Name
+}
+
+func (a WorkloadAlert) GetNamespace() string {
+	return a.Namespace
+}
+
+func (a WorkloadAlert) Command() string {
+	return string(a.Spec.Check)
+}
+
+func (a WorkloadAlert) GetCheckInterval() time.Duration {
+	return a.Spec.CheckInterval.Duration
+}
+
+func (a WorkloadAlert) GetAlertInterval() time.Duration {
+	return a.Spec.AlertInterval.Duration
+}
+
+func (a WorkloadAlert) IsValid(kc kubernetes.Interface) error {
+	if a.Spec.Paused {
+		return nil
+	}
+
+	if !IsWorkloadKind(a.Spec.WorkloadKind) {
+		return fmt.Errorf("%s is not a supported workload kind", a.Spec.WorkloadKind)
+	}
+	if a.Spec.WorkloadName != nil && a.Spec.Selector != nil {
+		return fmt.Errorf("can't specify both workload name and selector")
+	}
+	if a.Spec.WorkloadName == nil && a.Spec.Selector == nil {
+		return fmt.Errorf("specify either workload name or selector")
+	}
+	if a.Spec.Selector != nil {
+		_, err := metav1.LabelSelectorAsSelector(a.Spec.Selector)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd, ok := WorkloadCommands.Get(a.Spec.Check)
+	if !ok {
+		return fmt.Errorf("%s is not a valid workload check command", a.Spec.Check)
+	}
+
+	if err := validateVariables(cmd.Vars, a.Spec.Vars); err != nil {
+		return err
+	}
+
+	for _, rcv := range a.Spec.Receivers {
+		found := false
+		for _, state := range cmd.States {
+			if strings.EqualFold(state, rcv.State) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("state %s is unsupported for check command %s", rcv.State, a.Spec.Check)
+		}
+		if rcv.EscalateAfter != nil && rcv.EscalateAfter.Duration < 0 {
+			return fmt.Errorf("escalateAfter of receiver for state %s can't be negative", rcv.State)
+		}
+	}
+
+	if err := a.Spec.Grouping.isValid(); err != nil {
+		return err
+	}
+
+	return checkNotifiers(kc, a)
+}
+
+func (a WorkloadAlert) GetNotifierSecretName() string {
+	return a.Spec.NotifierSecretName
+}
+
+func (a WorkloadAlert) GetReceivers() []Receiver {
+	return a.Spec.Receivers
+}
+
+func (a WorkloadAlert) GetGrouping() *NotificationGrouping {
+	return a.Spec.Grouping
+}
+
+func (a WorkloadAlert) ObjectReference() *core.ObjectReference {
+	return &core.ObjectReference{
+		APIVersion:      SchemeGroupVersion.String(),
+		Kind:            ResourceKindWorkloadAlert,
+		Namespace:       a.Namespace,
+		Name:            a.Name,
+		UID:             a.UID,
+		ResourceVersion: a.ResourceVersion,
+	}
+}
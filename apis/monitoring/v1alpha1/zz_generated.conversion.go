@@ -0,0 +1,921 @@
+// +build !ignore_autogenerated
+
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by conversion-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	unsafe "unsafe"
+
+	monitoring "github.com/appscode/searchlight/apis/monitoring"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	conversion "k8s.io/apimachinery/pkg/conversion"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+func init() {
+	localSchemeBuilder.Register(RegisterConversions)
+}
+
+// RegisterConversions adds conversion functions to the given scheme.
+// Public to allow building arbitrary schemes.
+func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AlertCondition)(nil), (*monitoring.AlertCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertCondition_To_monitoring_AlertCondition(a.(*AlertCondition), b.(*monitoring.AlertCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.AlertCondition)(nil), (*AlertCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_AlertCondition_To_v1alpha1_AlertCondition(a.(*monitoring.AlertCondition), b.(*AlertCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertDependency)(nil), (*monitoring.AlertDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertDependency_To_monitoring_AlertDependency(a.(*AlertDependency), b.(*monitoring.AlertDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.AlertDependency)(nil), (*AlertDependency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_AlertDependency_To_v1alpha1_AlertDependency(a.(*monitoring.AlertDependency), b.(*AlertDependency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertStatus)(nil), (*monitoring.AlertStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertStatus_To_monitoring_AlertStatus(a.(*AlertStatus), b.(*monitoring.AlertStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.AlertStatus)(nil), (*AlertStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_AlertStatus_To_v1alpha1_AlertStatus(a.(*monitoring.AlertStatus), b.(*AlertStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AlertTemplateReference)(nil), (*monitoring.AlertTemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AlertTemplateReference_To_monitoring_AlertTemplateReference(a.(*AlertTemplateReference), b.(*monitoring.AlertTemplateReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.AlertTemplateReference)(nil), (*AlertTemplateReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_AlertTemplateReference_To_v1alpha1_AlertTemplateReference(a.(*monitoring.AlertTemplateReference), b.(*AlertTemplateReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAlert)(nil), (*monitoring.ClusterAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAlert_To_monitoring_ClusterAlert(a.(*ClusterAlert), b.(*monitoring.ClusterAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.ClusterAlert)(nil), (*ClusterAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_ClusterAlert_To_v1alpha1_ClusterAlert(a.(*monitoring.ClusterAlert), b.(*ClusterAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAlertList)(nil), (*monitoring.ClusterAlertList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAlertList_To_monitoring_ClusterAlertList(a.(*ClusterAlertList), b.(*monitoring.ClusterAlertList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.ClusterAlertList)(nil), (*ClusterAlertList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_ClusterAlertList_To_v1alpha1_ClusterAlertList(a.(*monitoring.ClusterAlertList), b.(*ClusterAlertList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAlertSpec)(nil), (*monitoring.ClusterAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAlertSpec_To_monitoring_ClusterAlertSpec(a.(*ClusterAlertSpec), b.(*monitoring.ClusterAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.ClusterAlertSpec)(nil), (*ClusterAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_ClusterAlertSpec_To_v1alpha1_ClusterAlertSpec(a.(*monitoring.ClusterAlertSpec), b.(*ClusterAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GroupedTarget)(nil), (*monitoring.GroupedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GroupedTarget_To_monitoring_GroupedTarget(a.(*GroupedTarget), b.(*monitoring.GroupedTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.GroupedTarget)(nil), (*GroupedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_GroupedTarget_To_v1alpha1_GroupedTarget(a.(*monitoring.GroupedTarget), b.(*GroupedTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalSecretReference)(nil), (*monitoring.LocalSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalSecretReference_To_monitoring_LocalSecretReference(a.(*LocalSecretReference), b.(*monitoring.LocalSecretReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.LocalSecretReference)(nil), (*LocalSecretReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_LocalSecretReference_To_v1alpha1_LocalSecretReference(a.(*monitoring.LocalSecretReference), b.(*LocalSecretReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeAlert)(nil), (*monitoring.NodeAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeAlert_To_monitoring_NodeAlert(a.(*NodeAlert), b.(*monitoring.NodeAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.NodeAlert)(nil), (*NodeAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_NodeAlert_To_v1alpha1_NodeAlert(a.(*monitoring.NodeAlert), b.(*NodeAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeAlertList)(nil), (*monitoring.NodeAlertList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeAlertList_To_monitoring_NodeAlertList(a.(*NodeAlertList), b.(*monitoring.NodeAlertList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.NodeAlertList)(nil), (*NodeAlertList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_NodeAlertList_To_v1alpha1_NodeAlertList(a.(*monitoring.NodeAlertList), b.(*NodeAlertList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeAlertSpec)(nil), (*monitoring.NodeAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeAlertSpec_To_monitoring_NodeAlertSpec(a.(*NodeAlertSpec), b.(*monitoring.NodeAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.NodeAlertSpec)(nil), (*NodeAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_NodeAlertSpec_To_v1alpha1_NodeAlertSpec(a.(*monitoring.NodeAlertSpec), b.(*NodeAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationGroup)(nil), (*monitoring.NotificationGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NotificationGroup_To_monitoring_NotificationGroup(a.(*NotificationGroup), b.(*monitoring.NotificationGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.NotificationGroup)(nil), (*NotificationGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_NotificationGroup_To_v1alpha1_NotificationGroup(a.(*monitoring.NotificationGroup), b.(*NotificationGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NotificationGrouping)(nil), (*monitoring.NotificationGrouping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NotificationGrouping_To_monitoring_NotificationGrouping(a.(*NotificationGrouping), b.(*monitoring.NotificationGrouping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.NotificationGrouping)(nil), (*NotificationGrouping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_NotificationGrouping_To_v1alpha1_NotificationGrouping(a.(*monitoring.NotificationGrouping), b.(*NotificationGrouping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodAlert)(nil), (*monitoring.PodAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodAlert_To_monitoring_PodAlert(a.(*PodAlert), b.(*monitoring.PodAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.PodAlert)(nil), (*PodAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_PodAlert_To_v1alpha1_PodAlert(a.(*monitoring.PodAlert), b.(*PodAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodAlertList)(nil), (*monitoring.PodAlertList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodAlertList_To_monitoring_PodAlertList(a.(*PodAlertList), b.(*monitoring.PodAlertList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.PodAlertList)(nil), (*PodAlertList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_PodAlertList_To_v1alpha1_PodAlertList(a.(*monitoring.PodAlertList), b.(*PodAlertList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PodAlertSpec)(nil), (*monitoring.PodAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodAlertSpec_To_monitoring_PodAlertSpec(a.(*PodAlertSpec), b.(*monitoring.PodAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.PodAlertSpec)(nil), (*PodAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_PodAlertSpec_To_v1alpha1_PodAlertSpec(a.(*monitoring.PodAlertSpec), b.(*PodAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Receiver)(nil), (*monitoring.Receiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Receiver_To_monitoring_Receiver(a.(*Receiver), b.(*monitoring.Receiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.Receiver)(nil), (*Receiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_Receiver_To_v1alpha1_Receiver(a.(*monitoring.Receiver), b.(*Receiver), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetStatus)(nil), (*monitoring.TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetStatus_To_monitoring_TargetStatus(a.(*TargetStatus), b.(*monitoring.TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitoring.TargetStatus)(nil), (*TargetStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_TargetStatus_To_v1alpha1_TargetStatus(a.(*monitoring.TargetStatus), b.(*TargetStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*monitoring.NodeAlertSpec)(nil), (*NodeAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_NodeAlertSpec_To_v1alpha1_NodeAlertSpec(a.(*monitoring.NodeAlertSpec), b.(*NodeAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*monitoring.NodeAlert)(nil), (*NodeAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitoring_NodeAlert_To_v1alpha1_NodeAlert(a.(*monitoring.NodeAlert), b.(*NodeAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*ClusterAlertSpec)(nil), (*monitoring.ClusterAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterAlertSpec_To_monitoring_ClusterAlertSpec(a.(*ClusterAlertSpec), b.(*monitoring.ClusterAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NodeAlertSpec)(nil), (*monitoring.NodeAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeAlertSpec_To_monitoring_NodeAlertSpec(a.(*NodeAlertSpec), b.(*monitoring.NodeAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*NodeAlert)(nil), (*monitoring.NodeAlert)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeAlert_To_monitoring_NodeAlert(a.(*NodeAlert), b.(*monitoring.NodeAlert), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*PodAlertSpec)(nil), (*monitoring.PodAlertSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodAlertSpec_To_monitoring_PodAlertSpec(a.(*PodAlertSpec), b.(*monitoring.PodAlertSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*Receiver)(nil), (*monitoring.Receiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Receiver_To_monitoring_Receiver(a.(*Receiver), b.(*monitoring.Receiver), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AlertCondition_To_monitoring_AlertCondition(in *AlertCondition, out *monitoring.AlertCondition, s conversion.Scope) error {
+	out.Type = monitoring.AlertConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_AlertCondition_To_monitoring_AlertCondition is an autogenerated conversion function.
+func Convert_v1alpha1_AlertCondition_To_monitoring_AlertCondition(in *AlertCondition, out *monitoring.AlertCondition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertCondition_To_monitoring_AlertCondition(in, out, s)
+}
+
+func autoConvert_monitoring_AlertCondition_To_v1alpha1_AlertCondition(in *monitoring.AlertCondition, out *AlertCondition, s conversion.Scope) error {
+	out.Type = AlertConditionType(in.Type)
+	out.Status = v1.ConditionStatus(in.Status)
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_monitoring_AlertCondition_To_v1alpha1_AlertCondition is an autogenerated conversion function.
+func Convert_monitoring_AlertCondition_To_v1alpha1_AlertCondition(in *monitoring.AlertCondition, out *AlertCondition, s conversion.Scope) error {
+	return autoConvert_monitoring_AlertCondition_To_v1alpha1_AlertCondition(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertDependency_To_monitoring_AlertDependency(in *AlertDependency, out *monitoring.AlertDependency, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_AlertDependency_To_monitoring_AlertDependency is an autogenerated conversion function.
+func Convert_v1alpha1_AlertDependency_To_monitoring_AlertDependency(in *AlertDependency, out *monitoring.AlertDependency, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertDependency_To_monitoring_AlertDependency(in, out, s)
+}
+
+func autoConvert_monitoring_AlertDependency_To_v1alpha1_AlertDependency(in *monitoring.AlertDependency, out *AlertDependency, s conversion.Scope) error {
+	out.Kind = in.Kind
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_monitoring_AlertDependency_To_v1alpha1_AlertDependency is an autogenerated conversion function.
+func Convert_monitoring_AlertDependency_To_v1alpha1_AlertDependency(in *monitoring.AlertDependency, out *AlertDependency, s conversion.Scope) error {
+	return autoConvert_monitoring_AlertDependency_To_v1alpha1_AlertDependency(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertStatus_To_monitoring_AlertStatus(in *AlertStatus, out *monitoring.AlertStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]monitoring.AlertCondition)(unsafe.Pointer(&in.Conditions))
+	out.MatchedTargets = in.MatchedTargets
+	out.Targets = *(*[]monitoring.TargetStatus)(unsafe.Pointer(&in.Targets))
+	out.NotificationGroups = *(*[]monitoring.NotificationGroup)(unsafe.Pointer(&in.NotificationGroups))
+	return nil
+}
+
+// Convert_v1alpha1_AlertStatus_To_monitoring_AlertStatus is an autogenerated conversion function.
+func Convert_v1alpha1_AlertStatus_To_monitoring_AlertStatus(in *AlertStatus, out *monitoring.AlertStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertStatus_To_monitoring_AlertStatus(in, out, s)
+}
+
+func autoConvert_monitoring_AlertStatus_To_v1alpha1_AlertStatus(in *monitoring.AlertStatus, out *AlertStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Conditions = *(*[]AlertCondition)(unsafe.Pointer(&in.Conditions))
+	out.MatchedTargets = in.MatchedTargets
+	out.Targets = *(*[]TargetStatus)(unsafe.Pointer(&in.Targets))
+	out.NotificationGroups = *(*[]NotificationGroup)(unsafe.Pointer(&in.NotificationGroups))
+	return nil
+}
+
+// Convert_monitoring_AlertStatus_To_v1alpha1_AlertStatus is an autogenerated conversion function.
+func Convert_monitoring_AlertStatus_To_v1alpha1_AlertStatus(in *monitoring.AlertStatus, out *AlertStatus, s conversion.Scope) error {
+	return autoConvert_monitoring_AlertStatus_To_v1alpha1_AlertStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_AlertTemplateReference_To_monitoring_AlertTemplateReference(in *AlertTemplateReference, out *monitoring.AlertTemplateReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_AlertTemplateReference_To_monitoring_AlertTemplateReference is an autogenerated conversion function.
+func Convert_v1alpha1_AlertTemplateReference_To_monitoring_AlertTemplateReference(in *AlertTemplateReference, out *monitoring.AlertTemplateReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AlertTemplateReference_To_monitoring_AlertTemplateReference(in, out, s)
+}
+
+func autoConvert_monitoring_AlertTemplateReference_To_v1alpha1_AlertTemplateReference(in *monitoring.AlertTemplateReference, out *AlertTemplateReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_monitoring_AlertTemplateReference_To_v1alpha1_AlertTemplateReference is an autogenerated conversion function.
+func Convert_monitoring_AlertTemplateReference_To_v1alpha1_AlertTemplateReference(in *monitoring.AlertTemplateReference, out *AlertTemplateReference, s conversion.Scope) error {
+	return autoConvert_monitoring_AlertTemplateReference_To_v1alpha1_AlertTemplateReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterAlert_To_monitoring_ClusterAlert(in *ClusterAlert, out *monitoring.ClusterAlert, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ClusterAlertSpec_To_monitoring_ClusterAlertSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AlertStatus_To_monitoring_AlertStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAlert_To_monitoring_ClusterAlert is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAlert_To_monitoring_ClusterAlert(in *ClusterAlert, out *monitoring.ClusterAlert, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAlert_To_monitoring_ClusterAlert(in, out, s)
+}
+
+func autoConvert_monitoring_ClusterAlert_To_v1alpha1_ClusterAlert(in *monitoring.ClusterAlert, out *ClusterAlert, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitoring_ClusterAlertSpec_To_v1alpha1_ClusterAlertSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_monitoring_AlertStatus_To_v1alpha1_AlertStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_monitoring_ClusterAlert_To_v1alpha1_ClusterAlert is an autogenerated conversion function.
+func Convert_monitoring_ClusterAlert_To_v1alpha1_ClusterAlert(in *monitoring.ClusterAlert, out *ClusterAlert, s conversion.Scope) error {
+	return autoConvert_monitoring_ClusterAlert_To_v1alpha1_ClusterAlert(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterAlertList_To_monitoring_ClusterAlertList(in *ClusterAlertList, out *monitoring.ClusterAlertList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]monitoring.ClusterAlert, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_ClusterAlert_To_monitoring_ClusterAlert(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterAlertList_To_monitoring_ClusterAlertList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterAlertList_To_monitoring_ClusterAlertList(in *ClusterAlertList, out *monitoring.ClusterAlertList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterAlertList_To_monitoring_ClusterAlertList(in, out, s)
+}
+
+func autoConvert_monitoring_ClusterAlertList_To_v1alpha1_ClusterAlertList(in *monitoring.ClusterAlertList, out *ClusterAlertList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterAlert, len(*in))
+		for i := range *in {
+			if err := Convert_monitoring_ClusterAlert_To_v1alpha1_ClusterAlert(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_monitoring_ClusterAlertList_To_v1alpha1_ClusterAlertList is an autogenerated conversion function.
+func Convert_monitoring_ClusterAlertList_To_v1alpha1_ClusterAlertList(in *monitoring.ClusterAlertList, out *ClusterAlertList, s conversion.Scope) error {
+	return autoConvert_monitoring_ClusterAlertList_To_v1alpha1_ClusterAlertList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterAlertSpec_To_monitoring_ClusterAlertSpec(in *ClusterAlertSpec, out *monitoring.ClusterAlertSpec, s conversion.Scope) error {
+	out.TemplateRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.TemplateRef))
+	out.Check = in.Check
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.MaxCheckAttempts = in.MaxCheckAttempts
+	out.RetryInterval = in.RetryInterval
+	out.EnableFlapping = in.EnableFlapping
+	out.FlappingThresholdLow = in.FlappingThresholdLow
+	out.FlappingThresholdHigh = in.FlappingThresholdHigh
+	out.NotificationDelay = in.NotificationDelay
+	out.NotifierSecretName = in.NotifierSecretName
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]monitoring.Receiver, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Receiver_To_monitoring_Receiver(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Receivers = nil
+	}
+	out.Vars = *(*map[string]string)(unsafe.Pointer(&in.Vars))
+	out.Paused = in.Paused
+	return nil
+}
+
+func autoConvert_monitoring_ClusterAlertSpec_To_v1alpha1_ClusterAlertSpec(in *monitoring.ClusterAlertSpec, out *ClusterAlertSpec, s conversion.Scope) error {
+	out.TemplateRef = (*AlertTemplateReference)(unsafe.Pointer(in.TemplateRef))
+	out.Check = in.Check
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.MaxCheckAttempts = in.MaxCheckAttempts
+	out.RetryInterval = in.RetryInterval
+	out.EnableFlapping = in.EnableFlapping
+	out.FlappingThresholdLow = in.FlappingThresholdLow
+	out.FlappingThresholdHigh = in.FlappingThresholdHigh
+	out.NotificationDelay = in.NotificationDelay
+	out.NotifierSecretName = in.NotifierSecretName
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			if err := Convert_monitoring_Receiver_To_v1alpha1_Receiver(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Receivers = nil
+	}
+	out.Vars = *(*map[string]string)(unsafe.Pointer(&in.Vars))
+	out.Paused = in.Paused
+	return nil
+}
+
+// Convert_monitoring_ClusterAlertSpec_To_v1alpha1_ClusterAlertSpec is an autogenerated conversion function.
+func Convert_monitoring_ClusterAlertSpec_To_v1alpha1_ClusterAlertSpec(in *monitoring.ClusterAlertSpec, out *ClusterAlertSpec, s conversion.Scope) error {
+	return autoConvert_monitoring_ClusterAlertSpec_To_v1alpha1_ClusterAlertSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_GroupedTarget_To_monitoring_GroupedTarget(in *GroupedTarget, out *monitoring.GroupedTarget, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = in.State
+	out.CheckOutput = in.CheckOutput
+	out.LastTimestamp = in.LastTimestamp
+	out.Notified = in.Notified
+	return nil
+}
+
+// Convert_v1alpha1_GroupedTarget_To_monitoring_GroupedTarget is an autogenerated conversion function.
+func Convert_v1alpha1_GroupedTarget_To_monitoring_GroupedTarget(in *GroupedTarget, out *monitoring.GroupedTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GroupedTarget_To_monitoring_GroupedTarget(in, out, s)
+}
+
+func autoConvert_monitoring_GroupedTarget_To_v1alpha1_GroupedTarget(in *monitoring.GroupedTarget, out *GroupedTarget, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = in.State
+	out.CheckOutput = in.CheckOutput
+	out.LastTimestamp = in.LastTimestamp
+	out.Notified = in.Notified
+	return nil
+}
+
+// Convert_monitoring_GroupedTarget_To_v1alpha1_GroupedTarget is an autogenerated conversion function.
+func Convert_monitoring_GroupedTarget_To_v1alpha1_GroupedTarget(in *monitoring.GroupedTarget, out *GroupedTarget, s conversion.Scope) error {
+	return autoConvert_monitoring_GroupedTarget_To_v1alpha1_GroupedTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_LocalSecretReference_To_monitoring_LocalSecretReference(in *LocalSecretReference, out *monitoring.LocalSecretReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_LocalSecretReference_To_monitoring_LocalSecretReference is an autogenerated conversion function.
+func Convert_v1alpha1_LocalSecretReference_To_monitoring_LocalSecretReference(in *LocalSecretReference, out *monitoring.LocalSecretReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalSecretReference_To_monitoring_LocalSecretReference(in, out, s)
+}
+
+func autoConvert_monitoring_LocalSecretReference_To_v1alpha1_LocalSecretReference(in *monitoring.LocalSecretReference, out *LocalSecretReference, s conversion.Scope) error {
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_monitoring_LocalSecretReference_To_v1alpha1_LocalSecretReference is an autogenerated conversion function.
+func Convert_monitoring_LocalSecretReference_To_v1alpha1_LocalSecretReference(in *monitoring.LocalSecretReference, out *LocalSecretReference, s conversion.Scope) error {
+	return autoConvert_monitoring_LocalSecretReference_To_v1alpha1_LocalSecretReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeAlert_To_monitoring_NodeAlert(in *NodeAlert, out *monitoring.NodeAlert, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_NodeAlertSpec_To_monitoring_NodeAlertSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AlertStatus_To_monitoring_AlertStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_monitoring_NodeAlert_To_v1alpha1_NodeAlert(in *monitoring.NodeAlert, out *NodeAlert, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitoring_NodeAlertSpec_To_v1alpha1_NodeAlertSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_monitoring_AlertStatus_To_v1alpha1_AlertStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_NodeAlertList_To_monitoring_NodeAlertList(in *NodeAlertList, out *monitoring.NodeAlertList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]monitoring.NodeAlert, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_NodeAlert_To_monitoring_NodeAlert(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_NodeAlertList_To_monitoring_NodeAlertList is an autogenerated conversion function.
+func Convert_v1alpha1_NodeAlertList_To_monitoring_NodeAlertList(in *NodeAlertList, out *monitoring.NodeAlertList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeAlertList_To_monitoring_NodeAlertList(in, out, s)
+}
+
+func autoConvert_monitoring_NodeAlertList_To_v1alpha1_NodeAlertList(in *monitoring.NodeAlertList, out *NodeAlertList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeAlert, len(*in))
+		for i := range *in {
+			if err := Convert_monitoring_NodeAlert_To_v1alpha1_NodeAlert(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_monitoring_NodeAlertList_To_v1alpha1_NodeAlertList is an autogenerated conversion function.
+func Convert_monitoring_NodeAlertList_To_v1alpha1_NodeAlertList(in *monitoring.NodeAlertList, out *NodeAlertList, s conversion.Scope) error {
+	return autoConvert_monitoring_NodeAlertList_To_v1alpha1_NodeAlertList(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeAlertSpec_To_monitoring_NodeAlertSpec(in *NodeAlertSpec, out *monitoring.NodeAlertSpec, s conversion.Scope) error {
+	// WARNING: in.Selector requires manual conversion: inconvertible types (map[string]string vs *k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector)
+	out.NodeName = (*string)(unsafe.Pointer(in.NodeName))
+	out.TemplateRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.TemplateRef))
+	out.Check = in.Check
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.MaxCheckAttempts = in.MaxCheckAttempts
+	out.RetryInterval = in.RetryInterval
+	out.EnableFlapping = in.EnableFlapping
+	out.FlappingThresholdLow = in.FlappingThresholdLow
+	out.FlappingThresholdHigh = in.FlappingThresholdHigh
+	out.NotificationDelay = in.NotificationDelay
+	out.Grouping = (*monitoring.NotificationGrouping)(unsafe.Pointer(in.Grouping))
+	out.NotifierSecretName = in.NotifierSecretName
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]monitoring.Receiver, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Receiver_To_monitoring_Receiver(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Receivers = nil
+	}
+	out.Vars = *(*map[string]string)(unsafe.Pointer(&in.Vars))
+	out.DependsOn = *(*[]monitoring.AlertDependency)(unsafe.Pointer(&in.DependsOn))
+	out.Paused = in.Paused
+	return nil
+}
+
+func autoConvert_monitoring_NodeAlertSpec_To_v1alpha1_NodeAlertSpec(in *monitoring.NodeAlertSpec, out *NodeAlertSpec, s conversion.Scope) error {
+	// WARNING: in.Selector requires manual conversion: inconvertible types (*k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector vs map[string]string)
+	out.NodeName = (*string)(unsafe.Pointer(in.NodeName))
+	out.TemplateRef = (*AlertTemplateReference)(unsafe.Pointer(in.TemplateRef))
+	out.Check = in.Check
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.MaxCheckAttempts = in.MaxCheckAttempts
+	out.RetryInterval = in.RetryInterval
+	out.EnableFlapping = in.EnableFlapping
+	out.FlappingThresholdLow = in.FlappingThresholdLow
+	out.FlappingThresholdHigh = in.FlappingThresholdHigh
+	out.NotificationDelay = in.NotificationDelay
+	out.Grouping = (*NotificationGrouping)(unsafe.Pointer(in.Grouping))
+	out.NotifierSecretName = in.NotifierSecretName
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			if err := Convert_monitoring_Receiver_To_v1alpha1_Receiver(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Receivers = nil
+	}
+	out.Vars = *(*map[string]string)(unsafe.Pointer(&in.Vars))
+	out.DependsOn = *(*[]AlertDependency)(unsafe.Pointer(&in.DependsOn))
+	out.Paused = in.Paused
+	return nil
+}
+
+func autoConvert_v1alpha1_NotificationGroup_To_monitoring_NotificationGroup(in *NotificationGroup, out *monitoring.NotificationGroup, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.FirstTimestamp = in.FirstTimestamp
+	out.NotifiedTimestamp = (*metav1.Time)(unsafe.Pointer(in.NotifiedTimestamp))
+	out.NotifiedStates = *(*[]string)(unsafe.Pointer(&in.NotifiedStates))
+	out.Targets = *(*[]monitoring.GroupedTarget)(unsafe.Pointer(&in.Targets))
+	return nil
+}
+
+// Convert_v1alpha1_NotificationGroup_To_monitoring_NotificationGroup is an autogenerated conversion function.
+func Convert_v1alpha1_NotificationGroup_To_monitoring_NotificationGroup(in *NotificationGroup, out *monitoring.NotificationGroup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NotificationGroup_To_monitoring_NotificationGroup(in, out, s)
+}
+
+func autoConvert_monitoring_NotificationGroup_To_v1alpha1_NotificationGroup(in *monitoring.NotificationGroup, out *NotificationGroup, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.FirstTimestamp = in.FirstTimestamp
+	out.NotifiedTimestamp = (*metav1.Time)(unsafe.Pointer(in.NotifiedTimestamp))
+	out.NotifiedStates = *(*[]string)(unsafe.Pointer(&in.NotifiedStates))
+	out.Targets = *(*[]GroupedTarget)(unsafe.Pointer(&in.Targets))
+	return nil
+}
+
+// Convert_monitoring_NotificationGroup_To_v1alpha1_NotificationGroup is an autogenerated conversion function.
+func Convert_monitoring_NotificationGroup_To_v1alpha1_NotificationGroup(in *monitoring.NotificationGroup, out *NotificationGroup, s conversion.Scope) error {
+	return autoConvert_monitoring_NotificationGroup_To_v1alpha1_NotificationGroup(in, out, s)
+}
+
+func autoConvert_v1alpha1_NotificationGrouping_To_monitoring_NotificationGrouping(in *NotificationGrouping, out *monitoring.NotificationGrouping, s conversion.Scope) error {
+	out.By = *(*[]string)(unsafe.Pointer(&in.By))
+	out.GroupWait = in.GroupWait
+	return nil
+}
+
+// Convert_v1alpha1_NotificationGrouping_To_monitoring_NotificationGrouping is an autogenerated conversion function.
+func Convert_v1alpha1_NotificationGrouping_To_monitoring_NotificationGrouping(in *NotificationGrouping, out *monitoring.NotificationGrouping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NotificationGrouping_To_monitoring_NotificationGrouping(in, out, s)
+}
+
+func autoConvert_monitoring_NotificationGrouping_To_v1alpha1_NotificationGrouping(in *monitoring.NotificationGrouping, out *NotificationGrouping, s conversion.Scope) error {
+	out.By = *(*[]string)(unsafe.Pointer(&in.By))
+	out.GroupWait = in.GroupWait
+	return nil
+}
+
+// Convert_monitoring_NotificationGrouping_To_v1alpha1_NotificationGrouping is an autogenerated conversion function.
+func Convert_monitoring_NotificationGrouping_To_v1alpha1_NotificationGrouping(in *monitoring.NotificationGrouping, out *NotificationGrouping, s conversion.Scope) error {
+	return autoConvert_monitoring_NotificationGrouping_To_v1alpha1_NotificationGrouping(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodAlert_To_monitoring_PodAlert(in *PodAlert, out *monitoring.PodAlert, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_PodAlertSpec_To_monitoring_PodAlertSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_AlertStatus_To_monitoring_AlertStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PodAlert_To_monitoring_PodAlert is an autogenerated conversion function.
+func Convert_v1alpha1_PodAlert_To_monitoring_PodAlert(in *PodAlert, out *monitoring.PodAlert, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodAlert_To_monitoring_PodAlert(in, out, s)
+}
+
+func autoConvert_monitoring_PodAlert_To_v1alpha1_PodAlert(in *monitoring.PodAlert, out *PodAlert, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitoring_PodAlertSpec_To_v1alpha1_PodAlertSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_monitoring_AlertStatus_To_v1alpha1_AlertStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_monitoring_PodAlert_To_v1alpha1_PodAlert is an autogenerated conversion function.
+func Convert_monitoring_PodAlert_To_v1alpha1_PodAlert(in *monitoring.PodAlert, out *PodAlert, s conversion.Scope) error {
+	return autoConvert_monitoring_PodAlert_To_v1alpha1_PodAlert(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodAlertList_To_monitoring_PodAlertList(in *PodAlertList, out *monitoring.PodAlertList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]monitoring.PodAlert, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_PodAlert_To_monitoring_PodAlert(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha1_PodAlertList_To_monitoring_PodAlertList is an autogenerated conversion function.
+func Convert_v1alpha1_PodAlertList_To_monitoring_PodAlertList(in *PodAlertList, out *monitoring.PodAlertList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodAlertList_To_monitoring_PodAlertList(in, out, s)
+}
+
+func autoConvert_monitoring_PodAlertList_To_v1alpha1_PodAlertList(in *monitoring.PodAlertList, out *PodAlertList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodAlert, len(*in))
+		for i := range *in {
+			if err := Convert_monitoring_PodAlert_To_v1alpha1_PodAlert(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_monitoring_PodAlertList_To_v1alpha1_PodAlertList is an autogenerated conversion function.
+func Convert_monitoring_PodAlertList_To_v1alpha1_PodAlertList(in *monitoring.PodAlertList, out *PodAlertList, s conversion.Scope) error {
+	return autoConvert_monitoring_PodAlertList_To_v1alpha1_PodAlertList(in, out, s)
+}
+
+func autoConvert_v1alpha1_PodAlertSpec_To_monitoring_PodAlertSpec(in *PodAlertSpec, out *monitoring.PodAlertSpec, s conversion.Scope) error {
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.PodName = (*string)(unsafe.Pointer(in.PodName))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.TemplateRef = (*v1.LocalObjectReference)(unsafe.Pointer(in.TemplateRef))
+	out.Check = in.Check
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.MaxCheckAttempts = in.MaxCheckAttempts
+	out.RetryInterval = in.RetryInterval
+	out.EnableFlapping = in.EnableFlapping
+	out.FlappingThresholdLow = in.FlappingThresholdLow
+	out.FlappingThresholdHigh = in.FlappingThresholdHigh
+	out.NotificationDelay = in.NotificationDelay
+	out.Grouping = (*monitoring.NotificationGrouping)(unsafe.Pointer(in.Grouping))
+	out.NotifierSecretName = in.NotifierSecretName
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]monitoring.Receiver, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha1_Receiver_To_monitoring_Receiver(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Receivers = nil
+	}
+	out.Vars = *(*map[string]string)(unsafe.Pointer(&in.Vars))
+	out.DependsOn = *(*[]monitoring.AlertDependency)(unsafe.Pointer(&in.DependsOn))
+	out.Paused = in.Paused
+	return nil
+}
+
+func autoConvert_monitoring_PodAlertSpec_To_v1alpha1_PodAlertSpec(in *monitoring.PodAlertSpec, out *PodAlertSpec, s conversion.Scope) error {
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.PodName = (*string)(unsafe.Pointer(in.PodName))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.TemplateRef = (*AlertTemplateReference)(unsafe.Pointer(in.TemplateRef))
+	out.Check = in.Check
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.MaxCheckAttempts = in.MaxCheckAttempts
+	out.RetryInterval = in.RetryInterval
+	out.EnableFlapping = in.EnableFlapping
+	out.FlappingThresholdLow = in.FlappingThresholdLow
+	out.FlappingThresholdHigh = in.FlappingThresholdHigh
+	out.NotificationDelay = in.NotificationDelay
+	out.Grouping = (*NotificationGrouping)(unsafe.Pointer(in.Grouping))
+	out.NotifierSecretName = in.NotifierSecretName
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			if err := Convert_monitoring_Receiver_To_v1alpha1_Receiver(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Receivers = nil
+	}
+	out.Vars = *(*map[string]string)(unsafe.Pointer(&in.Vars))
+	out.DependsOn = *(*[]AlertDependency)(unsafe.Pointer(&in.DependsOn))
+	out.Paused = in.Paused
+	return nil
+}
+
+// Convert_monitoring_PodAlertSpec_To_v1alpha1_PodAlertSpec is an autogenerated conversion function.
+func Convert_monitoring_PodAlertSpec_To_v1alpha1_PodAlertSpec(in *monitoring.PodAlertSpec, out *PodAlertSpec, s conversion.Scope) error {
+	return autoConvert_monitoring_PodAlertSpec_To_v1alpha1_PodAlertSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_Receiver_To_monitoring_Receiver(in *Receiver, out *monitoring.Receiver, s conversion.Scope) error {
+	out.State = monitoring.IcingaState(in.State)
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	out.Notifier = in.Notifier
+	out.OnCallSchedule = in.OnCallSchedule
+	out.EscalateAfter = (*metav1.Duration)(unsafe.Pointer(in.EscalateAfter))
+	out.SecretRef = (*monitoring.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+func autoConvert_monitoring_Receiver_To_v1alpha1_Receiver(in *monitoring.Receiver, out *Receiver, s conversion.Scope) error {
+	out.State = string(in.State)
+	out.To = *(*[]string)(unsafe.Pointer(&in.To))
+	out.Notifier = in.Notifier
+	out.OnCallSchedule = in.OnCallSchedule
+	out.EscalateAfter = (*metav1.Duration)(unsafe.Pointer(in.EscalateAfter))
+	out.SecretRef = (*LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_monitoring_Receiver_To_v1alpha1_Receiver is an autogenerated conversion function.
+func Convert_monitoring_Receiver_To_v1alpha1_Receiver(in *monitoring.Receiver, out *Receiver, s conversion.Scope) error {
+	return autoConvert_monitoring_Receiver_To_v1alpha1_Receiver(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetStatus_To_monitoring_TargetStatus(in *TargetStatus, out *monitoring.TargetStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = in.State
+	out.LastCheckOutput = in.LastCheckOutput
+	out.LastCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastCheckTime))
+	return nil
+}
+
+// Convert_v1alpha1_TargetStatus_To_monitoring_TargetStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TargetStatus_To_monitoring_TargetStatus(in *TargetStatus, out *monitoring.TargetStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetStatus_To_monitoring_TargetStatus(in, out, s)
+}
+
+func autoConvert_monitoring_TargetStatus_To_v1alpha1_TargetStatus(in *monitoring.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = in.State
+	out.LastCheckOutput = in.LastCheckOutput
+	out.LastCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastCheckTime))
+	return nil
+}
+
+// Convert_monitoring_TargetStatus_To_v1alpha1_TargetStatus is an autogenerated conversion function.
+func Convert_monitoring_TargetStatus_To_v1alpha1_TargetStatus(in *monitoring.TargetStatus, out *TargetStatus, s conversion.Scope) error {
+	return autoConvert_monitoring_TargetStatus_To_v1alpha1_TargetStatus(in, out, s)
+}
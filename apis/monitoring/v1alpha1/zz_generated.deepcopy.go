@@ -26,7 +26,77 @@ import (
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAlert) DeepCopyInto(out *ClusterAlert) {
+func (in *AlertCondition) DeepCopyInto(out *AlertCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertCondition.
+func (in *AlertCondition) DeepCopy() *AlertCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertDependency) DeepCopyInto(out *AlertDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertDependency.
+func (in *AlertDependency) DeepCopy() *AlertDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertStatus) DeepCopyInto(out *AlertStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AlertCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]TargetStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NotificationGroups != nil {
+		in, out := &in.NotificationGroups, &out.NotificationGroups
+		*out = make([]NotificationGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertStatus.
+func (in *AlertStatus) DeepCopy() *AlertStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertTemplate) DeepCopyInto(out *AlertTemplate) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -34,18 +104,18 @@ func (in *ClusterAlert) DeepCopyInto(out *ClusterAlert) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlert.
-func (in *ClusterAlert) DeepCopy() *ClusterAlert {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertTemplate.
+func (in *AlertTemplate) DeepCopy() *AlertTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAlert)
+	out := new(AlertTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterAlert) DeepCopyObject() runtime.Object {
+func (in *AlertTemplate) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -53,13 +123,13 @@ func (in *ClusterAlert) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAlertList) DeepCopyInto(out *ClusterAlertList) {
+func (in *AlertTemplateList) DeepCopyInto(out *AlertTemplateList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterAlert, len(*in))
+		*out = make([]AlertTemplate, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -67,18 +137,18 @@ func (in *ClusterAlertList) DeepCopyInto(out *ClusterAlertList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertList.
-func (in *ClusterAlertList) DeepCopy() *ClusterAlertList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertTemplateList.
+func (in *AlertTemplateList) DeepCopy() *AlertTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAlertList)
+	out := new(AlertTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterAlertList) DeepCopyObject() runtime.Object {
+func (in *AlertTemplateList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -86,7 +156,23 @@ func (in *ClusterAlertList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterAlertSpec) DeepCopyInto(out *ClusterAlertSpec) {
+func (in *AlertTemplateReference) DeepCopyInto(out *AlertTemplateReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertTemplateReference.
+func (in *AlertTemplateReference) DeepCopy() *AlertTemplateReference {
+	if in == nil {
+		return nil
+	}
+	out := new(AlertTemplateReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AlertTemplateSpec) DeepCopyInto(out *AlertTemplateSpec) {
 	*out = *in
 	out.CheckInterval = in.CheckInterval
 	out.AlertInterval = in.AlertInterval
@@ -107,37 +193,38 @@ func (in *ClusterAlertSpec) DeepCopyInto(out *ClusterAlertSpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertSpec.
-func (in *ClusterAlertSpec) DeepCopy() *ClusterAlertSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlertTemplateSpec.
+func (in *AlertTemplateSpec) DeepCopy() *AlertTemplateSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterAlertSpec)
+	out := new(AlertTemplateSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Incident) DeepCopyInto(out *Incident) {
+func (in *ClusterAlert) DeepCopyInto(out *ClusterAlert) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Incident.
-func (in *Incident) DeepCopy() *Incident {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlert.
+func (in *ClusterAlert) DeepCopy() *ClusterAlert {
 	if in == nil {
 		return nil
 	}
-	out := new(Incident)
+	out := new(ClusterAlert)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Incident) DeepCopyObject() runtime.Object {
+func (in *ClusterAlert) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -145,13 +232,13 @@ func (in *Incident) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IncidentList) DeepCopyInto(out *IncidentList) {
+func (in *ClusterAlertList) DeepCopyInto(out *ClusterAlertList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Incident, len(*in))
+		*out = make([]ClusterAlert, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -159,18 +246,18 @@ func (in *IncidentList) DeepCopyInto(out *IncidentList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentList.
-func (in *IncidentList) DeepCopy() *IncidentList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertList.
+func (in *ClusterAlertList) DeepCopy() *ClusterAlertList {
 	if in == nil {
 		return nil
 	}
-	out := new(IncidentList)
+	out := new(ClusterAlertList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IncidentList) DeepCopyObject() runtime.Object {
+func (in *ClusterAlertList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -178,77 +265,82 @@ func (in *IncidentList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IncidentNotification) DeepCopyInto(out *IncidentNotification) {
+func (in *ClusterAlertSpec) DeepCopyInto(out *ClusterAlertSpec) {
 	*out = *in
-	if in.Author != nil {
-		in, out := &in.Author, &out.Author
-		*out = new(string)
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(AlertTemplateReference)
 		**out = **in
 	}
-	if in.Comment != nil {
-		in, out := &in.Comment, &out.Comment
-		*out = new(string)
-		**out = **in
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.RetryInterval = in.RetryInterval
+	out.NotificationDelay = in.NotificationDelay
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	in.FirstTimestamp.DeepCopyInto(&out.FirstTimestamp)
-	in.LastTimestamp.DeepCopyInto(&out.LastTimestamp)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentNotification.
-func (in *IncidentNotification) DeepCopy() *IncidentNotification {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAlertSpec.
+func (in *ClusterAlertSpec) DeepCopy() *ClusterAlertSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IncidentNotification)
+	out := new(ClusterAlertSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IncidentStatus) DeepCopyInto(out *IncidentStatus) {
+func (in *CorrelatedIncidentReference) DeepCopyInto(out *CorrelatedIncidentReference) {
 	*out = *in
-	if in.Notifications != nil {
-		in, out := &in.Notifications, &out.Notifications
-		*out = make([]IncidentNotification, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentStatus.
-func (in *IncidentStatus) DeepCopy() *IncidentStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CorrelatedIncidentReference.
+func (in *CorrelatedIncidentReference) DeepCopy() *CorrelatedIncidentReference {
 	if in == nil {
 		return nil
 	}
-	out := new(IncidentStatus)
+	out := new(CorrelatedIncidentReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeAlert) DeepCopyInto(out *NodeAlert) {
+func (in *Downtime) DeepCopyInto(out *Downtime) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAlert.
-func (in *NodeAlert) DeepCopy() *NodeAlert {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Downtime.
+func (in *Downtime) DeepCopy() *Downtime {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeAlert)
+	out := new(Downtime)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NodeAlert) DeepCopyObject() runtime.Object {
+func (in *Downtime) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -256,13 +348,13 @@ func (in *NodeAlert) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeAlertList) DeepCopyInto(out *NodeAlertList) {
+func (in *DowntimeList) DeepCopyInto(out *DowntimeList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]NodeAlert, len(*in))
+		*out = make([]Downtime, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -270,18 +362,18 @@ func (in *NodeAlertList) DeepCopyInto(out *NodeAlertList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAlertList.
-func (in *NodeAlertList) DeepCopy() *NodeAlertList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeList.
+func (in *DowntimeList) DeepCopy() *DowntimeList {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeAlertList)
+	out := new(DowntimeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *NodeAlertList) DeepCopyObject() runtime.Object {
+func (in *DowntimeList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -289,142 +381,121 @@ func (in *NodeAlertList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeAlertSpec) DeepCopyInto(out *NodeAlertSpec) {
+func (in *DowntimeRecurrence) DeepCopyInto(out *DowntimeRecurrence) {
 	*out = *in
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.NodeName != nil {
-		in, out := &in.NodeName, &out.NodeName
-		*out = new(string)
-		**out = **in
-	}
-	out.CheckInterval = in.CheckInterval
-	out.AlertInterval = in.AlertInterval
-	if in.Receivers != nil {
-		in, out := &in.Receivers, &out.Receivers
-		*out = make([]Receiver, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Vars != nil {
-		in, out := &in.Vars, &out.Vars
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out.Interval = in.Interval
+	if in.Until != nil {
+		in, out := &in.Until, &out.Until
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAlertSpec.
-func (in *NodeAlertSpec) DeepCopy() *NodeAlertSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeRecurrence.
+func (in *DowntimeRecurrence) DeepCopy() *DowntimeRecurrence {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeAlertSpec)
+	out := new(DowntimeRecurrence)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PluginArguments) DeepCopyInto(out *PluginArguments) {
+func (in *DowntimeSpec) DeepCopyInto(out *DowntimeSpec) {
 	*out = *in
-	if in.Vars != nil {
-		in, out := &in.Vars, &out.Vars
-		*out = new(PluginVars)
+	if in.AlertName != nil {
+		in, out := &in.AlertName, &out.AlertName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Host != nil {
-		in, out := &in.Host, &out.Host
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	if in.Recurrence != nil {
+		in, out := &in.Recurrence, &out.Recurrence
+		*out = new(DowntimeRecurrence)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginArguments.
-func (in *PluginArguments) DeepCopy() *PluginArguments {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeSpec.
+func (in *DowntimeSpec) DeepCopy() *DowntimeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PluginArguments)
+	out := new(DowntimeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PluginVarField) DeepCopyInto(out *PluginVarField) {
+func (in *DowntimeStatus) DeepCopyInto(out *DowntimeStatus) {
 	*out = *in
+	if in.WindowStart != nil {
+		in, out := &in.WindowStart, &out.WindowStart
+		*out = (*in).DeepCopy()
+	}
+	if in.WindowEnd != nil {
+		in, out := &in.WindowEnd, &out.WindowEnd
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginVarField.
-func (in *PluginVarField) DeepCopy() *PluginVarField {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DowntimeStatus.
+func (in *DowntimeStatus) DeepCopy() *DowntimeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PluginVarField)
+	out := new(DowntimeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PluginVars) DeepCopyInto(out *PluginVars) {
+func (in *GroupedTarget) DeepCopyInto(out *GroupedTarget) {
 	*out = *in
-	if in.Fields != nil {
-		in, out := &in.Fields, &out.Fields
-		*out = make(map[string]PluginVarField, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Required != nil {
-		in, out := &in.Required, &out.Required
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	in.LastTimestamp.DeepCopyInto(&out.LastTimestamp)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginVars.
-func (in *PluginVars) DeepCopy() *PluginVars {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupedTarget.
+func (in *GroupedTarget) DeepCopy() *GroupedTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(PluginVars)
+	out := new(GroupedTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodAlert) DeepCopyInto(out *PodAlert) {
+func (in *Incident) DeepCopyInto(out *Incident) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAlert.
-func (in *PodAlert) DeepCopy() *PodAlert {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Incident.
+func (in *Incident) DeepCopy() *Incident {
 	if in == nil {
 		return nil
 	}
-	out := new(PodAlert)
+	out := new(Incident)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PodAlert) DeepCopyObject() runtime.Object {
+func (in *Incident) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -432,13 +503,13 @@ func (in *PodAlert) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodAlertList) DeepCopyInto(out *PodAlertList) {
+func (in *IncidentList) DeepCopyInto(out *IncidentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]PodAlert, len(*in))
+		*out = make([]Incident, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -446,18 +517,18 @@ func (in *PodAlertList) DeepCopyInto(out *PodAlertList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAlertList.
-func (in *PodAlertList) DeepCopy() *PodAlertList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentList.
+func (in *IncidentList) DeepCopy() *IncidentList {
 	if in == nil {
 		return nil
 	}
-	out := new(PodAlertList)
+	out := new(IncidentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PodAlertList) DeepCopyObject() runtime.Object {
+func (in *IncidentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -465,89 +536,127 @@ func (in *PodAlertList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodAlertSpec) DeepCopyInto(out *PodAlertSpec) {
+func (in *IncidentNotification) DeepCopyInto(out *IncidentNotification) {
 	*out = *in
-	if in.Selector != nil {
-		in, out := &in.Selector, &out.Selector
-		*out = new(v1.LabelSelector)
-		(*in).DeepCopyInto(*out)
+	if in.Author != nil {
+		in, out := &in.Author, &out.Author
+		*out = new(string)
+		**out = **in
 	}
-	if in.PodName != nil {
-		in, out := &in.PodName, &out.PodName
+	if in.Comment != nil {
+		in, out := &in.Comment, &out.Comment
 		*out = new(string)
 		**out = **in
 	}
-	out.CheckInterval = in.CheckInterval
-	out.AlertInterval = in.AlertInterval
-	if in.Receivers != nil {
-		in, out := &in.Receivers, &out.Receivers
-		*out = make([]Receiver, len(*in))
+	in.FirstTimestamp.DeepCopyInto(&out.FirstTimestamp)
+	in.LastTimestamp.DeepCopyInto(&out.LastTimestamp)
+	if in.EscalateAfter != nil {
+		in, out := &in.EscalateAfter, &out.EscalateAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentNotification.
+func (in *IncidentNotification) DeepCopy() *IncidentNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(IncidentNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IncidentStatus) DeepCopyInto(out *IncidentStatus) {
+	*out = *in
+	if in.Notifications != nil {
+		in, out := &in.Notifications, &out.Notifications
+		*out = make([]IncidentNotification, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Vars != nil {
-		in, out := &in.Vars, &out.Vars
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	if in.Deliveries != nil {
+		in, out := &in.Deliveries, &out.Deliveries
+		*out = make([]NotificationDelivery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ParentRef != nil {
+		in, out := &in.ParentRef, &out.ParentRef
+		*out = new(CorrelatedIncidentReference)
+		**out = **in
+	}
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = make([]CorrelatedIncidentReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.WaitingRoutes != nil {
+		in, out := &in.WaitingRoutes, &out.WaitingRoutes
+		*out = make([]WaitingRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextEscalationTimestamp != nil {
+		in, out := &in.NextEscalationTimestamp, &out.NextEscalationTimestamp
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAlertSpec.
-func (in *PodAlertSpec) DeepCopy() *PodAlertSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IncidentStatus.
+func (in *IncidentStatus) DeepCopy() *IncidentStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PodAlertSpec)
+	out := new(IncidentStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Receiver) DeepCopyInto(out *Receiver) {
+func (in *LocalSecretReference) DeepCopyInto(out *LocalSecretReference) {
 	*out = *in
-	if in.To != nil {
-		in, out := &in.To, &out.To
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
-func (in *Receiver) DeepCopy() *Receiver {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalSecretReference.
+func (in *LocalSecretReference) DeepCopy() *LocalSecretReference {
 	if in == nil {
 		return nil
 	}
-	out := new(Receiver)
+	out := new(LocalSecretReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchlightPlugin) DeepCopyInto(out *SearchlightPlugin) {
+func (in *NodeAlert) DeepCopyInto(out *NodeAlert) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchlightPlugin.
-func (in *SearchlightPlugin) DeepCopy() *SearchlightPlugin {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAlert.
+func (in *NodeAlert) DeepCopy() *NodeAlert {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchlightPlugin)
+	out := new(NodeAlert)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SearchlightPlugin) DeepCopyObject() runtime.Object {
+func (in *NodeAlert) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -555,13 +664,13 @@ func (in *SearchlightPlugin) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchlightPluginList) DeepCopyInto(out *SearchlightPluginList) {
+func (in *NodeAlertList) DeepCopyInto(out *NodeAlertList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SearchlightPlugin, len(*in))
+		*out = make([]NodeAlert, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -569,18 +678,18 @@ func (in *SearchlightPluginList) DeepCopyInto(out *SearchlightPluginList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchlightPluginList.
-func (in *SearchlightPluginList) DeepCopy() *SearchlightPluginList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAlertList.
+func (in *NodeAlertList) DeepCopy() *NodeAlertList {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchlightPluginList)
+	out := new(NodeAlertList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SearchlightPluginList) DeepCopyObject() runtime.Object {
+func (in *NodeAlertList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -588,49 +697,1160 @@ func (in *SearchlightPluginList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SearchlightPluginSpec) DeepCopyInto(out *SearchlightPluginSpec) {
+func (in *NodeAlertSpec) DeepCopyInto(out *NodeAlertSpec) {
 	*out = *in
-	if in.Webhook != nil {
-		in, out := &in.Webhook, &out.Webhook
-		*out = new(WebhookServiceSpec)
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NodeName != nil {
+		in, out := &in.NodeName, &out.NodeName
+		*out = new(string)
+		**out = **in
+	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(AlertTemplateReference)
+		**out = **in
+	}
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.RetryInterval = in.RetryInterval
+	out.NotificationDelay = in.NotificationDelay
+	if in.Grouping != nil {
+		in, out := &in.Grouping, &out.Grouping
+		*out = new(NotificationGrouping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]AlertDependency, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAlertSpec.
+func (in *NodeAlertSpec) DeepCopy() *NodeAlertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAlertSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.LastAttemptTimestamp.DeepCopyInto(&out.LastAttemptTimestamp)
+	if in.NextAttemptTimestamp != nil {
+		in, out := &in.NextAttemptTimestamp, &out.NextAttemptTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.NotifierSecret != nil {
+		in, out := &in.NotifierSecret, &out.NotifierSecret
+		*out = new(NotifierSecretReference)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(NotificationMessage)
 		**out = **in
 	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationGroup) DeepCopyInto(out *NotificationGroup) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.FirstTimestamp.DeepCopyInto(&out.FirstTimestamp)
+	if in.NotifiedTimestamp != nil {
+		in, out := &in.NotifiedTimestamp, &out.NotifiedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.NotifiedStates != nil {
+		in, out := &in.NotifiedStates, &out.NotifiedStates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]GroupedTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationGroup.
+func (in *NotificationGroup) DeepCopy() *NotificationGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationGrouping) DeepCopyInto(out *NotificationGrouping) {
+	*out = *in
+	if in.By != nil {
+		in, out := &in.By, &out.By
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.GroupWait = in.GroupWait
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationGrouping.
+func (in *NotificationGrouping) DeepCopy() *NotificationGrouping {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationGrouping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationMatcher) DeepCopyInto(out *NotificationMatcher) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.AlertKinds != nil {
 		in, out := &in.AlertKinds, &out.AlertKinds
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	in.Arguments.DeepCopyInto(&out.Arguments)
+	if in.AlertNames != nil {
+		in, out := &in.AlertNames, &out.AlertNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.States != nil {
 		in, out := &in.States, &out.States
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchlightPluginSpec.
-func (in *SearchlightPluginSpec) DeepCopy() *SearchlightPluginSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationMatcher.
+func (in *NotificationMatcher) DeepCopy() *NotificationMatcher {
 	if in == nil {
 		return nil
 	}
-	out := new(SearchlightPluginSpec)
+	out := new(NotificationMatcher)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *WebhookServiceSpec) DeepCopyInto(out *WebhookServiceSpec) {
+func (in *NotificationMessage) DeepCopyInto(out *NotificationMessage) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookServiceSpec.
-func (in *WebhookServiceSpec) DeepCopy() *WebhookServiceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationMessage.
+func (in *NotificationMessage) DeepCopy() *NotificationMessage {
 	if in == nil {
 		return nil
 	}
-	out := new(WebhookServiceSpec)
+	out := new(NotificationMessage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicy) DeepCopyInto(out *NotificationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicy.
+func (in *NotificationPolicy) DeepCopy() *NotificationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicyList) DeepCopyInto(out *NotificationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NotificationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicyList.
+func (in *NotificationPolicyList) DeepCopy() *NotificationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NotificationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationPolicySpec) DeepCopyInto(out *NotificationPolicySpec) {
+	*out = *in
+	if in.NotifierSecret != nil {
+		in, out := &in.NotifierSecret, &out.NotifierSecret
+		*out = new(NotifierSecretReference)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]NotificationRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationPolicySpec.
+func (in *NotificationPolicySpec) DeepCopy() *NotificationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationRoute) DeepCopyInto(out *NotificationRoute) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.GroupWait = in.GroupWait
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]NotificationRoute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationRoute.
+func (in *NotificationRoute) DeepCopy() *NotificationRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotifierSecretReference) DeepCopyInto(out *NotifierSecretReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotifierSecretReference.
+func (in *NotifierSecretReference) DeepCopy() *NotifierSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(NotifierSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallLayer) DeepCopyInto(out *OnCallLayer) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Start.DeepCopyInto(&out.Start)
+	out.RotationLength = in.RotationLength
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallLayer.
+func (in *OnCallLayer) DeepCopy() *OnCallLayer {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallLayer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallOverride) DeepCopyInto(out *OnCallOverride) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallOverride.
+func (in *OnCallOverride) DeepCopy() *OnCallOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallSchedule) DeepCopyInto(out *OnCallSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallSchedule.
+func (in *OnCallSchedule) DeepCopy() *OnCallSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleList) DeepCopyInto(out *OnCallScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OnCallSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleList.
+func (in *OnCallScheduleList) DeepCopy() *OnCallScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleSpec) DeepCopyInto(out *OnCallScheduleSpec) {
+	*out = *in
+	if in.Layers != nil {
+		in, out := &in.Layers, &out.Layers
+		*out = make([]OnCallLayer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = make([]OnCallOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleSpec.
+func (in *OnCallScheduleSpec) DeepCopy() *OnCallScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginArguments) DeepCopyInto(out *PluginArguments) {
+	*out = *in
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = new(PluginVars)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginArguments.
+func (in *PluginArguments) DeepCopy() *PluginArguments {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginArguments)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginVarField) DeepCopyInto(out *PluginVarField) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginVarField.
+func (in *PluginVarField) DeepCopy() *PluginVarField {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginVarField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginVars) DeepCopyInto(out *PluginVars) {
+	*out = *in
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]PluginVarField, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginVars.
+func (in *PluginVars) DeepCopy() *PluginVars {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginVars)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodAlert) DeepCopyInto(out *PodAlert) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAlert.
+func (in *PodAlert) DeepCopy() *PodAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(PodAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodAlert) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodAlertList) DeepCopyInto(out *PodAlertList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAlertList.
+func (in *PodAlertList) DeepCopy() *PodAlertList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodAlertList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodAlertList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodAlertSpec) DeepCopyInto(out *PodAlertSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodName != nil {
+		in, out := &in.PodName, &out.PodName
+		*out = new(string)
+		**out = **in
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TemplateRef != nil {
+		in, out := &in.TemplateRef, &out.TemplateRef
+		*out = new(AlertTemplateReference)
+		**out = **in
+	}
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	out.RetryInterval = in.RetryInterval
+	out.NotificationDelay = in.NotificationDelay
+	if in.Grouping != nil {
+		in, out := &in.Grouping, &out.Grouping
+		*out = new(NotificationGrouping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]AlertDependency, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodAlertSpec.
+func (in *PodAlertSpec) DeepCopy() *PodAlertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodAlertSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Receiver) DeepCopyInto(out *Receiver) {
+	*out = *in
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EscalateAfter != nil {
+		in, out := &in.EscalateAfter, &out.EscalateAfter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(LocalSecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Receiver.
+func (in *Receiver) DeepCopy() *Receiver {
+	if in == nil {
+		return nil
+	}
+	out := new(Receiver)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchlightPlugin) DeepCopyInto(out *SearchlightPlugin) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchlightPlugin.
+func (in *SearchlightPlugin) DeepCopy() *SearchlightPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchlightPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SearchlightPlugin) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchlightPluginList) DeepCopyInto(out *SearchlightPluginList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SearchlightPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchlightPluginList.
+func (in *SearchlightPluginList) DeepCopy() *SearchlightPluginList {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchlightPluginList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SearchlightPluginList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SearchlightPluginSpec) DeepCopyInto(out *SearchlightPluginSpec) {
+	*out = *in
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(WebhookServiceSpec)
+		**out = **in
+	}
+	if in.AlertKinds != nil {
+		in, out := &in.AlertKinds, &out.AlertKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Arguments.DeepCopyInto(&out.Arguments)
+	if in.States != nil {
+		in, out := &in.States, &out.States
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SearchlightPluginSpec.
+func (in *SearchlightPluginSpec) DeepCopy() *SearchlightPluginSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SearchlightPluginSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAlert) DeepCopyInto(out *ServiceAlert) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAlert.
+func (in *ServiceAlert) DeepCopy() *ServiceAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAlert) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAlertList) DeepCopyInto(out *ServiceAlertList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAlertList.
+func (in *ServiceAlertList) DeepCopy() *ServiceAlertList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAlertList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAlertList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAlertSpec) DeepCopyInto(out *ServiceAlertSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Grouping != nil {
+		in, out := &in.Grouping, &out.Grouping
+		*out = new(NotificationGrouping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAlertSpec.
+func (in *ServiceAlertSpec) DeepCopy() *ServiceAlertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAlertSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Silence) DeepCopyInto(out *Silence) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Silence.
+func (in *Silence) DeepCopy() *Silence {
+	if in == nil {
+		return nil
+	}
+	out := new(Silence)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Silence) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceList) DeepCopyInto(out *SilenceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Silence, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceList.
+func (in *SilenceList) DeepCopy() *SilenceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SilenceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SilenceSpec) DeepCopyInto(out *SilenceSpec) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.StartsAt != nil {
+		in, out := &in.StartsAt, &out.StartsAt
+		*out = (*in).DeepCopy()
+	}
+	in.EndsAt.DeepCopyInto(&out.EndsAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SilenceSpec.
+func (in *SilenceSpec) DeepCopy() *SilenceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SilenceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
+	*out = *in
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetStatus.
+func (in *TargetStatus) DeepCopy() *TargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WaitingRoute) DeepCopyInto(out *WaitingRoute) {
+	*out = *in
+	in.NotifyTimestamp.DeepCopyInto(&out.NotifyTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitingRoute.
+func (in *WaitingRoute) DeepCopy() *WaitingRoute {
+	if in == nil {
+		return nil
+	}
+	out := new(WaitingRoute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookServiceSpec) DeepCopyInto(out *WebhookServiceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookServiceSpec.
+func (in *WebhookServiceSpec) DeepCopy() *WebhookServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadAlert) DeepCopyInto(out *WorkloadAlert) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadAlert.
+func (in *WorkloadAlert) DeepCopy() *WorkloadAlert {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadAlert)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadAlert) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadAlertList) DeepCopyInto(out *WorkloadAlertList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkloadAlert, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadAlertList.
+func (in *WorkloadAlertList) DeepCopy() *WorkloadAlertList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadAlertList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkloadAlertList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadAlertSpec) DeepCopyInto(out *WorkloadAlertSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadName != nil {
+		in, out := &in.WorkloadName, &out.WorkloadName
+		*out = new(string)
+		**out = **in
+	}
+	out.CheckInterval = in.CheckInterval
+	out.AlertInterval = in.AlertInterval
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]Receiver, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Grouping != nil {
+		in, out := &in.Grouping, &out.Grouping
+		*out = new(NotificationGrouping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vars != nil {
+		in, out := &in.Vars, &out.Vars
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadAlertSpec.
+func (in *WorkloadAlertSpec) DeepCopy() *WorkloadAlertSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadAlertSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -0,0 +1,105 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ResourceKindClusterAlert     = "ClusterAlert"
+	ResourcePluralClusterAlert   = "clusteralerts"
+	ResourceSingularClusterAlert = "clusteralert"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterAlert struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the ClusterAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	Spec ClusterAlertSpec `json:"spec,omitempty"`
+
+	// Most recently observed status of the ClusterAlert.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#spec-and-status
+	// +optional
+	Status AlertStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAlertList is a collection of ClusterAlert.
+type ClusterAlertList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// More info: http://releases.k8s.io/release-1.2/docs/devel/api-conventions.md#metadata
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ClusterAlert.
+	Items []ClusterAlert `json:"items"`
+}
+
+// ClusterAlertSpec describes the ClusterAlert the user wishes to create.
+type ClusterAlertSpec struct {
+	// Name of AlertTemplate in the namespace of this alert that holds defaults for this alert
+	// +optional
+	TemplateRef *AlertTemplateReference `json:"templateRef,omitempty"`
+
+	// Icinga CheckCommand name. Required, unless it is set in AlertTemplate.
+	// +optional
+	Check string `json:"check,omitempty"`
+
+	// How frequently Icinga Service will be checked, such as 30s, 5m or 1h
+	// +optional
+	CheckInterval metav1.Duration `json:"checkInterval,omitempty"`
+
+	// How frequently notifications will be send, such as 30s, 5m or 1h
+	// +optional
+	AlertInterval metav1.Duration `json:"alertInterval,omitempty"`
+
+	// Number of times Icinga Service is checked in a soft problem state before it changes to a hard
+	// state and notifications are sent
+	// +optional
+	MaxCheckAttempts int32 `json:"maxCheckAttempts,omitempty"`
+
+	// How frequently Icinga Service will be checked while it is in a soft problem state, such as 30s or 1m
+	// +optional
+	RetryInterval metav1.Duration `json:"retryInterval,omitempty"`
+
+	// Indicates that Icinga detects flapping of the service state
+	// +optional
+	EnableFlapping bool `json:"enableFlapping,omitempty"`
+
+	// Flapping ends when the percentage of state changes falls below this threshold
+	// +optional
+	FlappingThresholdLow int32 `json:"flappingThresholdLow,omitempty"`
+
+	// Flapping starts when the percentage of state changes rises above this threshold
+	// +optional
+	FlappingThresholdHigh int32 `json:"flappingThresholdHigh,omitempty"`
+
+	// How long to wait before the first notification of a problem is sent, such as 5m
+	// +optional
+	NotificationDelay metav1.Duration `json:"notificationDelay,omitempty"`
+
+	// Secret containing notifier credentials
+	// +optional
+	NotifierSecretName string `json:"notifierSecretName,omitempty"`
+
+	// Receivers contains information to send notifications for Incident
+	// +optional
+	Receivers []Receiver `json:"receivers,omitempty"`
+
+	// Vars contains Icinga Service variables to be used in CheckCommand
+	// +optional
+	Vars map[string]string `json:"vars,omitempty"`
+
+	// Indicates that Check is paused
+	// Icinga Services are removed
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
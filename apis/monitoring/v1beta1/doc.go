@@ -0,0 +1,7 @@
+// +k8s:deepcopy-gen=package
+// +k8s:conversion-gen=github.com/appscode/searchlight/apis/monitoring
+// +k8s:openapi-gen=true
+
+// Package v1beta1 is the v1beta1 version of the API.
+// +groupName=monitoring.appscode.com
+package v1beta1
@@ -0,0 +1,108 @@
+package v1beta1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AlertStatus is the most recently observed status of a ClusterAlert, NodeAlert or PodAlert.
+type AlertStatus struct {
+	// ObservedGeneration is the most recent generation observed for this alert. It corresponds to the
+	// alert's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Conditions represent the latest available observations of the alert's current state.
+	// +optional
+	Conditions []AlertCondition `json:"conditions,omitempty"`
+
+	// Number of targets (pods, nodes or cluster) this alert is applied to
+	// +optional
+	MatchedTargets int32 `json:"matchedTargets,omitempty"`
+
+	// Current Icinga state of each target
+	// +optional
+	Targets []TargetStatus `json:"targets,omitempty"`
+
+	// Groups of targets whose notifications are combined into digests
+	// +optional
+	NotificationGroups []NotificationGroup `json:"notificationGroups,omitempty"`
+}
+
+type AlertConditionType string
+
+// These are the possible conditions for an alert.
+const (
+	// Icinga objects for this alert are in sync with the spec
+	AlertConditionSynced AlertConditionType = "Synced"
+	// Alert is paused and Icinga services are removed
+	AlertConditionPaused AlertConditionType = "Paused"
+	// Check command or its variables are not valid for this alert
+	AlertConditionInvalidCommand AlertConditionType = "InvalidCommand"
+)
+
+type AlertCondition struct {
+	// Type of alert condition.
+	Type AlertConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status core.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+type TargetStatus struct {
+	// Name of the target object. Empty for ClusterAlert.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Current state of Icinga service, such as OK, Warning, Critical, Unknown
+	// +optional
+	State string `json:"state,omitempty"`
+	// Output of the check that last reported a change of state
+	// +optional
+	LastCheckOutput string `json:"lastCheckOutput,omitempty"`
+	// The time at which the check that last reported a change of state was executed
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+}
+
+// NotificationGroup is the state of notifications of a group of targets. It is kept between notifications,
+// until all targets of the group recover.
+type NotificationGroup struct {
+	// Values of grouping labels of targets in this group
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// The time at which the first problem of a target in this group was reported
+	FirstTimestamp metav1.Time `json:"firstTimestamp"`
+	// The time at which the last digest of this group was sent. Not set, while waiting for groupWait.
+	// +optional
+	NotifiedTimestamp *metav1.Time `json:"notifiedTimestamp,omitempty"`
+	// States of targets in the digests sent for this group. Receivers of these states are notified,
+	// when all targets of the group recover.
+	// +optional
+	NotifiedStates []string `json:"notifiedStates,omitempty"`
+	// Targets of this group that have a problem, or recovered since the last digest
+	Targets []GroupedTarget `json:"targets"`
+}
+
+// GroupedTarget is a target of NotificationGroup.
+type GroupedTarget struct {
+	// Name of the target object
+	Name string `json:"name"`
+	// State of Icinga service, such as OK, Warning, Critical, Unknown
+	State string `json:"state"`
+	// Output of the check that was last reported
+	// +optional
+	CheckOutput string `json:"checkOutput,omitempty"`
+	// The time at which the state of this target was last reported
+	LastTimestamp metav1.Time `json:"lastTimestamp"`
+	// Indicates that the current state of this target was included in a digest
+	// +optional
+	Notified bool `json:"notified,omitempty"`
+}
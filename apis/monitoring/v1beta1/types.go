@@ -0,0 +1,75 @@
+package v1beta1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// IcingaState is the state of an Icinga service
+type IcingaState string
+
+const (
+	StateOK       IcingaState = "OK"
+	StateWarning  IcingaState = "Warning"
+	StateCritical IcingaState = "Critical"
+	StateUnknown  IcingaState = "Unknown"
+)
+
+type Receiver struct {
+	// For which state notification will be sent
+	State IcingaState `json:"state"`
+
+	// To whom notification will be sent
+	// +optional
+	To []string `json:"to,omitempty"`
+
+	// How this notification will be sent, such as Mailgun, Twilio, Slack
+	Notifier string `json:"notifier"`
+
+	// Name of an OnCallSchedule in the namespace of alert. Contacts on call at the time of
+	// notification are notified in addition to the ones in To.
+	// +optional
+	OnCallSchedule string `json:"onCallSchedule,omitempty"`
+
+	// How long a problem must stay unacknowledged before notifications are sent to this receiver,
+	// such as 15m. Receivers without it are notified immediately.
+	// +optional
+	EscalateAfter *metav1.Duration `json:"escalateAfter,omitempty"`
+
+	// Secret with the credentials of Notifier, in the namespace of alert. If not set, notifierSecretName
+	// of alert is used, or else the default notifier Secret of Searchlight operator.
+	// +optional
+	SecretRef *LocalSecretReference `json:"secretRef,omitempty"`
+}
+
+// LocalSecretReference refers to a Secret in the namespace of an alert.
+type LocalSecretReference struct {
+	// Name of Secret
+	Name string `json:"name"`
+}
+
+// AlertTemplateReference refers to an AlertTemplate in the namespace of an alert.
+type AlertTemplateReference struct {
+	// Name of AlertTemplate
+	Name string `json:"name"`
+}
+
+// AlertDependency is a parent alert. Notifications of an alert are suppressed while
+// the Icinga service of its parent alert is Critical or Unknown.
+type AlertDependency struct {
+	// Kind of parent alert, such as NodeAlert or ClusterAlert
+	Kind string `json:"kind"`
+
+	// Name of parent alert in the namespace of this alert
+	Name string `json:"name"`
+}
+
+// NotificationGrouping combines the notifications of targets of an alert into digests, so that a
+// problem shared by many targets is notified once.
+type NotificationGrouping struct {
+	// Label keys of targets. Targets with the same values of these labels are grouped together.
+	// All targets of alert are grouped together, if not set.
+	// +optional
+	By []string `json:"by,omitempty"`
+
+	// How long to wait for problems of other targets of a group, before its first digest is sent, such as 2m
+	// +optional
+	GroupWait metav1.Duration `json:"groupWait,omitempty"`
+}
@@ -21,6 +21,7 @@ package versioned
 import (
 	incidentsv1alpha1 "github.com/appscode/searchlight/client/clientset/versioned/typed/incidents/v1alpha1"
 	monitoringv1alpha1 "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -30,6 +31,7 @@ type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	IncidentsV1alpha1() incidentsv1alpha1.IncidentsV1alpha1Interface
 	MonitoringV1alpha1() monitoringv1alpha1.MonitoringV1alpha1Interface
+	MonitoringV1beta1() monitoringv1beta1.MonitoringV1beta1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
@@ -38,6 +40,7 @@ type Clientset struct {
 	*discovery.DiscoveryClient
 	incidentsV1alpha1  *incidentsv1alpha1.IncidentsV1alpha1Client
 	monitoringV1alpha1 *monitoringv1alpha1.MonitoringV1alpha1Client
+	monitoringV1beta1  *monitoringv1beta1.MonitoringV1beta1Client
 }
 
 // IncidentsV1alpha1 retrieves the IncidentsV1alpha1Client
@@ -50,6 +53,11 @@ func (c *Clientset) MonitoringV1alpha1() monitoringv1alpha1.MonitoringV1alpha1In
 	return c.monitoringV1alpha1
 }
 
+// MonitoringV1beta1 retrieves the MonitoringV1beta1Client
+func (c *Clientset) MonitoringV1beta1() monitoringv1beta1.MonitoringV1beta1Interface {
+	return c.monitoringV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -74,6 +82,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.monitoringV1beta1, err = monitoringv1beta1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfig(&configShallowCopy)
 	if err != nil {
@@ -88,6 +100,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
 	cs.incidentsV1alpha1 = incidentsv1alpha1.NewForConfigOrDie(c)
 	cs.monitoringV1alpha1 = monitoringv1alpha1.NewForConfigOrDie(c)
+	cs.monitoringV1beta1 = monitoringv1beta1.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
 	return &cs
@@ -98,6 +111,7 @@ func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.incidentsV1alpha1 = incidentsv1alpha1.New(c)
 	cs.monitoringV1alpha1 = monitoringv1alpha1.New(c)
+	cs.monitoringV1beta1 = monitoringv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
@@ -24,6 +24,8 @@ import (
 	fakeincidentsv1alpha1 "github.com/appscode/searchlight/client/clientset/versioned/typed/incidents/v1alpha1/fake"
 	monitoringv1alpha1 "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1"
 	fakemonitoringv1alpha1 "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/fake"
+	monitoringv1beta1 "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1beta1"
+	fakemonitoringv1beta1 "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -82,3 +84,8 @@ func (c *Clientset) IncidentsV1alpha1() incidentsv1alpha1.IncidentsV1alpha1Inter
 func (c *Clientset) MonitoringV1alpha1() monitoringv1alpha1.MonitoringV1alpha1Interface {
 	return &fakemonitoringv1alpha1.FakeMonitoringV1alpha1{Fake: &c.Fake}
 }
+
+// MonitoringV1beta1 retrieves the MonitoringV1beta1Client
+func (c *Clientset) MonitoringV1beta1() monitoringv1beta1.MonitoringV1beta1Interface {
+	return &fakemonitoringv1beta1.FakeMonitoringV1beta1{Fake: &c.Fake}
+}
@@ -21,6 +21,7 @@ package scheme
 import (
 	incidentsv1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
 	monitoringv1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	monitoringv1beta1 "github.com/appscode/searchlight/apis/monitoring/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -34,6 +35,7 @@ var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
 	incidentsv1alpha1.AddToScheme,
 	monitoringv1alpha1.AddToScheme,
+	monitoringv1beta1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
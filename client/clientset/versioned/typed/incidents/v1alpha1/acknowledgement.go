@@ -19,8 +19,12 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	watch "k8s.io/apimachinery/pkg/watch"
 	rest "k8s.io/client-go/rest"
 )
 
@@ -34,6 +38,9 @@ type AcknowledgementsGetter interface {
 type AcknowledgementInterface interface {
 	Create(*v1alpha1.Acknowledgement) (*v1alpha1.Acknowledgement, error)
 	Delete(name string, options *v1.DeleteOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Acknowledgement, error)
+	List(opts v1.ListOptions) (*v1alpha1.AcknowledgementList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
 	AcknowledgementExpansion
 }
 
@@ -51,6 +58,51 @@ func newAcknowledgements(c *IncidentsV1alpha1Client, namespace string) *acknowle
 	}
 }
 
+// Get takes name of the acknowledgement, and returns the corresponding acknowledgement object, and an error if there is any.
+func (c *acknowledgements) Get(name string, options v1.GetOptions) (result *v1alpha1.Acknowledgement, err error) {
+	result = &v1alpha1.Acknowledgement{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("acknowledgements").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Acknowledgements that match those selectors.
+func (c *acknowledgements) List(opts v1.ListOptions) (result *v1alpha1.AcknowledgementList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.AcknowledgementList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("acknowledgements").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested acknowledgements.
+func (c *acknowledgements) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("acknowledgements").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
 // Create takes the representation of a acknowledgement and creates it.  Returns the server's representation of the acknowledgement, and an error, if there is any.
 func (c *acknowledgements) Create(acknowledgement *v1alpha1.Acknowledgement) (result *v1alpha1.Acknowledgement, err error) {
 	result = &v1alpha1.Acknowledgement{}
@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	rest "k8s.io/client-go/rest"
+)
+
+// AssignmentsGetter has a method to return a AssignmentInterface.
+// A group's client should implement this interface.
+type AssignmentsGetter interface {
+	Assignments(namespace string) AssignmentInterface
+}
+
+// AssignmentInterface has methods to work with Assignment resources.
+type AssignmentInterface interface {
+	Create(*v1alpha1.Assignment) (*v1alpha1.Assignment, error)
+	AssignmentExpansion
+}
+
+// assignments implements AssignmentInterface
+type assignments struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAssignments returns a Assignments
+func newAssignments(c *IncidentsV1alpha1Client, namespace string) *assignments {
+	return &assignments{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Create takes the representation of a assignment and creates it.  Returns the server's representation of the assignment, and an error, if there is any.
+func (c *assignments) Create(assignment *v1alpha1.Assignment) (result *v1alpha1.Assignment, err error) {
+	result = &v1alpha1.Assignment{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("assignments").
+		Body(assignment).
+		Do().
+		Into(result)
+	return
+}
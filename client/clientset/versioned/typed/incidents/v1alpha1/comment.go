@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	rest "k8s.io/client-go/rest"
+)
+
+// CommentsGetter has a method to return a CommentInterface.
+// A group's client should implement this interface.
+type CommentsGetter interface {
+	Comments(namespace string) CommentInterface
+}
+
+// CommentInterface has methods to work with Comment resources.
+type CommentInterface interface {
+	Create(*v1alpha1.Comment) (*v1alpha1.Comment, error)
+	CommentExpansion
+}
+
+// comments implements CommentInterface
+type comments struct {
+	client rest.Interface
+	ns     string
+}
+
+// newComments returns a Comments
+func newComments(c *IncidentsV1alpha1Client, namespace string) *comments {
+	return &comments{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Create takes the representation of a comment and creates it.  Returns the server's representation of the comment, and an error, if there is any.
+func (c *comments) Create(comment *v1alpha1.Comment) (result *v1alpha1.Comment, err error) {
+	result = &v1alpha1.Comment{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("comments").
+		Body(comment).
+		Do().
+		Into(result)
+	return
+}
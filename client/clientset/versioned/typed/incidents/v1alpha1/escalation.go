@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	rest "k8s.io/client-go/rest"
+)
+
+// EscalationsGetter has a method to return a EscalationInterface.
+// A group's client should implement this interface.
+type EscalationsGetter interface {
+	Escalations(namespace string) EscalationInterface
+}
+
+// EscalationInterface has methods to work with Escalation resources.
+type EscalationInterface interface {
+	Create(*v1alpha1.Escalation) (*v1alpha1.Escalation, error)
+	EscalationExpansion
+}
+
+// escalations implements EscalationInterface
+type escalations struct {
+	client rest.Interface
+	ns     string
+}
+
+// newEscalations returns a Escalations
+func newEscalations(c *IncidentsV1alpha1Client, namespace string) *escalations {
+	return &escalations{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Create takes the representation of a escalation and creates it.  Returns the server's representation of the escalation, and an error, if there is any.
+func (c *escalations) Create(escalation *v1alpha1.Escalation) (result *v1alpha1.Escalation, err error) {
+	result = &v1alpha1.Escalation{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("escalations").
+		Body(escalation).
+		Do().
+		Into(result)
+	return
+}
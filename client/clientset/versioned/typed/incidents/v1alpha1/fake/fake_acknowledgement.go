@@ -21,7 +21,9 @@ package fake
 import (
 	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	watch "k8s.io/apimachinery/pkg/watch"
 	testing "k8s.io/client-go/testing"
 )
 
@@ -35,6 +37,46 @@ var acknowledgementsResource = schema.GroupVersionResource{Group: "incidents.mon
 
 var acknowledgementsKind = schema.GroupVersionKind{Group: "incidents.monitoring.appscode.com", Version: "v1alpha1", Kind: "Acknowledgement"}
 
+// Get takes name of the acknowledgement, and returns the corresponding acknowledgement object, and an error if there is any.
+func (c *FakeAcknowledgements) Get(name string, options v1.GetOptions) (result *v1alpha1.Acknowledgement, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(acknowledgementsResource, c.ns, name), &v1alpha1.Acknowledgement{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Acknowledgement), err
+}
+
+// List takes label and field selectors, and returns the list of Acknowledgements that match those selectors.
+func (c *FakeAcknowledgements) List(opts v1.ListOptions) (result *v1alpha1.AcknowledgementList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(acknowledgementsResource, acknowledgementsKind, c.ns, opts), &v1alpha1.AcknowledgementList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.AcknowledgementList{ListMeta: obj.(*v1alpha1.AcknowledgementList).ListMeta}
+	for _, item := range obj.(*v1alpha1.AcknowledgementList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested acknowledgements.
+func (c *FakeAcknowledgements) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(acknowledgementsResource, c.ns, opts))
+
+}
+
 // Create takes the representation of a acknowledgement and creates it.  Returns the server's representation of the acknowledgement, and an error, if there is any.
 func (c *FakeAcknowledgements) Create(acknowledgement *v1alpha1.Acknowledgement) (result *v1alpha1.Acknowledgement, err error) {
 	obj, err := c.Fake.
@@ -32,6 +32,30 @@ func (c *FakeIncidentsV1alpha1) Acknowledgements(namespace string) v1alpha1.Ackn
 	return &FakeAcknowledgements{c, namespace}
 }
 
+func (c *FakeIncidentsV1alpha1) Assignments(namespace string) v1alpha1.AssignmentInterface {
+	return &FakeAssignments{c, namespace}
+}
+
+func (c *FakeIncidentsV1alpha1) Comments(namespace string) v1alpha1.CommentInterface {
+	return &FakeComments{c, namespace}
+}
+
+func (c *FakeIncidentsV1alpha1) Escalations(namespace string) v1alpha1.EscalationInterface {
+	return &FakeEscalations{c, namespace}
+}
+
+func (c *FakeIncidentsV1alpha1) Notifications(namespace string) v1alpha1.NotificationInterface {
+	return &FakeNotifications{c, namespace}
+}
+
+func (c *FakeIncidentsV1alpha1) Reports(namespace string) v1alpha1.ReportInterface {
+	return &FakeReports{c, namespace}
+}
+
+func (c *FakeIncidentsV1alpha1) Resolutions(namespace string) v1alpha1.ResolutionInterface {
+	return &FakeResolutions{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeIncidentsV1alpha1) RESTClient() rest.Interface {
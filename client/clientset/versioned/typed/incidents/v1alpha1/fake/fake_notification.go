@@ -0,0 +1,46 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNotifications implements NotificationInterface
+type FakeNotifications struct {
+	Fake *FakeIncidentsV1alpha1
+	ns   string
+}
+
+var notificationsResource = schema.GroupVersionResource{Group: "incidents.monitoring.appscode.com", Version: "v1alpha1", Resource: "notifications"}
+
+var notificationsKind = schema.GroupVersionKind{Group: "incidents.monitoring.appscode.com", Version: "v1alpha1", Kind: "Notification"}
+
+// Create takes the representation of a notification and creates it.  Returns the server's representation of the notification, and an error, if there is any.
+func (c *FakeNotifications) Create(notification *v1alpha1.Notification) (result *v1alpha1.Notification, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(notificationsResource, c.ns, notification), &v1alpha1.Notification{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Notification), err
+}
@@ -19,3 +19,15 @@ limitations under the License.
 package v1alpha1
 
 type AcknowledgementExpansion interface{}
+
+type AssignmentExpansion interface{}
+
+type CommentExpansion interface{}
+
+type EscalationExpansion interface{}
+
+type NotificationExpansion interface{}
+
+type ReportExpansion interface{}
+
+type ResolutionExpansion interface{}
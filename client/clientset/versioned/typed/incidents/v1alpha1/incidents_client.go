@@ -28,6 +28,12 @@ import (
 type IncidentsV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AcknowledgementsGetter
+	AssignmentsGetter
+	CommentsGetter
+	EscalationsGetter
+	NotificationsGetter
+	ReportsGetter
+	ResolutionsGetter
 }
 
 // IncidentsV1alpha1Client is used to interact with features provided by the incidents.monitoring.appscode.com group.
@@ -39,6 +45,30 @@ func (c *IncidentsV1alpha1Client) Acknowledgements(namespace string) Acknowledge
 	return newAcknowledgements(c, namespace)
 }
 
+func (c *IncidentsV1alpha1Client) Assignments(namespace string) AssignmentInterface {
+	return newAssignments(c, namespace)
+}
+
+func (c *IncidentsV1alpha1Client) Comments(namespace string) CommentInterface {
+	return newComments(c, namespace)
+}
+
+func (c *IncidentsV1alpha1Client) Escalations(namespace string) EscalationInterface {
+	return newEscalations(c, namespace)
+}
+
+func (c *IncidentsV1alpha1Client) Notifications(namespace string) NotificationInterface {
+	return newNotifications(c, namespace)
+}
+
+func (c *IncidentsV1alpha1Client) Reports(namespace string) ReportInterface {
+	return newReports(c, namespace)
+}
+
+func (c *IncidentsV1alpha1Client) Resolutions(namespace string) ResolutionInterface {
+	return newResolutions(c, namespace)
+}
+
 // NewForConfig creates a new IncidentsV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*IncidentsV1alpha1Client, error) {
 	config := *c
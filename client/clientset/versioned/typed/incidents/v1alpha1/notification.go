@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	rest "k8s.io/client-go/rest"
+)
+
+// NotificationsGetter has a method to return a NotificationInterface.
+// A group's client should implement this interface.
+type NotificationsGetter interface {
+	Notifications(namespace string) NotificationInterface
+}
+
+// NotificationInterface has methods to work with Notification resources.
+type NotificationInterface interface {
+	Create(*v1alpha1.Notification) (*v1alpha1.Notification, error)
+	NotificationExpansion
+}
+
+// notifications implements NotificationInterface
+type notifications struct {
+	client rest.Interface
+	ns     string
+}
+
+// newNotifications returns a Notifications
+func newNotifications(c *IncidentsV1alpha1Client, namespace string) *notifications {
+	return &notifications{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Create takes the representation of a notification and creates it.  Returns the server's representation of the notification, and an error, if there is any.
+func (c *notifications) Create(notification *v1alpha1.Notification) (result *v1alpha1.Notification, err error) {
+	result = &v1alpha1.Notification{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("notifications").
+		Body(notification).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,62 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	rest "k8s.io/client-go/rest"
+)
+
+// ResolutionsGetter has a method to return a ResolutionInterface.
+// A group's client should implement this interface.
+type ResolutionsGetter interface {
+	Resolutions(namespace string) ResolutionInterface
+}
+
+// ResolutionInterface has methods to work with Resolution resources.
+type ResolutionInterface interface {
+	Create(*v1alpha1.Resolution) (*v1alpha1.Resolution, error)
+	ResolutionExpansion
+}
+
+// resolutions implements ResolutionInterface
+type resolutions struct {
+	client rest.Interface
+	ns     string
+}
+
+// newResolutions returns a Resolutions
+func newResolutions(c *IncidentsV1alpha1Client, namespace string) *resolutions {
+	return &resolutions{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Create takes the representation of a resolution and creates it.  Returns the server's representation of the resolution, and an error, if there is any.
+func (c *resolutions) Create(resolution *v1alpha1.Resolution) (result *v1alpha1.Resolution, err error) {
+	result = &v1alpha1.Resolution{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("resolutions").
+		Body(resolution).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AlertTemplatesGetter has a method to return a AlertTemplateInterface.
+// A group's client should implement this interface.
+type AlertTemplatesGetter interface {
+	AlertTemplates(namespace string) AlertTemplateInterface
+}
+
+// AlertTemplateInterface has methods to work with AlertTemplate resources.
+type AlertTemplateInterface interface {
+	Create(*v1alpha1.AlertTemplate) (*v1alpha1.AlertTemplate, error)
+	Update(*v1alpha1.AlertTemplate) (*v1alpha1.AlertTemplate, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.AlertTemplate, error)
+	List(opts v1.ListOptions) (*v1alpha1.AlertTemplateList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.AlertTemplate, err error)
+	AlertTemplateExpansion
+}
+
+// alertTemplates implements AlertTemplateInterface
+type alertTemplates struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAlertTemplates returns a AlertTemplates
+func newAlertTemplates(c *MonitoringV1alpha1Client, namespace string) *alertTemplates {
+	return &alertTemplates{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the alertTemplate, and returns the corresponding alertTemplate object, and an error if there is any.
+func (c *alertTemplates) Get(name string, options v1.GetOptions) (result *v1alpha1.AlertTemplate, err error) {
+	result = &v1alpha1.AlertTemplate{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AlertTemplates that match those selectors.
+func (c *alertTemplates) List(opts v1.ListOptions) (result *v1alpha1.AlertTemplateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.AlertTemplateList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested alertTemplates.
+func (c *alertTemplates) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a alertTemplate and creates it.  Returns the server's representation of the alertTemplate, and an error, if there is any.
+func (c *alertTemplates) Create(alertTemplate *v1alpha1.AlertTemplate) (result *v1alpha1.AlertTemplate, err error) {
+	result = &v1alpha1.AlertTemplate{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		Body(alertTemplate).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a alertTemplate and updates it. Returns the server's representation of the alertTemplate, and an error, if there is any.
+func (c *alertTemplates) Update(alertTemplate *v1alpha1.AlertTemplate) (result *v1alpha1.AlertTemplate, err error) {
+	result = &v1alpha1.AlertTemplate{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		Name(alertTemplate.Name).
+		Body(alertTemplate).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the alertTemplate and deletes it. Returns an error if one occurs.
+func (c *alertTemplates) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *alertTemplates) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched alertTemplate.
+func (c *alertTemplates) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.AlertTemplate, err error) {
+	result = &v1alpha1.AlertTemplate{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("alerttemplates").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
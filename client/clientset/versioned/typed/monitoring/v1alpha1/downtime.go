@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DowntimesGetter has a method to return a DowntimeInterface.
+// A group's client should implement this interface.
+type DowntimesGetter interface {
+	Downtimes(namespace string) DowntimeInterface
+}
+
+// DowntimeInterface has methods to work with Downtime resources.
+type DowntimeInterface interface {
+	Create(*v1alpha1.Downtime) (*v1alpha1.Downtime, error)
+	Update(*v1alpha1.Downtime) (*v1alpha1.Downtime, error)
+	UpdateStatus(*v1alpha1.Downtime) (*v1alpha1.Downtime, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Downtime, error)
+	List(opts v1.ListOptions) (*v1alpha1.DowntimeList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Downtime, err error)
+	DowntimeExpansion
+}
+
+// downtimes implements DowntimeInterface
+type downtimes struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDowntimes returns a Downtimes
+func newDowntimes(c *MonitoringV1alpha1Client, namespace string) *downtimes {
+	return &downtimes{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the downtime, and returns the corresponding downtime object, and an error if there is any.
+func (c *downtimes) Get(name string, options v1.GetOptions) (result *v1alpha1.Downtime, err error) {
+	result = &v1alpha1.Downtime{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("downtimes").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Downtimes that match those selectors.
+func (c *downtimes) List(opts v1.ListOptions) (result *v1alpha1.DowntimeList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.DowntimeList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("downtimes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested downtimes.
+func (c *downtimes) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("downtimes").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a downtime and creates it.  Returns the server's representation of the downtime, and an error, if there is any.
+func (c *downtimes) Create(downtime *v1alpha1.Downtime) (result *v1alpha1.Downtime, err error) {
+	result = &v1alpha1.Downtime{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("downtimes").
+		Body(downtime).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a downtime and updates it. Returns the server's representation of the downtime, and an error, if there is any.
+func (c *downtimes) Update(downtime *v1alpha1.Downtime) (result *v1alpha1.Downtime, err error) {
+	result = &v1alpha1.Downtime{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("downtimes").
+		Name(downtime.Name).
+		Body(downtime).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *downtimes) UpdateStatus(downtime *v1alpha1.Downtime) (result *v1alpha1.Downtime, err error) {
+	result = &v1alpha1.Downtime{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("downtimes").
+		Name(downtime.Name).
+		SubResource("status").
+		Body(downtime).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the downtime and deletes it. Returns an error if one occurs.
+func (c *downtimes) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("downtimes").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *downtimes) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("downtimes").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched downtime.
+func (c *downtimes) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Downtime, err error) {
+	result = &v1alpha1.Downtime{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("downtimes").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAlertTemplates implements AlertTemplateInterface
+type FakeAlertTemplates struct {
+	Fake *FakeMonitoringV1alpha1
+	ns   string
+}
+
+var alerttemplatesResource = schema.GroupVersionResource{Group: "monitoring.appscode.com", Version: "v1alpha1", Resource: "alerttemplates"}
+
+var alerttemplatesKind = schema.GroupVersionKind{Group: "monitoring.appscode.com", Version: "v1alpha1", Kind: "AlertTemplate"}
+
+// Get takes name of the alertTemplate, and returns the corresponding alertTemplate object, and an error if there is any.
+func (c *FakeAlertTemplates) Get(name string, options v1.GetOptions) (result *v1alpha1.AlertTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(alerttemplatesResource, c.ns, name), &v1alpha1.AlertTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AlertTemplate), err
+}
+
+// List takes label and field selectors, and returns the list of AlertTemplates that match those selectors.
+func (c *FakeAlertTemplates) List(opts v1.ListOptions) (result *v1alpha1.AlertTemplateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(alerttemplatesResource, alerttemplatesKind, c.ns, opts), &v1alpha1.AlertTemplateList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.AlertTemplateList{ListMeta: obj.(*v1alpha1.AlertTemplateList).ListMeta}
+	for _, item := range obj.(*v1alpha1.AlertTemplateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested alertTemplates.
+func (c *FakeAlertTemplates) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(alerttemplatesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a alertTemplate and creates it.  Returns the server's representation of the alertTemplate, and an error, if there is any.
+func (c *FakeAlertTemplates) Create(alertTemplate *v1alpha1.AlertTemplate) (result *v1alpha1.AlertTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(alerttemplatesResource, c.ns, alertTemplate), &v1alpha1.AlertTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AlertTemplate), err
+}
+
+// Update takes the representation of a alertTemplate and updates it. Returns the server's representation of the alertTemplate, and an error, if there is any.
+func (c *FakeAlertTemplates) Update(alertTemplate *v1alpha1.AlertTemplate) (result *v1alpha1.AlertTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(alerttemplatesResource, c.ns, alertTemplate), &v1alpha1.AlertTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AlertTemplate), err
+}
+
+// Delete takes name of the alertTemplate and deletes it. Returns an error if one occurs.
+func (c *FakeAlertTemplates) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(alerttemplatesResource, c.ns, name), &v1alpha1.AlertTemplate{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAlertTemplates) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(alerttemplatesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.AlertTemplateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched alertTemplate.
+func (c *FakeAlertTemplates) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.AlertTemplate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(alerttemplatesResource, c.ns, name, pt, data, subresources...), &v1alpha1.AlertTemplate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AlertTemplate), err
+}
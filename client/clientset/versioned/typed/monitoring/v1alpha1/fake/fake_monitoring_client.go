@@ -28,10 +28,18 @@ type FakeMonitoringV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeMonitoringV1alpha1) AlertTemplates(namespace string) v1alpha1.AlertTemplateInterface {
+	return &FakeAlertTemplates{c, namespace}
+}
+
 func (c *FakeMonitoringV1alpha1) ClusterAlerts(namespace string) v1alpha1.ClusterAlertInterface {
 	return &FakeClusterAlerts{c, namespace}
 }
 
+func (c *FakeMonitoringV1alpha1) Downtimes(namespace string) v1alpha1.DowntimeInterface {
+	return &FakeDowntimes{c, namespace}
+}
+
 func (c *FakeMonitoringV1alpha1) Incidents(namespace string) v1alpha1.IncidentInterface {
 	return &FakeIncidents{c, namespace}
 }
@@ -40,6 +48,14 @@ func (c *FakeMonitoringV1alpha1) NodeAlerts(namespace string) v1alpha1.NodeAlert
 	return &FakeNodeAlerts{c, namespace}
 }
 
+func (c *FakeMonitoringV1alpha1) NotificationPolicies() v1alpha1.NotificationPolicyInterface {
+	return &FakeNotificationPolicies{c}
+}
+
+func (c *FakeMonitoringV1alpha1) OnCallSchedules(namespace string) v1alpha1.OnCallScheduleInterface {
+	return &FakeOnCallSchedules{c, namespace}
+}
+
 func (c *FakeMonitoringV1alpha1) PodAlerts(namespace string) v1alpha1.PodAlertInterface {
 	return &FakePodAlerts{c, namespace}
 }
@@ -48,6 +64,18 @@ func (c *FakeMonitoringV1alpha1) SearchlightPlugins() v1alpha1.SearchlightPlugin
 	return &FakeSearchlightPlugins{c}
 }
 
+func (c *FakeMonitoringV1alpha1) ServiceAlerts(namespace string) v1alpha1.ServiceAlertInterface {
+	return &FakeServiceAlerts{c, namespace}
+}
+
+func (c *FakeMonitoringV1alpha1) Silences(namespace string) v1alpha1.SilenceInterface {
+	return &FakeSilences{c, namespace}
+}
+
+func (c *FakeMonitoringV1alpha1) WorkloadAlerts(namespace string) v1alpha1.WorkloadAlertInterface {
+	return &FakeWorkloadAlerts{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeMonitoringV1alpha1) RESTClient() rest.Interface {
@@ -100,6 +100,18 @@ func (c *FakeNodeAlerts) Update(nodeAlert *v1alpha1.NodeAlert) (result *v1alpha1
 	return obj.(*v1alpha1.NodeAlert), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNodeAlerts) UpdateStatus(nodeAlert *v1alpha1.NodeAlert) (*v1alpha1.NodeAlert, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(nodealertsResource, "status", c.ns, nodeAlert), &v1alpha1.NodeAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeAlert), err
+}
+
 // Delete takes name of the nodeAlert and deletes it. Returns an error if one occurs.
 func (c *FakeNodeAlerts) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
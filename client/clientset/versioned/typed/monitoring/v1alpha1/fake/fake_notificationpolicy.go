@@ -0,0 +1,120 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeNotificationPolicies implements NotificationPolicyInterface
+type FakeNotificationPolicies struct {
+	Fake *FakeMonitoringV1alpha1
+}
+
+var notificationpoliciesResource = schema.GroupVersionResource{Group: "monitoring.appscode.com", Version: "v1alpha1", Resource: "notificationpolicies"}
+
+var notificationpoliciesKind = schema.GroupVersionKind{Group: "monitoring.appscode.com", Version: "v1alpha1", Kind: "NotificationPolicy"}
+
+// Get takes name of the notificationPolicy, and returns the corresponding notificationPolicy object, and an error if there is any.
+func (c *FakeNotificationPolicies) Get(name string, options v1.GetOptions) (result *v1alpha1.NotificationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(notificationpoliciesResource, name), &v1alpha1.NotificationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NotificationPolicy), err
+}
+
+// List takes label and field selectors, and returns the list of NotificationPolicies that match those selectors.
+func (c *FakeNotificationPolicies) List(opts v1.ListOptions) (result *v1alpha1.NotificationPolicyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(notificationpoliciesResource, notificationpoliciesKind, opts), &v1alpha1.NotificationPolicyList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.NotificationPolicyList{ListMeta: obj.(*v1alpha1.NotificationPolicyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.NotificationPolicyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested notificationPolicies.
+func (c *FakeNotificationPolicies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(notificationpoliciesResource, opts))
+}
+
+// Create takes the representation of a notificationPolicy and creates it.  Returns the server's representation of the notificationPolicy, and an error, if there is any.
+func (c *FakeNotificationPolicies) Create(notificationPolicy *v1alpha1.NotificationPolicy) (result *v1alpha1.NotificationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(notificationpoliciesResource, notificationPolicy), &v1alpha1.NotificationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NotificationPolicy), err
+}
+
+// Update takes the representation of a notificationPolicy and updates it. Returns the server's representation of the notificationPolicy, and an error, if there is any.
+func (c *FakeNotificationPolicies) Update(notificationPolicy *v1alpha1.NotificationPolicy) (result *v1alpha1.NotificationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(notificationpoliciesResource, notificationPolicy), &v1alpha1.NotificationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NotificationPolicy), err
+}
+
+// Delete takes name of the notificationPolicy and deletes it. Returns an error if one occurs.
+func (c *FakeNotificationPolicies) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(notificationpoliciesResource, name), &v1alpha1.NotificationPolicy{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNotificationPolicies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(notificationpoliciesResource, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.NotificationPolicyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched notificationPolicy.
+func (c *FakeNotificationPolicies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.NotificationPolicy, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(notificationpoliciesResource, name, pt, data, subresources...), &v1alpha1.NotificationPolicy{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NotificationPolicy), err
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeOnCallSchedules implements OnCallScheduleInterface
+type FakeOnCallSchedules struct {
+	Fake *FakeMonitoringV1alpha1
+	ns   string
+}
+
+var oncallschedulesResource = schema.GroupVersionResource{Group: "monitoring.appscode.com", Version: "v1alpha1", Resource: "oncallschedules"}
+
+var oncallschedulesKind = schema.GroupVersionKind{Group: "monitoring.appscode.com", Version: "v1alpha1", Kind: "OnCallSchedule"}
+
+// Get takes name of the onCallSchedule, and returns the corresponding onCallSchedule object, and an error if there is any.
+func (c *FakeOnCallSchedules) Get(name string, options v1.GetOptions) (result *v1alpha1.OnCallSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(oncallschedulesResource, c.ns, name), &v1alpha1.OnCallSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OnCallSchedule), err
+}
+
+// List takes label and field selectors, and returns the list of OnCallSchedules that match those selectors.
+func (c *FakeOnCallSchedules) List(opts v1.ListOptions) (result *v1alpha1.OnCallScheduleList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(oncallschedulesResource, oncallschedulesKind, c.ns, opts), &v1alpha1.OnCallScheduleList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.OnCallScheduleList{ListMeta: obj.(*v1alpha1.OnCallScheduleList).ListMeta}
+	for _, item := range obj.(*v1alpha1.OnCallScheduleList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested onCallSchedules.
+func (c *FakeOnCallSchedules) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(oncallschedulesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a onCallSchedule and creates it.  Returns the server's representation of the onCallSchedule, and an error, if there is any.
+func (c *FakeOnCallSchedules) Create(onCallSchedule *v1alpha1.OnCallSchedule) (result *v1alpha1.OnCallSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(oncallschedulesResource, c.ns, onCallSchedule), &v1alpha1.OnCallSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OnCallSchedule), err
+}
+
+// Update takes the representation of a onCallSchedule and updates it. Returns the server's representation of the onCallSchedule, and an error, if there is any.
+func (c *FakeOnCallSchedules) Update(onCallSchedule *v1alpha1.OnCallSchedule) (result *v1alpha1.OnCallSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(oncallschedulesResource, c.ns, onCallSchedule), &v1alpha1.OnCallSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OnCallSchedule), err
+}
+
+// Delete takes name of the onCallSchedule and deletes it. Returns an error if one occurs.
+func (c *FakeOnCallSchedules) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(oncallschedulesResource, c.ns, name), &v1alpha1.OnCallSchedule{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeOnCallSchedules) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(oncallschedulesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.OnCallScheduleList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched onCallSchedule.
+func (c *FakeOnCallSchedules) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.OnCallSchedule, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(oncallschedulesResource, c.ns, name, pt, data, subresources...), &v1alpha1.OnCallSchedule{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OnCallSchedule), err
+}
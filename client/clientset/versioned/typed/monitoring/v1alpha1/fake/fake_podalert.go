@@ -100,6 +100,18 @@ func (c *FakePodAlerts) Update(podAlert *v1alpha1.PodAlert) (result *v1alpha1.Po
 	return obj.(*v1alpha1.PodAlert), err
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakePodAlerts) UpdateStatus(podAlert *v1alpha1.PodAlert) (*v1alpha1.PodAlert, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(podalertsResource, "status", c.ns, podAlert), &v1alpha1.PodAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.PodAlert), err
+}
+
 // Delete takes name of the podAlert and deletes it. Returns an error if one occurs.
 func (c *FakePodAlerts) Delete(name string, options *v1.DeleteOptions) error {
 	_, err := c.Fake.
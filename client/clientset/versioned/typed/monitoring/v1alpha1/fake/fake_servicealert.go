@@ -0,0 +1,140 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeServiceAlerts implements ServiceAlertInterface
+type FakeServiceAlerts struct {
+	Fake *FakeMonitoringV1alpha1
+	ns   string
+}
+
+var servicealertsResource = schema.GroupVersionResource{Group: "monitoring.appscode.com", Version: "v1alpha1", Resource: "servicealerts"}
+
+var servicealertsKind = schema.GroupVersionKind{Group: "monitoring.appscode.com", Version: "v1alpha1", Kind: "ServiceAlert"}
+
+// Get takes name of the serviceAlert, and returns the corresponding serviceAlert object, and an error if there is any.
+func (c *FakeServiceAlerts) Get(name string, options v1.GetOptions) (result *v1alpha1.ServiceAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(servicealertsResource, c.ns, name), &v1alpha1.ServiceAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAlert), err
+}
+
+// List takes label and field selectors, and returns the list of ServiceAlerts that match those selectors.
+func (c *FakeServiceAlerts) List(opts v1.ListOptions) (result *v1alpha1.ServiceAlertList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(servicealertsResource, servicealertsKind, c.ns, opts), &v1alpha1.ServiceAlertList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ServiceAlertList{ListMeta: obj.(*v1alpha1.ServiceAlertList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ServiceAlertList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested serviceAlerts.
+func (c *FakeServiceAlerts) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(servicealertsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a serviceAlert and creates it.  Returns the server's representation of the serviceAlert, and an error, if there is any.
+func (c *FakeServiceAlerts) Create(serviceAlert *v1alpha1.ServiceAlert) (result *v1alpha1.ServiceAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(servicealertsResource, c.ns, serviceAlert), &v1alpha1.ServiceAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAlert), err
+}
+
+// Update takes the representation of a serviceAlert and updates it. Returns the server's representation of the serviceAlert, and an error, if there is any.
+func (c *FakeServiceAlerts) Update(serviceAlert *v1alpha1.ServiceAlert) (result *v1alpha1.ServiceAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(servicealertsResource, c.ns, serviceAlert), &v1alpha1.ServiceAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAlert), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeServiceAlerts) UpdateStatus(serviceAlert *v1alpha1.ServiceAlert) (*v1alpha1.ServiceAlert, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(servicealertsResource, "status", c.ns, serviceAlert), &v1alpha1.ServiceAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAlert), err
+}
+
+// Delete takes name of the serviceAlert and deletes it. Returns an error if one occurs.
+func (c *FakeServiceAlerts) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(servicealertsResource, c.ns, name), &v1alpha1.ServiceAlert{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeServiceAlerts) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(servicealertsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ServiceAlertList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched serviceAlert.
+func (c *FakeServiceAlerts) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ServiceAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(servicealertsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ServiceAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAlert), err
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSilences implements SilenceInterface
+type FakeSilences struct {
+	Fake *FakeMonitoringV1alpha1
+	ns   string
+}
+
+var silencesResource = schema.GroupVersionResource{Group: "monitoring.appscode.com", Version: "v1alpha1", Resource: "silences"}
+
+var silencesKind = schema.GroupVersionKind{Group: "monitoring.appscode.com", Version: "v1alpha1", Kind: "Silence"}
+
+// Get takes name of the silence, and returns the corresponding silence object, and an error if there is any.
+func (c *FakeSilences) Get(name string, options v1.GetOptions) (result *v1alpha1.Silence, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(silencesResource, c.ns, name), &v1alpha1.Silence{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Silence), err
+}
+
+// List takes label and field selectors, and returns the list of Silences that match those selectors.
+func (c *FakeSilences) List(opts v1.ListOptions) (result *v1alpha1.SilenceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(silencesResource, silencesKind, c.ns, opts), &v1alpha1.SilenceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.SilenceList{ListMeta: obj.(*v1alpha1.SilenceList).ListMeta}
+	for _, item := range obj.(*v1alpha1.SilenceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested silences.
+func (c *FakeSilences) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(silencesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a silence and creates it.  Returns the server's representation of the silence, and an error, if there is any.
+func (c *FakeSilences) Create(silence *v1alpha1.Silence) (result *v1alpha1.Silence, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(silencesResource, c.ns, silence), &v1alpha1.Silence{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Silence), err
+}
+
+// Update takes the representation of a silence and updates it. Returns the server's representation of the silence, and an error, if there is any.
+func (c *FakeSilences) Update(silence *v1alpha1.Silence) (result *v1alpha1.Silence, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(silencesResource, c.ns, silence), &v1alpha1.Silence{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Silence), err
+}
+
+// Delete takes name of the silence and deletes it. Returns an error if one occurs.
+func (c *FakeSilences) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(silencesResource, c.ns, name), &v1alpha1.Silence{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSilences) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(silencesResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.SilenceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched silence.
+func (c *FakeSilences) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Silence, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(silencesResource, c.ns, name, pt, data, subresources...), &v1alpha1.Silence{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Silence), err
+}
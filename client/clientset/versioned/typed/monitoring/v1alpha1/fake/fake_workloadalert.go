@@ -0,0 +1,140 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWorkloadAlerts implements WorkloadAlertInterface
+type FakeWorkloadAlerts struct {
+	Fake *FakeMonitoringV1alpha1
+	ns   string
+}
+
+var workloadalertsResource = schema.GroupVersionResource{Group: "monitoring.appscode.com", Version: "v1alpha1", Resource: "workloadalerts"}
+
+var workloadalertsKind = schema.GroupVersionKind{Group: "monitoring.appscode.com", Version: "v1alpha1", Kind: "WorkloadAlert"}
+
+// Get takes name of the workloadAlert, and returns the corresponding workloadAlert object, and an error if there is any.
+func (c *FakeWorkloadAlerts) Get(name string, options v1.GetOptions) (result *v1alpha1.WorkloadAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(workloadalertsResource, c.ns, name), &v1alpha1.WorkloadAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkloadAlert), err
+}
+
+// List takes label and field selectors, and returns the list of WorkloadAlerts that match those selectors.
+func (c *FakeWorkloadAlerts) List(opts v1.ListOptions) (result *v1alpha1.WorkloadAlertList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(workloadalertsResource, workloadalertsKind, c.ns, opts), &v1alpha1.WorkloadAlertList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.WorkloadAlertList{ListMeta: obj.(*v1alpha1.WorkloadAlertList).ListMeta}
+	for _, item := range obj.(*v1alpha1.WorkloadAlertList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested workloadAlerts.
+func (c *FakeWorkloadAlerts) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(workloadalertsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a workloadAlert and creates it.  Returns the server's representation of the workloadAlert, and an error, if there is any.
+func (c *FakeWorkloadAlerts) Create(workloadAlert *v1alpha1.WorkloadAlert) (result *v1alpha1.WorkloadAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(workloadalertsResource, c.ns, workloadAlert), &v1alpha1.WorkloadAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkloadAlert), err
+}
+
+// Update takes the representation of a workloadAlert and updates it. Returns the server's representation of the workloadAlert, and an error, if there is any.
+func (c *FakeWorkloadAlerts) Update(workloadAlert *v1alpha1.WorkloadAlert) (result *v1alpha1.WorkloadAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(workloadalertsResource, c.ns, workloadAlert), &v1alpha1.WorkloadAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkloadAlert), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeWorkloadAlerts) UpdateStatus(workloadAlert *v1alpha1.WorkloadAlert) (*v1alpha1.WorkloadAlert, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(workloadalertsResource, "status", c.ns, workloadAlert), &v1alpha1.WorkloadAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkloadAlert), err
+}
+
+// Delete takes name of the workloadAlert and deletes it. Returns an error if one occurs.
+func (c *FakeWorkloadAlerts) Delete(name string, options *v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(workloadalertsResource, c.ns, name), &v1alpha1.WorkloadAlert{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWorkloadAlerts) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(workloadalertsResource, c.ns, listOptions)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.WorkloadAlertList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched workloadAlert.
+func (c *FakeWorkloadAlerts) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.WorkloadAlert, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(workloadalertsResource, c.ns, name, pt, data, subresources...), &v1alpha1.WorkloadAlert{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WorkloadAlert), err
+}
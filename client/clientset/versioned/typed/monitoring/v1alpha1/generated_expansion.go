@@ -18,12 +18,26 @@ limitations under the License.
 
 package v1alpha1
 
+type AlertTemplateExpansion interface{}
+
 type ClusterAlertExpansion interface{}
 
+type DowntimeExpansion interface{}
+
 type IncidentExpansion interface{}
 
 type NodeAlertExpansion interface{}
 
+type NotificationPolicyExpansion interface{}
+
+type OnCallScheduleExpansion interface{}
+
 type PodAlertExpansion interface{}
 
 type SearchlightPluginExpansion interface{}
+
+type ServiceAlertExpansion interface{}
+
+type SilenceExpansion interface{}
+
+type WorkloadAlertExpansion interface{}
@@ -27,11 +27,18 @@ import (
 
 type MonitoringV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AlertTemplatesGetter
 	ClusterAlertsGetter
+	DowntimesGetter
 	IncidentsGetter
 	NodeAlertsGetter
+	NotificationPoliciesGetter
+	OnCallSchedulesGetter
 	PodAlertsGetter
 	SearchlightPluginsGetter
+	ServiceAlertsGetter
+	SilencesGetter
+	WorkloadAlertsGetter
 }
 
 // MonitoringV1alpha1Client is used to interact with features provided by the monitoring.appscode.com group.
@@ -39,10 +46,18 @@ type MonitoringV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *MonitoringV1alpha1Client) AlertTemplates(namespace string) AlertTemplateInterface {
+	return newAlertTemplates(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) ClusterAlerts(namespace string) ClusterAlertInterface {
 	return newClusterAlerts(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) Downtimes(namespace string) DowntimeInterface {
+	return newDowntimes(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) Incidents(namespace string) IncidentInterface {
 	return newIncidents(c, namespace)
 }
@@ -51,6 +66,14 @@ func (c *MonitoringV1alpha1Client) NodeAlerts(namespace string) NodeAlertInterfa
 	return newNodeAlerts(c, namespace)
 }
 
+func (c *MonitoringV1alpha1Client) NotificationPolicies() NotificationPolicyInterface {
+	return newNotificationPolicies(c)
+}
+
+func (c *MonitoringV1alpha1Client) OnCallSchedules(namespace string) OnCallScheduleInterface {
+	return newOnCallSchedules(c, namespace)
+}
+
 func (c *MonitoringV1alpha1Client) PodAlerts(namespace string) PodAlertInterface {
 	return newPodAlerts(c, namespace)
 }
@@ -59,6 +82,18 @@ func (c *MonitoringV1alpha1Client) SearchlightPlugins() SearchlightPluginInterfa
 	return newSearchlightPlugins(c)
 }
 
+func (c *MonitoringV1alpha1Client) ServiceAlerts(namespace string) ServiceAlertInterface {
+	return newServiceAlerts(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) Silences(namespace string) SilenceInterface {
+	return newSilences(c, namespace)
+}
+
+func (c *MonitoringV1alpha1Client) WorkloadAlerts(namespace string) WorkloadAlertInterface {
+	return newWorkloadAlerts(c, namespace)
+}
+
 // NewForConfig creates a new MonitoringV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*MonitoringV1alpha1Client, error) {
 	config := *c
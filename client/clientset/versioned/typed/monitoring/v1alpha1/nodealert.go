@@ -39,6 +39,7 @@ type NodeAlertsGetter interface {
 type NodeAlertInterface interface {
 	Create(*v1alpha1.NodeAlert) (*v1alpha1.NodeAlert, error)
 	Update(*v1alpha1.NodeAlert) (*v1alpha1.NodeAlert, error)
+	UpdateStatus(*v1alpha1.NodeAlert) (*v1alpha1.NodeAlert, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.NodeAlert, error)
@@ -132,6 +133,22 @@ func (c *nodeAlerts) Update(nodeAlert *v1alpha1.NodeAlert) (result *v1alpha1.Nod
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *nodeAlerts) UpdateStatus(nodeAlert *v1alpha1.NodeAlert) (result *v1alpha1.NodeAlert, err error) {
+	result = &v1alpha1.NodeAlert{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("nodealerts").
+		Name(nodeAlert.Name).
+		SubResource("status").
+		Body(nodeAlert).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the nodeAlert and deletes it. Returns an error if one occurs.
 func (c *nodeAlerts) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
@@ -0,0 +1,164 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// NotificationPoliciesGetter has a method to return a NotificationPolicyInterface.
+// A group's client should implement this interface.
+type NotificationPoliciesGetter interface {
+	NotificationPolicies() NotificationPolicyInterface
+}
+
+// NotificationPolicyInterface has methods to work with NotificationPolicy resources.
+type NotificationPolicyInterface interface {
+	Create(*v1alpha1.NotificationPolicy) (*v1alpha1.NotificationPolicy, error)
+	Update(*v1alpha1.NotificationPolicy) (*v1alpha1.NotificationPolicy, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.NotificationPolicy, error)
+	List(opts v1.ListOptions) (*v1alpha1.NotificationPolicyList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.NotificationPolicy, err error)
+	NotificationPolicyExpansion
+}
+
+// notificationPolicies implements NotificationPolicyInterface
+type notificationPolicies struct {
+	client rest.Interface
+}
+
+// newNotificationPolicies returns a NotificationPolicies
+func newNotificationPolicies(c *MonitoringV1alpha1Client) *notificationPolicies {
+	return &notificationPolicies{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the notificationPolicy, and returns the corresponding notificationPolicy object, and an error if there is any.
+func (c *notificationPolicies) Get(name string, options v1.GetOptions) (result *v1alpha1.NotificationPolicy, err error) {
+	result = &v1alpha1.NotificationPolicy{}
+	err = c.client.Get().
+		Resource("notificationpolicies").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NotificationPolicies that match those selectors.
+func (c *notificationPolicies) List(opts v1.ListOptions) (result *v1alpha1.NotificationPolicyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.NotificationPolicyList{}
+	err = c.client.Get().
+		Resource("notificationpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested notificationPolicies.
+func (c *notificationPolicies) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("notificationpolicies").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a notificationPolicy and creates it.  Returns the server's representation of the notificationPolicy, and an error, if there is any.
+func (c *notificationPolicies) Create(notificationPolicy *v1alpha1.NotificationPolicy) (result *v1alpha1.NotificationPolicy, err error) {
+	result = &v1alpha1.NotificationPolicy{}
+	err = c.client.Post().
+		Resource("notificationpolicies").
+		Body(notificationPolicy).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a notificationPolicy and updates it. Returns the server's representation of the notificationPolicy, and an error, if there is any.
+func (c *notificationPolicies) Update(notificationPolicy *v1alpha1.NotificationPolicy) (result *v1alpha1.NotificationPolicy, err error) {
+	result = &v1alpha1.NotificationPolicy{}
+	err = c.client.Put().
+		Resource("notificationpolicies").
+		Name(notificationPolicy.Name).
+		Body(notificationPolicy).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the notificationPolicy and deletes it. Returns an error if one occurs.
+func (c *notificationPolicies) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("notificationpolicies").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *notificationPolicies) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("notificationpolicies").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched notificationPolicy.
+func (c *notificationPolicies) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.NotificationPolicy, err error) {
+	result = &v1alpha1.NotificationPolicy{}
+	err = c.client.Patch(pt).
+		Resource("notificationpolicies").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
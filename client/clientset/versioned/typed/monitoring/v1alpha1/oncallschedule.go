@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// OnCallSchedulesGetter has a method to return a OnCallScheduleInterface.
+// A group's client should implement this interface.
+type OnCallSchedulesGetter interface {
+	OnCallSchedules(namespace string) OnCallScheduleInterface
+}
+
+// OnCallScheduleInterface has methods to work with OnCallSchedule resources.
+type OnCallScheduleInterface interface {
+	Create(*v1alpha1.OnCallSchedule) (*v1alpha1.OnCallSchedule, error)
+	Update(*v1alpha1.OnCallSchedule) (*v1alpha1.OnCallSchedule, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.OnCallSchedule, error)
+	List(opts v1.ListOptions) (*v1alpha1.OnCallScheduleList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.OnCallSchedule, err error)
+	OnCallScheduleExpansion
+}
+
+// onCallSchedules implements OnCallScheduleInterface
+type onCallSchedules struct {
+	client rest.Interface
+	ns     string
+}
+
+// newOnCallSchedules returns a OnCallSchedules
+func newOnCallSchedules(c *MonitoringV1alpha1Client, namespace string) *onCallSchedules {
+	return &onCallSchedules{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the onCallSchedule, and returns the corresponding onCallSchedule object, and an error if there is any.
+func (c *onCallSchedules) Get(name string, options v1.GetOptions) (result *v1alpha1.OnCallSchedule, err error) {
+	result = &v1alpha1.OnCallSchedule{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of OnCallSchedules that match those selectors.
+func (c *onCallSchedules) List(opts v1.ListOptions) (result *v1alpha1.OnCallScheduleList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.OnCallScheduleList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested onCallSchedules.
+func (c *onCallSchedules) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a onCallSchedule and creates it.  Returns the server's representation of the onCallSchedule, and an error, if there is any.
+func (c *onCallSchedules) Create(onCallSchedule *v1alpha1.OnCallSchedule) (result *v1alpha1.OnCallSchedule, err error) {
+	result = &v1alpha1.OnCallSchedule{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		Body(onCallSchedule).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a onCallSchedule and updates it. Returns the server's representation of the onCallSchedule, and an error, if there is any.
+func (c *onCallSchedules) Update(onCallSchedule *v1alpha1.OnCallSchedule) (result *v1alpha1.OnCallSchedule, err error) {
+	result = &v1alpha1.OnCallSchedule{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		Name(onCallSchedule.Name).
+		Body(onCallSchedule).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the onCallSchedule and deletes it. Returns an error if one occurs.
+func (c *onCallSchedules) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *onCallSchedules) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched onCallSchedule.
+func (c *onCallSchedules) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.OnCallSchedule, err error) {
+	result = &v1alpha1.OnCallSchedule{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("oncallschedules").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -39,6 +39,7 @@ type PodAlertsGetter interface {
 type PodAlertInterface interface {
 	Create(*v1alpha1.PodAlert) (*v1alpha1.PodAlert, error)
 	Update(*v1alpha1.PodAlert) (*v1alpha1.PodAlert, error)
+	UpdateStatus(*v1alpha1.PodAlert) (*v1alpha1.PodAlert, error)
 	Delete(name string, options *v1.DeleteOptions) error
 	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
 	Get(name string, options v1.GetOptions) (*v1alpha1.PodAlert, error)
@@ -132,6 +133,22 @@ func (c *podAlerts) Update(podAlert *v1alpha1.PodAlert) (result *v1alpha1.PodAle
 	return
 }
 
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *podAlerts) UpdateStatus(podAlert *v1alpha1.PodAlert) (result *v1alpha1.PodAlert, err error) {
+	result = &v1alpha1.PodAlert{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("podalerts").
+		Name(podAlert.Name).
+		SubResource("status").
+		Body(podAlert).
+		Do().
+		Into(result)
+	return
+}
+
 // Delete takes name of the podAlert and deletes it. Returns an error if one occurs.
 func (c *podAlerts) Delete(name string, options *v1.DeleteOptions) error {
 	return c.client.Delete().
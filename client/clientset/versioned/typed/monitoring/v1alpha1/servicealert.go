@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ServiceAlertsGetter has a method to return a ServiceAlertInterface.
+// A group's client should implement this interface.
+type ServiceAlertsGetter interface {
+	ServiceAlerts(namespace string) ServiceAlertInterface
+}
+
+// ServiceAlertInterface has methods to work with ServiceAlert resources.
+type ServiceAlertInterface interface {
+	Create(*v1alpha1.ServiceAlert) (*v1alpha1.ServiceAlert, error)
+	Update(*v1alpha1.ServiceAlert) (*v1alpha1.ServiceAlert, error)
+	UpdateStatus(*v1alpha1.ServiceAlert) (*v1alpha1.ServiceAlert, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.ServiceAlert, error)
+	List(opts v1.ListOptions) (*v1alpha1.ServiceAlertList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ServiceAlert, err error)
+	ServiceAlertExpansion
+}
+
+// serviceAlerts implements ServiceAlertInterface
+type serviceAlerts struct {
+	client rest.Interface
+	ns     string
+}
+
+// newServiceAlerts returns a ServiceAlerts
+func newServiceAlerts(c *MonitoringV1alpha1Client, namespace string) *serviceAlerts {
+	return &serviceAlerts{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the serviceAlert, and returns the corresponding serviceAlert object, and an error if there is any.
+func (c *serviceAlerts) Get(name string, options v1.GetOptions) (result *v1alpha1.ServiceAlert, err error) {
+	result = &v1alpha1.ServiceAlert{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ServiceAlerts that match those selectors.
+func (c *serviceAlerts) List(opts v1.ListOptions) (result *v1alpha1.ServiceAlertList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ServiceAlertList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested serviceAlerts.
+func (c *serviceAlerts) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a serviceAlert and creates it.  Returns the server's representation of the serviceAlert, and an error, if there is any.
+func (c *serviceAlerts) Create(serviceAlert *v1alpha1.ServiceAlert) (result *v1alpha1.ServiceAlert, err error) {
+	result = &v1alpha1.ServiceAlert{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		Body(serviceAlert).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a serviceAlert and updates it. Returns the server's representation of the serviceAlert, and an error, if there is any.
+func (c *serviceAlerts) Update(serviceAlert *v1alpha1.ServiceAlert) (result *v1alpha1.ServiceAlert, err error) {
+	result = &v1alpha1.ServiceAlert{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		Name(serviceAlert.Name).
+		Body(serviceAlert).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *serviceAlerts) UpdateStatus(serviceAlert *v1alpha1.ServiceAlert) (result *v1alpha1.ServiceAlert, err error) {
+	result = &v1alpha1.ServiceAlert{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		Name(serviceAlert.Name).
+		SubResource("status").
+		Body(serviceAlert).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the serviceAlert and deletes it. Returns an error if one occurs.
+func (c *serviceAlerts) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *serviceAlerts) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("servicealerts").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched serviceAlert.
+func (c *serviceAlerts) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.ServiceAlert, err error) {
+	result = &v1alpha1.ServiceAlert{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("servicealerts").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -0,0 +1,174 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// SilencesGetter has a method to return a SilenceInterface.
+// A group's client should implement this interface.
+type SilencesGetter interface {
+	Silences(namespace string) SilenceInterface
+}
+
+// SilenceInterface has methods to work with Silence resources.
+type SilenceInterface interface {
+	Create(*v1alpha1.Silence) (*v1alpha1.Silence, error)
+	Update(*v1alpha1.Silence) (*v1alpha1.Silence, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.Silence, error)
+	List(opts v1.ListOptions) (*v1alpha1.SilenceList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Silence, err error)
+	SilenceExpansion
+}
+
+// silences implements SilenceInterface
+type silences struct {
+	client rest.Interface
+	ns     string
+}
+
+// newSilences returns a Silences
+func newSilences(c *MonitoringV1alpha1Client, namespace string) *silences {
+	return &silences{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the silence, and returns the corresponding silence object, and an error if there is any.
+func (c *silences) Get(name string, options v1.GetOptions) (result *v1alpha1.Silence, err error) {
+	result = &v1alpha1.Silence{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("silences").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Silences that match those selectors.
+func (c *silences) List(opts v1.ListOptions) (result *v1alpha1.SilenceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.SilenceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("silences").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested silences.
+func (c *silences) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("silences").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a silence and creates it.  Returns the server's representation of the silence, and an error, if there is any.
+func (c *silences) Create(silence *v1alpha1.Silence) (result *v1alpha1.Silence, err error) {
+	result = &v1alpha1.Silence{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("silences").
+		Body(silence).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a silence and updates it. Returns the server's representation of the silence, and an error, if there is any.
+func (c *silences) Update(silence *v1alpha1.Silence) (result *v1alpha1.Silence, err error) {
+	result = &v1alpha1.Silence{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("silences").
+		Name(silence.Name).
+		Body(silence).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the silence and deletes it. Returns an error if one occurs.
+func (c *silences) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("silences").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *silences) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("silences").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched silence.
+func (c *silences) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.Silence, err error) {
+	result = &v1alpha1.Silence{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("silences").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
@@ -7,6 +7,7 @@ import (
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
 	cs "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1"
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -80,3 +81,56 @@ func TryUpdateClusterAlert(c cs.MonitoringV1alpha1Interface, meta metav1.ObjectM
 	}
 	return
 }
+
+func UpdateClusterAlertStatus(
+	c cs.MonitoringV1alpha1Interface,
+	in *api.ClusterAlert,
+	transform func(*api.AlertStatus) *api.AlertStatus,
+	useSubresource ...bool,
+) (result *api.ClusterAlert, err error) {
+	if len(useSubresource) > 1 {
+		return nil, errors.Errorf("invalid value passed for useSubresource: %v", useSubresource)
+	}
+	apply := func(x *api.ClusterAlert) *api.ClusterAlert {
+		out := &api.ClusterAlert{
+			TypeMeta:   x.TypeMeta,
+			ObjectMeta: x.ObjectMeta,
+			Spec:       x.Spec,
+			Status:     *transform(x.Status.DeepCopy()),
+		}
+		return out
+	}
+
+	if len(useSubresource) == 1 && useSubresource[0] {
+		attempt := 0
+		cur := in.DeepCopy()
+		err = wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+			attempt++
+			var e2 error
+			result, e2 = c.ClusterAlerts(in.Namespace).UpdateStatus(apply(cur))
+			if kerr.IsConflict(e2) {
+				latest, e3 := c.ClusterAlerts(in.Namespace).Get(in.Name, metav1.GetOptions{})
+				switch {
+				case e3 == nil:
+					cur = latest
+					return false, nil
+				case kutil.IsRequestRetryable(e3):
+					return false, nil
+				default:
+					return false, e3
+				}
+			} else if e2 != nil && !kutil.IsRequestRetryable(e2) {
+				return false, e2
+			}
+			return e2 == nil, nil
+		})
+
+		if err != nil {
+			err = fmt.Errorf("failed to update status of ClusterAlert %s/%s after %d attempts due to %v", in.Namespace, in.Name, attempt, err)
+		}
+		return
+	}
+
+	result, _, err = PatchClusterAlertObject(c, in, apply(in))
+	return
+}
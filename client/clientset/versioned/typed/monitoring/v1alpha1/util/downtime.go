@@ -0,0 +1,136 @@
+package util
+
+import (
+	"encoding/json"
+	"fmt"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	cs "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kutil "kmodules.xyz/client-go"
+)
+
+func CreateOrPatchDowntime(c cs.MonitoringV1alpha1Interface, meta metav1.ObjectMeta, transform func(alert *api.Downtime) *api.Downtime) (*api.Downtime, kutil.VerbType, error) {
+	cur, err := c.Downtimes(meta.Namespace).Get(meta.Name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		glog.V(3).Infof("Creating Downtime %s/%s.", meta.Namespace, meta.Name)
+		out, err := c.Downtimes(meta.Namespace).Create(transform(&api.Downtime{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "Downtime",
+				APIVersion: api.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: meta,
+		}))
+		return out, kutil.VerbCreated, err
+	} else if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+	return PatchDowntime(c, cur, transform)
+}
+
+func PatchDowntime(c cs.MonitoringV1alpha1Interface, cur *api.Downtime, transform func(*api.Downtime) *api.Downtime) (*api.Downtime, kutil.VerbType, error) {
+	return PatchDowntimeObject(c, cur, transform(cur.DeepCopy()))
+}
+
+func PatchDowntimeObject(c cs.MonitoringV1alpha1Interface, cur, mod *api.Downtime) (*api.Downtime, kutil.VerbType, error) {
+	curJson, err := json.Marshal(cur)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+
+	modJson, err := json.Marshal(mod)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+
+	patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(curJson, modJson, curJson)
+	if err != nil {
+		return nil, kutil.VerbUnchanged, err
+	}
+	if len(patch) == 0 || string(patch) == "{}" {
+		return cur, kutil.VerbUnchanged, nil
+	}
+	glog.V(3).Infof("Patching Downtime %s/%s with %s.", cur.Namespace, cur.Name, string(patch))
+	out, err := c.Downtimes(cur.Namespace).Patch(cur.Name, types.MergePatchType, patch)
+	return out, kutil.VerbPatched, err
+}
+
+func TryUpdateDowntime(c cs.MonitoringV1alpha1Interface, meta metav1.ObjectMeta, transform func(*api.Downtime) *api.Downtime) (result *api.Downtime, err error) {
+	attempt := 0
+	err = wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+		attempt++
+		cur, e2 := c.Downtimes(meta.Namespace).Get(meta.Name, metav1.GetOptions{})
+		if kerr.IsNotFound(e2) {
+			return false, e2
+		} else if e2 == nil {
+			result, e2 = c.Downtimes(cur.Namespace).Update(transform(cur.DeepCopy()))
+			return e2 == nil, nil
+		}
+		glog.Errorf("Attempt %d failed to update Downtime %s/%s due to %v.", attempt, cur.Namespace, cur.Name, e2)
+		return false, nil
+	})
+
+	if err != nil {
+		err = fmt.Errorf("failed to update Downtime %s/%s after %d attempts due to %v", meta.Namespace, meta.Name, attempt, err)
+	}
+	return
+}
+
+func UpdateDowntimeStatus(
+	c cs.MonitoringV1alpha1Interface,
+	in *api.Downtime,
+	transform func(*api.DowntimeStatus) *api.DowntimeStatus,
+	useSubresource ...bool,
+) (result *api.Downtime, err error) {
+	if len(useSubresource) > 1 {
+		return nil, errors.Errorf("invalid value passed for useSubresource: %v", useSubresource)
+	}
+	apply := func(x *api.Downtime) *api.Downtime {
+		out := &api.Downtime{
+			TypeMeta:   x.TypeMeta,
+			ObjectMeta: x.ObjectMeta,
+			Spec:       x.Spec,
+			Status:     *transform(x.Status.DeepCopy()),
+		}
+		return out
+	}
+
+	if len(useSubresource) == 1 && useSubresource[0] {
+		attempt := 0
+		cur := in.DeepCopy()
+		err = wait.PollImmediate(kutil.RetryInterval, kutil.RetryTimeout, func() (bool, error) {
+			attempt++
+			var e2 error
+			result, e2 = c.Downtimes(in.Namespace).UpdateStatus(apply(cur))
+			if kerr.IsConflict(e2) {
+				latest, e3 := c.Downtimes(in.Namespace).Get(in.Name, metav1.GetOptions{})
+				switch {
+				case e3 == nil:
+					cur = latest
+					return false, nil
+				case kutil.IsRequestRetryable(e3):
+					return false, nil
+				default:
+					return false, e3
+				}
+			} else if e2 != nil && !kutil.IsRequestRetryable(e2) {
+				return false, e2
+			}
+			return e2 == nil, nil
+		})
+
+		if err != nil {
+			err = fmt.Errorf("failed to update status of Downtime %s/%s after %d attempts due to %v", in.Namespace, in.Name, attempt, err)
+		}
+		return
+	}
+
+	result, _, err = PatchDowntimeObject(c, in, apply(in))
+	return
+}
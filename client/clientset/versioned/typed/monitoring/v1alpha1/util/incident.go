@@ -118,7 +118,7 @@ func UpdateIncidentStatus(
 				default:
 					return false, e3
 				}
-			} else if err != nil && !kutil.IsRequestRetryable(e2) {
+			} else if e2 != nil && !kutil.IsRequestRetryable(e2) {
 				return false, e2
 			}
 			return e2 == nil, nil
@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"time"
+
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	scheme "github.com/appscode/searchlight/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WorkloadAlertsGetter has a method to return a WorkloadAlertInterface.
+// A group's client should implement this interface.
+type WorkloadAlertsGetter interface {
+	WorkloadAlerts(namespace string) WorkloadAlertInterface
+}
+
+// WorkloadAlertInterface has methods to work with WorkloadAlert resources.
+type WorkloadAlertInterface interface {
+	Create(*v1alpha1.WorkloadAlert) (*v1alpha1.WorkloadAlert, error)
+	Update(*v1alpha1.WorkloadAlert) (*v1alpha1.WorkloadAlert, error)
+	UpdateStatus(*v1alpha1.WorkloadAlert) (*v1alpha1.WorkloadAlert, error)
+	Delete(name string, options *v1.DeleteOptions) error
+	DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error
+	Get(name string, options v1.GetOptions) (*v1alpha1.WorkloadAlert, error)
+	List(opts v1.ListOptions) (*v1alpha1.WorkloadAlertList, error)
+	Watch(opts v1.ListOptions) (watch.Interface, error)
+	Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.WorkloadAlert, err error)
+	WorkloadAlertExpansion
+}
+
+// workloadAlerts implements WorkloadAlertInterface
+type workloadAlerts struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWorkloadAlerts returns a WorkloadAlerts
+func newWorkloadAlerts(c *MonitoringV1alpha1Client, namespace string) *workloadAlerts {
+	return &workloadAlerts{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the workloadAlert, and returns the corresponding workloadAlert object, and an error if there is any.
+func (c *workloadAlerts) Get(name string, options v1.GetOptions) (result *v1alpha1.WorkloadAlert, err error) {
+	result = &v1alpha1.WorkloadAlert{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do().
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WorkloadAlerts that match those selectors.
+func (c *workloadAlerts) List(opts v1.ListOptions) (result *v1alpha1.WorkloadAlertList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.WorkloadAlertList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do().
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested workloadAlerts.
+func (c *workloadAlerts) Watch(opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch()
+}
+
+// Create takes the representation of a workloadAlert and creates it.  Returns the server's representation of the workloadAlert, and an error, if there is any.
+func (c *workloadAlerts) Create(workloadAlert *v1alpha1.WorkloadAlert) (result *v1alpha1.WorkloadAlert, err error) {
+	result = &v1alpha1.WorkloadAlert{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		Body(workloadAlert).
+		Do().
+		Into(result)
+	return
+}
+
+// Update takes the representation of a workloadAlert and updates it. Returns the server's representation of the workloadAlert, and an error, if there is any.
+func (c *workloadAlerts) Update(workloadAlert *v1alpha1.WorkloadAlert) (result *v1alpha1.WorkloadAlert, err error) {
+	result = &v1alpha1.WorkloadAlert{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		Name(workloadAlert.Name).
+		Body(workloadAlert).
+		Do().
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+
+func (c *workloadAlerts) UpdateStatus(workloadAlert *v1alpha1.WorkloadAlert) (result *v1alpha1.WorkloadAlert, err error) {
+	result = &v1alpha1.WorkloadAlert{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		Name(workloadAlert.Name).
+		SubResource("status").
+		Body(workloadAlert).
+		Do().
+		Into(result)
+	return
+}
+
+// Delete takes name of the workloadAlert and deletes it. Returns an error if one occurs.
+func (c *workloadAlerts) Delete(name string, options *v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		Name(name).
+		Body(options).
+		Do().
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *workloadAlerts) DeleteCollection(options *v1.DeleteOptions, listOptions v1.ListOptions) error {
+	var timeout time.Duration
+	if listOptions.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOptions.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		VersionedParams(&listOptions, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(options).
+		Do().
+		Error()
+}
+
+// Patch applies the patch and returns the patched workloadAlert.
+func (c *workloadAlerts) Patch(name string, pt types.PatchType, data []byte, subresources ...string) (result *v1alpha1.WorkloadAlert, err error) {
+	result = &v1alpha1.WorkloadAlert{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("workloadalerts").
+		SubResource(subresources...).
+		Name(name).
+		Body(data).
+		Do().
+		Into(result)
+	return
+}
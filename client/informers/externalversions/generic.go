@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	v1beta1 "github.com/appscode/searchlight/apis/monitoring/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -53,16 +54,38 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=monitoring.appscode.com, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("alerttemplates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().AlertTemplates().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("clusteralerts"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ClusterAlerts().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("downtimes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().Downtimes().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("incidents"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().Incidents().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("nodealerts"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().NodeAlerts().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("notificationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().NotificationPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("oncallschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().OnCallSchedules().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("podalerts"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().PodAlerts().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("searchlightplugins"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().SearchlightPlugins().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("servicealerts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().ServiceAlerts().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("silences"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().Silences().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("workloadalerts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1alpha1().WorkloadAlerts().Informer()}, nil
+
+		// Group=monitoring.appscode.com, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("clusteralerts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1beta1().ClusterAlerts().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("nodealerts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1beta1().NodeAlerts().Informer()}, nil
+	case v1beta1.SchemeGroupVersion.WithResource("podalerts"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitoring().V1beta1().PodAlerts().Informer()}, nil
 
 	}
 
@@ -24,16 +24,30 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AlertTemplates returns a AlertTemplateInformer.
+	AlertTemplates() AlertTemplateInformer
 	// ClusterAlerts returns a ClusterAlertInformer.
 	ClusterAlerts() ClusterAlertInformer
+	// Downtimes returns a DowntimeInformer.
+	Downtimes() DowntimeInformer
 	// Incidents returns a IncidentInformer.
 	Incidents() IncidentInformer
 	// NodeAlerts returns a NodeAlertInformer.
 	NodeAlerts() NodeAlertInformer
+	// NotificationPolicies returns a NotificationPolicyInformer.
+	NotificationPolicies() NotificationPolicyInformer
+	// OnCallSchedules returns a OnCallScheduleInformer.
+	OnCallSchedules() OnCallScheduleInformer
 	// PodAlerts returns a PodAlertInformer.
 	PodAlerts() PodAlertInformer
 	// SearchlightPlugins returns a SearchlightPluginInformer.
 	SearchlightPlugins() SearchlightPluginInformer
+	// ServiceAlerts returns a ServiceAlertInformer.
+	ServiceAlerts() ServiceAlertInformer
+	// Silences returns a SilenceInformer.
+	Silences() SilenceInformer
+	// WorkloadAlerts returns a WorkloadAlertInformer.
+	WorkloadAlerts() WorkloadAlertInformer
 }
 
 type version struct {
@@ -47,11 +61,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AlertTemplates returns a AlertTemplateInformer.
+func (v *version) AlertTemplates() AlertTemplateInformer {
+	return &alertTemplateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterAlerts returns a ClusterAlertInformer.
 func (v *version) ClusterAlerts() ClusterAlertInformer {
 	return &clusterAlertInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// Downtimes returns a DowntimeInformer.
+func (v *version) Downtimes() DowntimeInformer {
+	return &downtimeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Incidents returns a IncidentInformer.
 func (v *version) Incidents() IncidentInformer {
 	return &incidentInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -62,6 +86,16 @@ func (v *version) NodeAlerts() NodeAlertInformer {
 	return &nodeAlertInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NotificationPolicies returns a NotificationPolicyInformer.
+func (v *version) NotificationPolicies() NotificationPolicyInformer {
+	return &notificationPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// OnCallSchedules returns a OnCallScheduleInformer.
+func (v *version) OnCallSchedules() OnCallScheduleInformer {
+	return &onCallScheduleInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PodAlerts returns a PodAlertInformer.
 func (v *version) PodAlerts() PodAlertInformer {
 	return &podAlertInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -71,3 +105,18 @@ func (v *version) PodAlerts() PodAlertInformer {
 func (v *version) SearchlightPlugins() SearchlightPluginInformer {
 	return &searchlightPluginInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
+
+// ServiceAlerts returns a ServiceAlertInformer.
+func (v *version) ServiceAlerts() ServiceAlertInformer {
+	return &serviceAlertInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Silences returns a SilenceInformer.
+func (v *version) Silences() SilenceInformer {
+	return &silenceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// WorkloadAlerts returns a WorkloadAlertInformer.
+func (v *version) WorkloadAlerts() WorkloadAlertInformer {
+	return &workloadAlertInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
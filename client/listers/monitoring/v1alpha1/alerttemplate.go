@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AlertTemplateLister helps list AlertTemplates.
+type AlertTemplateLister interface {
+	// List lists all AlertTemplates in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.AlertTemplate, err error)
+	// AlertTemplates returns an object that can list and get AlertTemplates.
+	AlertTemplates(namespace string) AlertTemplateNamespaceLister
+	AlertTemplateListerExpansion
+}
+
+// alertTemplateLister implements the AlertTemplateLister interface.
+type alertTemplateLister struct {
+	indexer cache.Indexer
+}
+
+// NewAlertTemplateLister returns a new AlertTemplateLister.
+func NewAlertTemplateLister(indexer cache.Indexer) AlertTemplateLister {
+	return &alertTemplateLister{indexer: indexer}
+}
+
+// List lists all AlertTemplates in the indexer.
+func (s *alertTemplateLister) List(selector labels.Selector) (ret []*v1alpha1.AlertTemplate, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.AlertTemplate))
+	})
+	return ret, err
+}
+
+// AlertTemplates returns an object that can list and get AlertTemplates.
+func (s *alertTemplateLister) AlertTemplates(namespace string) AlertTemplateNamespaceLister {
+	return alertTemplateNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// AlertTemplateNamespaceLister helps list and get AlertTemplates.
+type AlertTemplateNamespaceLister interface {
+	// List lists all AlertTemplates in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.AlertTemplate, err error)
+	// Get retrieves the AlertTemplate from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.AlertTemplate, error)
+	AlertTemplateNamespaceListerExpansion
+}
+
+// alertTemplateNamespaceLister implements the AlertTemplateNamespaceLister
+// interface.
+type alertTemplateNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all AlertTemplates in the indexer for a given namespace.
+func (s alertTemplateNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.AlertTemplate, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.AlertTemplate))
+	})
+	return ret, err
+}
+
+// Get retrieves the AlertTemplate from the indexer for a given namespace and name.
+func (s alertTemplateNamespaceLister) Get(name string) (*v1alpha1.AlertTemplate, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("alerttemplate"), name)
+	}
+	return obj.(*v1alpha1.AlertTemplate), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DowntimeLister helps list Downtimes.
+type DowntimeLister interface {
+	// List lists all Downtimes in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.Downtime, err error)
+	// Downtimes returns an object that can list and get Downtimes.
+	Downtimes(namespace string) DowntimeNamespaceLister
+	DowntimeListerExpansion
+}
+
+// downtimeLister implements the DowntimeLister interface.
+type downtimeLister struct {
+	indexer cache.Indexer
+}
+
+// NewDowntimeLister returns a new DowntimeLister.
+func NewDowntimeLister(indexer cache.Indexer) DowntimeLister {
+	return &downtimeLister{indexer: indexer}
+}
+
+// List lists all Downtimes in the indexer.
+func (s *downtimeLister) List(selector labels.Selector) (ret []*v1alpha1.Downtime, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Downtime))
+	})
+	return ret, err
+}
+
+// Downtimes returns an object that can list and get Downtimes.
+func (s *downtimeLister) Downtimes(namespace string) DowntimeNamespaceLister {
+	return downtimeNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DowntimeNamespaceLister helps list and get Downtimes.
+type DowntimeNamespaceLister interface {
+	// List lists all Downtimes in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.Downtime, err error)
+	// Get retrieves the Downtime from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.Downtime, error)
+	DowntimeNamespaceListerExpansion
+}
+
+// downtimeNamespaceLister implements the DowntimeNamespaceLister
+// interface.
+type downtimeNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Downtimes in the indexer for a given namespace.
+func (s downtimeNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.Downtime, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Downtime))
+	})
+	return ret, err
+}
+
+// Get retrieves the Downtime from the indexer for a given namespace and name.
+func (s downtimeNamespaceLister) Get(name string) (*v1alpha1.Downtime, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("downtime"), name)
+	}
+	return obj.(*v1alpha1.Downtime), nil
+}
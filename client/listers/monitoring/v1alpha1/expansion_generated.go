@@ -18,6 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+// AlertTemplateListerExpansion allows custom methods to be added to
+// AlertTemplateLister.
+type AlertTemplateListerExpansion interface{}
+
+// AlertTemplateNamespaceListerExpansion allows custom methods to be added to
+// AlertTemplateNamespaceLister.
+type AlertTemplateNamespaceListerExpansion interface{}
+
 // ClusterAlertListerExpansion allows custom methods to be added to
 // ClusterAlertLister.
 type ClusterAlertListerExpansion interface{}
@@ -26,6 +34,14 @@ type ClusterAlertListerExpansion interface{}
 // ClusterAlertNamespaceLister.
 type ClusterAlertNamespaceListerExpansion interface{}
 
+// DowntimeListerExpansion allows custom methods to be added to
+// DowntimeLister.
+type DowntimeListerExpansion interface{}
+
+// DowntimeNamespaceListerExpansion allows custom methods to be added to
+// DowntimeNamespaceLister.
+type DowntimeNamespaceListerExpansion interface{}
+
 // IncidentListerExpansion allows custom methods to be added to
 // IncidentLister.
 type IncidentListerExpansion interface{}
@@ -42,6 +58,18 @@ type NodeAlertListerExpansion interface{}
 // NodeAlertNamespaceLister.
 type NodeAlertNamespaceListerExpansion interface{}
 
+// NotificationPolicyListerExpansion allows custom methods to be added to
+// NotificationPolicyLister.
+type NotificationPolicyListerExpansion interface{}
+
+// OnCallScheduleListerExpansion allows custom methods to be added to
+// OnCallScheduleLister.
+type OnCallScheduleListerExpansion interface{}
+
+// OnCallScheduleNamespaceListerExpansion allows custom methods to be added to
+// OnCallScheduleNamespaceLister.
+type OnCallScheduleNamespaceListerExpansion interface{}
+
 // PodAlertListerExpansion allows custom methods to be added to
 // PodAlertLister.
 type PodAlertListerExpansion interface{}
@@ -53,3 +81,27 @@ type PodAlertNamespaceListerExpansion interface{}
 // SearchlightPluginListerExpansion allows custom methods to be added to
 // SearchlightPluginLister.
 type SearchlightPluginListerExpansion interface{}
+
+// ServiceAlertListerExpansion allows custom methods to be added to
+// ServiceAlertLister.
+type ServiceAlertListerExpansion interface{}
+
+// ServiceAlertNamespaceListerExpansion allows custom methods to be added to
+// ServiceAlertNamespaceLister.
+type ServiceAlertNamespaceListerExpansion interface{}
+
+// SilenceListerExpansion allows custom methods to be added to
+// SilenceLister.
+type SilenceListerExpansion interface{}
+
+// SilenceNamespaceListerExpansion allows custom methods to be added to
+// SilenceNamespaceLister.
+type SilenceNamespaceListerExpansion interface{}
+
+// WorkloadAlertListerExpansion allows custom methods to be added to
+// WorkloadAlertLister.
+type WorkloadAlertListerExpansion interface{}
+
+// WorkloadAlertNamespaceListerExpansion allows custom methods to be added to
+// WorkloadAlertNamespaceLister.
+type WorkloadAlertNamespaceListerExpansion interface{}
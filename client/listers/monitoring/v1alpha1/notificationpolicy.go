@@ -0,0 +1,65 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// NotificationPolicyLister helps list NotificationPolicies.
+type NotificationPolicyLister interface {
+	// List lists all NotificationPolicies in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.NotificationPolicy, err error)
+	// Get retrieves the NotificationPolicy from the index for a given name.
+	Get(name string) (*v1alpha1.NotificationPolicy, error)
+	NotificationPolicyListerExpansion
+}
+
+// notificationPolicyLister implements the NotificationPolicyLister interface.
+type notificationPolicyLister struct {
+	indexer cache.Indexer
+}
+
+// NewNotificationPolicyLister returns a new NotificationPolicyLister.
+func NewNotificationPolicyLister(indexer cache.Indexer) NotificationPolicyLister {
+	return &notificationPolicyLister{indexer: indexer}
+}
+
+// List lists all NotificationPolicies in the indexer.
+func (s *notificationPolicyLister) List(selector labels.Selector) (ret []*v1alpha1.NotificationPolicy, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.NotificationPolicy))
+	})
+	return ret, err
+}
+
+// Get retrieves the NotificationPolicy from the index for a given name.
+func (s *notificationPolicyLister) Get(name string) (*v1alpha1.NotificationPolicy, error) {
+	obj, exists, err := s.indexer.GetByKey(name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("notificationpolicy"), name)
+	}
+	return obj.(*v1alpha1.NotificationPolicy), nil
+}
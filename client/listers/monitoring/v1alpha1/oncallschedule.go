@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// OnCallScheduleLister helps list OnCallSchedules.
+type OnCallScheduleLister interface {
+	// List lists all OnCallSchedules in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.OnCallSchedule, err error)
+	// OnCallSchedules returns an object that can list and get OnCallSchedules.
+	OnCallSchedules(namespace string) OnCallScheduleNamespaceLister
+	OnCallScheduleListerExpansion
+}
+
+// onCallScheduleLister implements the OnCallScheduleLister interface.
+type onCallScheduleLister struct {
+	indexer cache.Indexer
+}
+
+// NewOnCallScheduleLister returns a new OnCallScheduleLister.
+func NewOnCallScheduleLister(indexer cache.Indexer) OnCallScheduleLister {
+	return &onCallScheduleLister{indexer: indexer}
+}
+
+// List lists all OnCallSchedules in the indexer.
+func (s *onCallScheduleLister) List(selector labels.Selector) (ret []*v1alpha1.OnCallSchedule, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.OnCallSchedule))
+	})
+	return ret, err
+}
+
+// OnCallSchedules returns an object that can list and get OnCallSchedules.
+func (s *onCallScheduleLister) OnCallSchedules(namespace string) OnCallScheduleNamespaceLister {
+	return onCallScheduleNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// OnCallScheduleNamespaceLister helps list and get OnCallSchedules.
+type OnCallScheduleNamespaceLister interface {
+	// List lists all OnCallSchedules in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.OnCallSchedule, err error)
+	// Get retrieves the OnCallSchedule from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.OnCallSchedule, error)
+	OnCallScheduleNamespaceListerExpansion
+}
+
+// onCallScheduleNamespaceLister implements the OnCallScheduleNamespaceLister
+// interface.
+type onCallScheduleNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all OnCallSchedules in the indexer for a given namespace.
+func (s onCallScheduleNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.OnCallSchedule, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.OnCallSchedule))
+	})
+	return ret, err
+}
+
+// Get retrieves the OnCallSchedule from the indexer for a given namespace and name.
+func (s onCallScheduleNamespaceLister) Get(name string) (*v1alpha1.OnCallSchedule, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("oncallschedule"), name)
+	}
+	return obj.(*v1alpha1.OnCallSchedule), nil
+}
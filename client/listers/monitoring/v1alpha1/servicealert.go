@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ServiceAlertLister helps list ServiceAlerts.
+type ServiceAlertLister interface {
+	// List lists all ServiceAlerts in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.ServiceAlert, err error)
+	// ServiceAlerts returns an object that can list and get ServiceAlerts.
+	ServiceAlerts(namespace string) ServiceAlertNamespaceLister
+	ServiceAlertListerExpansion
+}
+
+// serviceAlertLister implements the ServiceAlertLister interface.
+type serviceAlertLister struct {
+	indexer cache.Indexer
+}
+
+// NewServiceAlertLister returns a new ServiceAlertLister.
+func NewServiceAlertLister(indexer cache.Indexer) ServiceAlertLister {
+	return &serviceAlertLister{indexer: indexer}
+}
+
+// List lists all ServiceAlerts in the indexer.
+func (s *serviceAlertLister) List(selector labels.Selector) (ret []*v1alpha1.ServiceAlert, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ServiceAlert))
+	})
+	return ret, err
+}
+
+// ServiceAlerts returns an object that can list and get ServiceAlerts.
+func (s *serviceAlertLister) ServiceAlerts(namespace string) ServiceAlertNamespaceLister {
+	return serviceAlertNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ServiceAlertNamespaceLister helps list and get ServiceAlerts.
+type ServiceAlertNamespaceLister interface {
+	// List lists all ServiceAlerts in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.ServiceAlert, err error)
+	// Get retrieves the ServiceAlert from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.ServiceAlert, error)
+	ServiceAlertNamespaceListerExpansion
+}
+
+// serviceAlertNamespaceLister implements the ServiceAlertNamespaceLister
+// interface.
+type serviceAlertNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ServiceAlerts in the indexer for a given namespace.
+func (s serviceAlertNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ServiceAlert, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ServiceAlert))
+	})
+	return ret, err
+}
+
+// Get retrieves the ServiceAlert from the indexer for a given namespace and name.
+func (s serviceAlertNamespaceLister) Get(name string) (*v1alpha1.ServiceAlert, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("servicealert"), name)
+	}
+	return obj.(*v1alpha1.ServiceAlert), nil
+}
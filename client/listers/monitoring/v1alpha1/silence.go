@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SilenceLister helps list Silences.
+type SilenceLister interface {
+	// List lists all Silences in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.Silence, err error)
+	// Silences returns an object that can list and get Silences.
+	Silences(namespace string) SilenceNamespaceLister
+	SilenceListerExpansion
+}
+
+// silenceLister implements the SilenceLister interface.
+type silenceLister struct {
+	indexer cache.Indexer
+}
+
+// NewSilenceLister returns a new SilenceLister.
+func NewSilenceLister(indexer cache.Indexer) SilenceLister {
+	return &silenceLister{indexer: indexer}
+}
+
+// List lists all Silences in the indexer.
+func (s *silenceLister) List(selector labels.Selector) (ret []*v1alpha1.Silence, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Silence))
+	})
+	return ret, err
+}
+
+// Silences returns an object that can list and get Silences.
+func (s *silenceLister) Silences(namespace string) SilenceNamespaceLister {
+	return silenceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// SilenceNamespaceLister helps list and get Silences.
+type SilenceNamespaceLister interface {
+	// List lists all Silences in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.Silence, err error)
+	// Get retrieves the Silence from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.Silence, error)
+	SilenceNamespaceListerExpansion
+}
+
+// silenceNamespaceLister implements the SilenceNamespaceLister
+// interface.
+type silenceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Silences in the indexer for a given namespace.
+func (s silenceNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.Silence, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Silence))
+	})
+	return ret, err
+}
+
+// Get retrieves the Silence from the indexer for a given namespace and name.
+func (s silenceNamespaceLister) Get(name string) (*v1alpha1.Silence, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("silence"), name)
+	}
+	return obj.(*v1alpha1.Silence), nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WorkloadAlertLister helps list WorkloadAlerts.
+type WorkloadAlertLister interface {
+	// List lists all WorkloadAlerts in the indexer.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkloadAlert, err error)
+	// WorkloadAlerts returns an object that can list and get WorkloadAlerts.
+	WorkloadAlerts(namespace string) WorkloadAlertNamespaceLister
+	WorkloadAlertListerExpansion
+}
+
+// workloadAlertLister implements the WorkloadAlertLister interface.
+type workloadAlertLister struct {
+	indexer cache.Indexer
+}
+
+// NewWorkloadAlertLister returns a new WorkloadAlertLister.
+func NewWorkloadAlertLister(indexer cache.Indexer) WorkloadAlertLister {
+	return &workloadAlertLister{indexer: indexer}
+}
+
+// List lists all WorkloadAlerts in the indexer.
+func (s *workloadAlertLister) List(selector labels.Selector) (ret []*v1alpha1.WorkloadAlert, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkloadAlert))
+	})
+	return ret, err
+}
+
+// WorkloadAlerts returns an object that can list and get WorkloadAlerts.
+func (s *workloadAlertLister) WorkloadAlerts(namespace string) WorkloadAlertNamespaceLister {
+	return workloadAlertNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WorkloadAlertNamespaceLister helps list and get WorkloadAlerts.
+type WorkloadAlertNamespaceLister interface {
+	// List lists all WorkloadAlerts in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1alpha1.WorkloadAlert, err error)
+	// Get retrieves the WorkloadAlert from the indexer for a given namespace and name.
+	Get(name string) (*v1alpha1.WorkloadAlert, error)
+	WorkloadAlertNamespaceListerExpansion
+}
+
+// workloadAlertNamespaceLister implements the WorkloadAlertNamespaceLister
+// interface.
+type workloadAlertNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WorkloadAlerts in the indexer for a given namespace.
+func (s workloadAlertNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.WorkloadAlert, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WorkloadAlert))
+	})
+	return ret, err
+}
+
+// Get retrieves the WorkloadAlert from the indexer for a given namespace and name.
+func (s workloadAlertNamespaceLister) Get(name string) (*v1alpha1.WorkloadAlert, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("workloadalert"), name)
+	}
+	return obj.(*v1alpha1.WorkloadAlert), nil
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2019 The Searchlight Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// ClusterAlertListerExpansion allows custom methods to be added to
+// ClusterAlertLister.
+type ClusterAlertListerExpansion interface{}
+
+// ClusterAlertNamespaceListerExpansion allows custom methods to be added to
+// ClusterAlertNamespaceLister.
+type ClusterAlertNamespaceListerExpansion interface{}
+
+// NodeAlertListerExpansion allows custom methods to be added to
+// NodeAlertLister.
+type NodeAlertListerExpansion interface{}
+
+// NodeAlertNamespaceListerExpansion allows custom methods to be added to
+// NodeAlertNamespaceLister.
+type NodeAlertNamespaceListerExpansion interface{}
+
+// PodAlertListerExpansion allows custom methods to be added to
+// PodAlertLister.
+type PodAlertListerExpansion interface{}
+
+// PodAlertNamespaceListerExpansion allows custom methods to be added to
+// PodAlertNamespaceLister.
+type PodAlertNamespaceListerExpansion interface{}
@@ -7,7 +7,7 @@ PACKAGE_NAME=github.com/appscode/searchlight
 REPO_ROOT="$GOPATH/src/$PACKAGE_NAME"
 DOCKER_REPO_ROOT="/go/src/$PACKAGE_NAME"
 DOCKER_CODEGEN_PKG="/go/src/k8s.io/code-generator"
-apiGroups=(incidents/v1alpha1 monitoring/v1alpha1)
+apiGroups=(incidents/v1alpha1 monitoring/v1alpha1 monitoring/v1beta1)
 
 pushd $REPO_ROOT
 
@@ -23,7 +23,7 @@ docker run --rm -ti -u $(id -u):$(id -g) \
   github.com/appscode/searchlight/client \
   github.com/appscode/searchlight/apis \
   github.com/appscode/searchlight/apis \
-  incidents:v1alpha1 \
+  "incidents:v1alpha1 monitoring:v1alpha1,v1beta1" \
   --go-header-file "$DOCKER_REPO_ROOT/hack/gengo/boilerplate.go.txt"
 
 # for both CRD and EAS types
@@ -33,7 +33,7 @@ docker run --rm -ti -u $(id -u):$(id -g) \
   appscode/gengo:release-1.14 "$DOCKER_CODEGEN_PKG"/generate-groups.sh all \
   github.com/appscode/searchlight/client \
   github.com/appscode/searchlight/apis \
-  "incidents:v1alpha1 monitoring:v1alpha1" \
+  "incidents:v1alpha1 monitoring:v1alpha1,v1beta1" \
   --go-header-file "$DOCKER_REPO_ROOT/hack/gengo/boilerplate.go.txt"
 
 # Generate openapi
@@ -1,8 +1,8 @@
 #!/bin/bash
 set -eou pipefail
 
-crds=(clusteralerts nodealerts podalerts incidents searchlightplugins)
-apiversions=(v1alpha1.admission v1alpha1.incidents)
+crds=(clusteralerts nodealerts podalerts servicealerts workloadalerts downtimes silences alerttemplates oncallschedules notificationpolicies incidents searchlightplugins)
+apiversions=(v1alpha1.admission v1alpha1.conversion v1alpha1.incidents)
 
 echo "checking kubeconfig context"
 kubectl config current-context || {
@@ -167,6 +167,7 @@ export SEARCHLIGHT_ENABLE_ANALYTICS=true
 export SEARCHLIGHT_UNINSTALL=0
 export SEARCHLIGHT_PURGE=0
 export SEARCHLIGHT_ENABLE_STATUS_SUBRESOURCE=false
+export SEARCHLIGHT_ENABLE_CONVERSION_WEBHOOK=false
 export SEARCHLIGHT_PRIORITY_CLASS=system-cluster-critical
 
 export SCRIPT_LOCATION="curl -fsSL https://raw.githubusercontent.com/appscode/searchlight/8.0.0/"
@@ -181,6 +182,7 @@ fi
 KUBE_APISERVER_VERSION=$(kubectl version -o=json | $ONESSL jsonpath '{.serverVersion.gitVersion}')
 $ONESSL semver --check='<1.9.0' $KUBE_APISERVER_VERSION || { export SEARCHLIGHT_ENABLE_VALIDATING_WEBHOOK=true; }
 $ONESSL semver --check='<1.11.0' $KUBE_APISERVER_VERSION || { export SEARCHLIGHT_ENABLE_STATUS_SUBRESOURCE=true; }
+$ONESSL semver --check='<1.15.0' $KUBE_APISERVER_VERSION || { export SEARCHLIGHT_ENABLE_CONVERSION_WEBHOOK=true; }
 
 show_help() {
   echo "searchlight.sh - install searchlight operator"
@@ -197,6 +199,7 @@ show_help() {
   echo "    --enable-validating-webhook    enable/disable validating webhooks for Searchlight crds"
   echo "    --icinga-api-password          password used by icinga2 api (if unset, a random password will be generated and used)"
   echo "    --enable-status-subresource    If enabled, uses status sub resource for Searchlight crds"
+  echo "    --enable-conversion-webhook    If enabled, serves alert crds in both v1alpha1 and v1beta1 using conversion webhook"
   echo "    --enable-analytics             send usage events to Google Analytics (default: true)"
   echo "    --uninstall                    uninstall searchlight"
   echo "    --purge                        purges searchlight crd objects and crds"
@@ -245,6 +248,13 @@ while test $# -gt 0; do
       fi
       shift
       ;;
+    --enable-conversion-webhook*)
+      val=$(echo $1 | sed -e 's/^[^=]*=//g')
+      if [ "$val" = "false" ]; then
+        export SEARCHLIGHT_ENABLE_CONVERSION_WEBHOOK=false
+      fi
+      shift
+      ;;
     --enable-analytics*)
       val=$(echo $1 | sed -e 's/^[^=]*=//g')
       if [ "$val" = "false" ]; then
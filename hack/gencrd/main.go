@@ -23,6 +23,7 @@ import (
 
 func generateCRDDefinitions() {
 	slitev1alpha1.EnableStatusSubresource = true
+	slitev1alpha1.EnableAdditionalProperties = true
 
 	filename := gort.GOPath() + "/src/github.com/appscode/searchlight/apis/monitoring/v1alpha1/crds.yaml"
 	os.Remove(filename)
@@ -36,6 +37,13 @@ func generateCRDDefinitions() {
 		slitev1alpha1.ClusterAlert{}.CustomResourceDefinition(),
 		slitev1alpha1.NodeAlert{}.CustomResourceDefinition(),
 		slitev1alpha1.PodAlert{}.CustomResourceDefinition(),
+		slitev1alpha1.ServiceAlert{}.CustomResourceDefinition(),
+		slitev1alpha1.WorkloadAlert{}.CustomResourceDefinition(),
+		slitev1alpha1.Downtime{}.CustomResourceDefinition(),
+		slitev1alpha1.Silence{}.CustomResourceDefinition(),
+		slitev1alpha1.AlertTemplate{}.CustomResourceDefinition(),
+		slitev1alpha1.OnCallSchedule{}.CustomResourceDefinition(),
+		slitev1alpha1.NotificationPolicy{}.CustomResourceDefinition(),
 		slitev1alpha1.Incident{}.CustomResourceDefinition(),
 		slitev1alpha1.SearchlightPlugin{}.CustomResourceDefinition(),
 	}
@@ -83,6 +91,13 @@ func generateSwaggerJson() {
 			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralClusterAlert, slitev1alpha1.ResourceKindClusterAlert, true},
 			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralNodeAlert, slitev1alpha1.ResourceKindNodeAlert, true},
 			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralPodAlert, slitev1alpha1.ResourceKindPodAlert, true},
+			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralServiceAlert, slitev1alpha1.ResourceKindServiceAlert, true},
+			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralWorkloadAlert, slitev1alpha1.ResourceKindWorkloadAlert, true},
+			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralDowntime, slitev1alpha1.ResourceKindDowntime, true},
+			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralSilence, slitev1alpha1.ResourceKindSilence, true},
+			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralAlertTemplate, slitev1alpha1.ResourceKindAlertTemplate, true},
+			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralOnCallSchedule, slitev1alpha1.ResourceKindOnCallSchedule, true},
+			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralNotificationPolicy, slitev1alpha1.ResourceKindNotificationPolicy, false},
 			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralIncident, slitev1alpha1.ResourceKindIncident, true},
 			{slitev1alpha1.SchemeGroupVersion, slitev1alpha1.ResourcePluralSearchlightPlugin, slitev1alpha1.ResourceKindSearchlightPlugin, true},
 		},
@@ -29,6 +29,10 @@ func main() {
 		plugin.GetPodStatusPlugin(),
 		plugin.GetPodVolumePlugin(),
 		plugin.GetPodExecPlugin(),
+		plugin.GetServiceEndpointsPlugin(),
+		plugin.GetWorkloadRolloutPlugin(),
+		plugin.GetWorkloadReplicasPlugin(),
+		plugin.GetWorkloadUnavailablePlugin(),
 	}
 
 	f, err := os.OpenFile(filepath.Join(pluginFolder, "plugins.yaml"), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
@@ -1,20 +1,37 @@
 package plugin
 
 import (
-	"encoding/json"
+	"fmt"
 	"sync"
 
+	"github.com/appscode/searchlight/apis/monitoring"
+	"github.com/appscode/searchlight/apis/monitoring/install"
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	cs "github.com/appscode/searchlight/client/clientset/versioned"
+	"github.com/appscode/searchlight/plugins/notifier"
 	admission "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	hooks "kmodules.xyz/webhook-runtime/admission/v1beta1"
 )
 
+var (
+	scheme = runtime.NewScheme()
+	codecs = serializer.NewCodecFactory(scheme)
+)
+
+func init() {
+	install.Install(scheme)
+}
+
 type CRDValidator struct {
 	client      kubernetes.Interface
+	extClient   cs.Interface
 	lock        sync.RWMutex
 	initialized bool
 }
@@ -38,12 +55,15 @@ func (a *CRDValidator) Initialize(config *rest.Config, stopCh <-chan struct{}) e
 	if a.client, err = kubernetes.NewForConfig(config); err != nil {
 		return err
 	}
+	if a.extClient, err = cs.NewForConfig(config); err != nil {
+		return err
+	}
 	return err
 }
 
 func (a *CRDValidator) Admit(req *admission.AdmissionRequest) *admission.AdmissionResponse {
 	status := &admission.AdmissionResponse{}
-	supportedKinds := sets.NewString(api.ResourceKindClusterAlert, api.ResourceKindNodeAlert, api.ResourceKindPodAlert)
+	supportedKinds := sets.NewString(api.ResourceKindClusterAlert, api.ResourceKindNodeAlert, api.ResourceKindPodAlert, api.ResourceKindServiceAlert, api.ResourceKindWorkloadAlert, api.ResourceKindDowntime, api.ResourceKindSilence, api.ResourceKindAlertTemplate, api.ResourceKindOnCallSchedule, api.ResourceKindNotificationPolicy)
 
 	if (req.Operation != admission.Create && req.Operation != admission.Update) ||
 		len(req.SubResource) != 0 ||
@@ -59,25 +79,113 @@ func (a *CRDValidator) Admit(req *admission.AdmissionRequest) *admission.Admissi
 		return hooks.StatusUninitialized()
 	}
 
-	var alert api.Alert
-	switch req.Kind.Kind {
-	case api.ResourceKindClusterAlert:
-		alert = &api.ClusterAlert{}
-	case api.ResourceKindNodeAlert:
-		alert = &api.NodeAlert{}
-	case api.ResourceKindPodAlert:
-		alert = &api.PodAlert{}
-	}
-
-	err := json.Unmarshal(req.Object.Raw, alert)
+	obj, err := runtime.Decode(codecs.UniversalDeserializer(), req.Object.Raw)
 	if err != nil {
 		return hooks.StatusBadRequest(err)
 	}
+	// objects may be created without namespace in their metadata, eg, PodAlerts are validated based on it
+	if m, ok := obj.(metav1.Object); ok && m.GetNamespace() == "" {
+		m.SetNamespace(req.Namespace)
+	}
+	if d, ok := obj.(*api.Downtime); ok {
+		if err := d.IsValid(); err != nil {
+			return hooks.StatusForbidden(err)
+		}
+		status.Allowed = true
+		return status
+	}
+	if s, ok := obj.(*api.Silence); ok {
+		if err := s.IsValid(); err != nil {
+			return hooks.StatusForbidden(err)
+		}
+		status.Allowed = true
+		return status
+	}
+	if t, ok := obj.(*api.AlertTemplate); ok {
+		if err := t.IsValid(); err != nil {
+			return hooks.StatusForbidden(err)
+		}
+		status.Allowed = true
+		return status
+	}
+	if s, ok := obj.(*api.OnCallSchedule); ok {
+		if err := s.IsValid(); err != nil {
+			return hooks.StatusForbidden(err)
+		}
+		status.Allowed = true
+		return status
+	}
+	if p, ok := obj.(*api.NotificationPolicy); ok {
+		if err := p.IsValid(); err != nil {
+			return hooks.StatusForbidden(err)
+		}
+		if ref := p.Spec.NotifierSecret; ref != nil {
+			if err := a.checkTemplates(ref.Namespace, ref.Name, nil); err != nil {
+				return hooks.StatusForbidden(err)
+			}
+		}
+		status.Allowed = true
+		return status
+	}
+	alert, ok := obj.(api.Alert)
+	if !ok {
+		// Alerts of other versions are validated as v1alpha1, converted via internal version
+		internal, err := scheme.ConvertToVersion(obj, monitoring.SchemeGroupVersion)
+		if err != nil {
+			return hooks.StatusBadRequest(err)
+		}
+		if obj, err = scheme.ConvertToVersion(internal, api.SchemeGroupVersion); err != nil {
+			return hooks.StatusBadRequest(err)
+		}
+		if alert, ok = obj.(api.Alert); !ok {
+			return hooks.StatusBadRequest(fmt.Errorf("unexpected object of type %T", obj))
+		}
+	}
+	if t, ok := alert.(api.TemplatedAlert); ok && t.GetTemplateRef() != nil {
+		// alert is validated together with its template, template must be created first
+		ref := t.GetTemplateRef()
+		tpl, err := a.extClient.MonitoringV1alpha1().AlertTemplates(req.Namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return hooks.StatusForbidden(fmt.Errorf("failed to get AlertTemplate %s. Reason: %v", ref.Name, err))
+		}
+		t.SetTemplateDefaults(tpl)
+	}
 	err = alert.IsValid(a.client)
 	if err != nil {
 		return hooks.StatusForbidden(err)
 	}
+	secretNames := sets.NewString()
+	if alert.GetNotifierSecretName() != "" {
+		secretNames.Insert(alert.GetNotifierSecretName())
+	}
+	for _, r := range alert.GetReceivers() {
+		if r.SecretRef != nil {
+			secretNames.Insert(r.SecretRef.Name)
+		}
+	}
+	for _, name := range secretNames.List() {
+		if err := a.checkTemplates(alert.GetNamespace(), name, alert); err != nil {
+			return hooks.StatusForbidden(err)
+		}
+	}
 
 	status.Allowed = true
 	return status
 }
+
+// checkTemplates validates the notification templates referred by the notifier Secret namespace/name.
+func (a *CRDValidator) checkTemplates(namespace, secretName string, alert api.Alert) error {
+	secret, err := a.client.CoreV1().Secrets(namespace).Get(secretName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	name := string(secret.Data[notifier.NotificationTemplatesKey])
+	if name == "" {
+		return nil
+	}
+	cm, err := a.client.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get ConfigMap %s of notification templates. Reason: %v", name, err)
+	}
+	return notifier.ValidateTemplates(cm.Data, alert)
+}
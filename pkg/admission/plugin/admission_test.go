@@ -0,0 +1,52 @@
+package plugin
+
+import (
+	"encoding/json"
+	"testing"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	admission "k8s.io/api/admission/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestAdmitPodAlertNamespaceSelector(t *testing.T) {
+	api.PodCommands.Insert("pod-exec", api.IcingaCommand{Name: "pod-exec", States: []string{"OK", "Critical", "Unknown"}})
+	defer api.PodCommands.Delete("pod-exec")
+	api.OperatorNamespace = "kube-system"
+	defer func() { api.OperatorNamespace = "" }()
+
+	a := &CRDValidator{client: kfake.NewSimpleClientset(), extClient: fake.NewSimpleClientset(), initialized: true}
+	admit := func(namespace string) *admission.AdmissionResponse {
+		alert := &api.PodAlert{
+			TypeMeta:   metav1.TypeMeta{APIVersion: api.SchemeGroupVersion.String(), Kind: api.ResourceKindPodAlert},
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-exec"},
+			Spec: api.PodAlertSpec{
+				Selector:          &metav1.LabelSelector{},
+				NamespaceSelector: &metav1.LabelSelector{},
+				Check:             "pod-exec",
+				Vars:              map[string]string{"argv": "ps"},
+			},
+		}
+		raw, err := json.Marshal(alert)
+		assert.Nil(t, err)
+		return a.Admit(&admission.AdmissionRequest{
+			Kind:      metav1.GroupVersionKind{Group: api.SchemeGroupVersion.Group, Version: api.SchemeGroupVersion.Version, Kind: api.ResourceKindPodAlert},
+			Namespace: namespace,
+			Operation: admission.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	// PodAlerts of tenants can't run checks in other namespaces
+	resp := admit("demo")
+	assert.False(t, resp.Allowed)
+	if assert.NotNil(t, resp.Result) {
+		assert.Contains(t, resp.Result.Message, "namespace selector is only allowed")
+	}
+
+	assert.True(t, admit("kube-system").Allowed)
+}
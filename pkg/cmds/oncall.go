@@ -0,0 +1,65 @@
+package cmds
+
+import (
+	"fmt"
+	"time"
+
+	cs "github.com/appscode/searchlight/client/clientset/versioned"
+	"github.com/appscode/searchlight/plugins"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/tools/clientcmd"
+)
+
+func NewCmdOnCall() *cobra.Command {
+	var (
+		kubeconfigPath string
+		contextName    string
+		namespace      = metav1.NamespaceDefault
+		at             string
+	)
+	cmd := &cobra.Command{
+		Use:               "oncall [schedule]",
+		Short:             "Print contacts on call in an OnCallSchedule",
+		Example:           "searchlight oncall payments --namespace demo --time 2019-01-07T09:00:00Z",
+		Args:              cobra.ExactArgs(1),
+		DisableAutoGenTag: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t := time.Now()
+			if at != "" {
+				var err error
+				if t, err = time.Parse(time.RFC3339, at); err != nil {
+					return fmt.Errorf("invalid time %s. Reason: %v", at, err)
+				}
+			}
+
+			config, err := clientcmd.BuildConfigFromContext(kubeconfigPath, contextName)
+			if err != nil {
+				return err
+			}
+			extClient, err := cs.NewForConfig(config)
+			if err != nil {
+				return err
+			}
+			schedule, err := extClient.MonitoringV1alpha1().OnCallSchedules(namespace).Get(args[0], metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			contacts, err := schedule.OnCall(t)
+			if err != nil {
+				return err
+			}
+			for _, c := range contacts {
+				fmt.Println(c)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeconfigPath, plugins.FlagKubeConfig, kubeconfigPath, "Path to kubeconfig file with authorization information (the master location is set by the master flag).")
+	cmd.Flags().StringVar(&contextName, plugins.FlagKubeConfigContext, contextName, "Use the context in kubeconfig")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", namespace, "Namespace of the OnCallSchedule")
+	cmd.Flags().StringVar(&at, "time", at, "Time in RFC3339 format, such as 2019-01-07T09:00:00Z. Default is now.")
+
+	return cmd
+}
@@ -0,0 +1,197 @@
+package cmds
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	incidentapi "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	cs "github.com/appscode/searchlight/client/clientset/versioned"
+	"github.com/appscode/searchlight/plugins"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"kmodules.xyz/client-go/tools/clientcmd"
+)
+
+// Formats of reports
+const (
+	reportFormatJSON     = "json"
+	reportFormatCSV      = "csv"
+	reportFormatMarkdown = "markdown"
+)
+
+func NewCmdReport() *cobra.Command {
+	var (
+		kubeconfigPath string
+		contextName    string
+		namespace      = metav1.NamespaceDefault
+		allNamespaces  bool
+		from           string
+		to             string
+		period         = 30 * 24 * time.Hour
+		alerts         []string
+		output         = reportFormatMarkdown
+	)
+	cmd := &cobra.Command{
+		Use:               "report",
+		Short:             "Print availability, number of incidents, MTTA and MTTR of alerts",
+		Long:              "Print availability, number of incidents, mean time to acknowledge (MTTA) and mean time to recover (MTTR) of alerts over a period, computed from their Incidents. Periods starting more than --incident-ttl of Searchlight operator ago are refused, since older Incidents are garbage collected",
+		Example:           "searchlight report --namespace demo --from 2019-01-01T00:00:00Z --to 2019-02-01T00:00:00Z --output csv",
+		Args:              cobra.NoArgs,
+		DisableAutoGenTag: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch output {
+			case reportFormatJSON, reportFormatCSV, reportFormatMarkdown:
+			default:
+				return fmt.Errorf("output format %s is unsupported", output)
+			}
+			end := time.Now()
+			if to != "" {
+				var err error
+				if end, err = time.Parse(time.RFC3339, to); err != nil {
+					return fmt.Errorf("invalid time %s. Reason: %v", to, err)
+				}
+			}
+			start := end.Add(-period)
+			if from != "" {
+				var err error
+				if start, err = time.Parse(time.RFC3339, from); err != nil {
+					return fmt.Errorf("invalid time %s. Reason: %v", from, err)
+				}
+			}
+
+			config, err := clientcmd.BuildConfigFromContext(kubeconfigPath, contextName)
+			if err != nil {
+				return err
+			}
+			extClient, err := cs.NewForConfig(config)
+			if err != nil {
+				return err
+			}
+			namespaces := []string{namespace}
+			if allNamespaces {
+				kubeClient, err := kubernetes.NewForConfig(config)
+				if err != nil {
+					return err
+				}
+				list, err := kubeClient.CoreV1().Namespaces().List(metav1.ListOptions{})
+				if err != nil {
+					return err
+				}
+				namespaces = namespaces[:0]
+				for _, ns := range list.Items {
+					namespaces = append(namespaces, ns.Name)
+				}
+			}
+
+			reports := make([]incidentapi.ReportResponse, 0, len(namespaces))
+			for _, ns := range namespaces {
+				report, err := extClient.IncidentsV1alpha1().Reports(ns).Create(&incidentapi.Report{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "report",
+						Namespace: ns,
+					},
+					Request: incidentapi.ReportRequest{
+						From:   metav1.NewTime(start),
+						To:     &metav1.Time{Time: end},
+						Alerts: alerts,
+					},
+				})
+				if err != nil {
+					return fmt.Errorf("failed to compute report of namespace %s. Reason: %v", ns, err)
+				}
+				reports = append(reports, report.Response)
+			}
+
+			switch output {
+			case reportFormatJSON:
+				return writeReportJSON(os.Stdout, reports)
+			case reportFormatCSV:
+				return writeReportCSV(os.Stdout, reports)
+			default:
+				return writeReportMarkdown(os.Stdout, reports)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&kubeconfigPath, plugins.FlagKubeConfig, kubeconfigPath, "Path to kubeconfig file with authorization information (the master location is set by the master flag).")
+	cmd.Flags().StringVar(&contextName, plugins.FlagKubeConfigContext, contextName, "Use the context in kubeconfig")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", namespace, "Namespace of alerts")
+	cmd.Flags().BoolVar(&allNamespaces, "all-namespaces", allNamespaces, "If true, reports alerts of all namespaces")
+	cmd.Flags().StringVar(&from, "from", from, "Start of the period in RFC3339 format, such as 2019-01-01T00:00:00Z. Default is --period before the end.")
+	cmd.Flags().StringVar(&to, "to", to, "End of the period in RFC3339 format, such as 2019-02-01T00:00:00Z. Default is now.")
+	cmd.Flags().DurationVar(&period, "period", period, "Duration of the period, used if --from is not set")
+	cmd.Flags().StringSliceVar(&alerts, "alert", alerts, "Names of alerts to report. All alerts are reported, if not set.")
+	cmd.Flags().StringVarP(&output, "output", "o", output, "Output format. One of: json, csv, markdown")
+
+	return cmd
+}
+
+func writeReportJSON(w io.Writer, reports []incidentapi.ReportResponse) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(reports)
+}
+
+// writeReportCSV writes a row for each alert, followed by a row for all alerts of its namespace with
+// empty kind and name.
+func writeReportCSV(w io.Writer, reports []incidentapi.ReportResponse) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"from", "to", "namespace", "kind", "alert", "incidents", "acknowledged", "recovered", "downtime", "availability", "mtta", "mttr"})
+	for _, report := range reports {
+		for _, r := range append(report.Alerts, report.Summary) {
+			cw.Write([]string{
+				report.From.UTC().Format(time.RFC3339),
+				report.To.UTC().Format(time.RFC3339),
+				r.Namespace,
+				r.Kind,
+				r.Name,
+				strconv.Itoa(int(r.Incidents)),
+				strconv.Itoa(int(r.Acknowledged)),
+				strconv.Itoa(int(r.Recovered)),
+				r.Downtime.Duration.String(),
+				strconv.FormatFloat(r.Availability, 'f', 3, 64),
+				formatReportDuration(r.MeanTimeToAcknowledge, ""),
+				formatReportDuration(r.MeanTimeToRecover, ""),
+			})
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeReportMarkdown writes a table for each namespace.
+func writeReportMarkdown(w io.Writer, reports []incidentapi.ReportResponse) error {
+	for i, report := range reports {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "### Namespace %s\n\n", report.Summary.Namespace)
+		fmt.Fprintf(w, "From %s to %s\n\n", report.From.UTC().Format(time.RFC3339), report.To.UTC().Format(time.RFC3339))
+		fmt.Fprintln(w, "| Kind | Alert | Incidents | Acknowledged | Recovered | Downtime | Availability | MTTA | MTTR |")
+		fmt.Fprintln(w, "|------|-------|----------:|-------------:|----------:|---------:|-------------:|-----:|-----:|")
+		for _, r := range append(report.Alerts, report.Summary) {
+			kind, name := r.Kind, r.Name
+			if kind == "" && name == "" {
+				kind, name = "**Total**", ""
+			}
+			fmt.Fprintf(w, "| %s | %s | %d | %d | %d | %s | %.3f%% | %s | %s |\n",
+				kind, strings.Replace(name, "|", `\|`, -1), r.Incidents, r.Acknowledged, r.Recovered, r.Downtime.Duration,
+				r.Availability, formatReportDuration(r.MeanTimeToAcknowledge, "-"), formatReportDuration(r.MeanTimeToRecover, "-"))
+		}
+	}
+	return nil
+}
+
+func formatReportDuration(d *metav1.Duration, none string) string {
+	if d == nil {
+		return none
+	}
+	return d.Duration.String()
+}
@@ -38,6 +38,8 @@ func NewCmdSearchlight() *cobra.Command {
 	stopCh := genericapiserver.SetupSignalHandler()
 	rootCmd.AddCommand(NewCmdRun(os.Stdout, os.Stderr, stopCh))
 	rootCmd.AddCommand(NewCmdConfigure())
+	rootCmd.AddCommand(NewCmdOnCall())
+	rootCmd.AddCommand(NewCmdReport())
 	rootCmd.AddCommand(v.NewCmdVersion())
 
 	return rootCmd
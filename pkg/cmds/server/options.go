@@ -2,6 +2,7 @@ package server
 
 import (
 	"flag"
+	"strings"
 	"time"
 
 	"github.com/appscode/go/log"
@@ -10,6 +11,8 @@ import (
 	"github.com/appscode/searchlight/pkg/admission/plugin"
 	"github.com/appscode/searchlight/pkg/icinga"
 	"github.com/appscode/searchlight/pkg/operator"
+	"github.com/appscode/searchlight/pkg/registry/acknowledgement"
+	"github.com/appscode/searchlight/plugins/notifier"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
@@ -26,19 +29,45 @@ type OperatorOptions struct {
 	MaxNumRequeues   int
 	NumThreads       int
 	IncidentTTL      time.Duration
+	// Age after which open incidents are garbage collected, kept if 0
+	OpenIncidentTTL time.Duration
+	// Directory that incidents are archived in before they are deleted
+	IncidentArchiveDir string
+	// Name of ConfigMap in the namespace of operator that incidents are archived in before they are deleted
+	IncidentArchiveConfigMap string
+	// Comma separated targets, node or workload, that incidents of pods are correlated by
+	CorrelateIncidentsBy string
+	// Duration after the problem of an open incident, within which incidents are correlated under it
+	IncidentCorrelationWindow time.Duration
+	// Period of retrying notifications that notifier failed to send
+	NotificationRetryPeriod time.Duration
+	// Number of attempts to send a notification, before it is given up
+	MaxNotificationAttempts int
+	// Period of sending notifications whose groupWait has expired
+	NotificationFlushPeriod time.Duration
+	// Name of notifier Secret in the namespace of operator, used by receivers without any notifier Secret
+	NotifierSecretName string
+	// Public address of Searchlight server, used in acknowledge links of notifications
+	AcknowledgeURL string
+	// Duration for which acknowledge links are valid
+	AcknowledgeLinkTTL time.Duration
 	// V logging level, the value of the -v flag
 	verbosity string
 }
 
 func NewOperatorOptions() *OperatorOptions {
 	return &OperatorOptions{
-		ConfigRoot:       "/srv",
-		ConfigSecretName: "searchlight-operator",
-		ResyncPeriod:     5 * time.Minute,
-		MaxNumRequeues:   5,
-		NumThreads:       1,
-		IncidentTTL:      90 * 24 * time.Hour,
-		verbosity:        "3",
+		ConfigRoot:              "/srv",
+		ConfigSecretName:        "searchlight-operator",
+		ResyncPeriod:            5 * time.Minute,
+		MaxNumRequeues:          5,
+		NumThreads:              1,
+		IncidentTTL:             90 * 24 * time.Hour,
+		NotificationRetryPeriod: 30 * time.Second,
+		MaxNotificationAttempts: 10,
+		NotificationFlushPeriod: 30 * time.Second,
+		AcknowledgeLinkTTL:      24 * time.Hour,
+		verbosity:               "3",
 	}
 }
 
@@ -46,9 +75,21 @@ func (s *OperatorOptions) AddGoFlags(fs *flag.FlagSet) {
 	fs.StringVar(&s.ConfigRoot, "config-dir", s.ConfigRoot, "Path to directory containing icinga2 config. This should be an emptyDir inside Kubernetes.")
 	fs.StringVar(&s.ConfigSecretName, "config-secret-name", s.ConfigSecretName, "Name of Kubernetes secret used to pass icinga credentials.")
 	fs.DurationVar(&s.ResyncPeriod, "resync-period", s.ResyncPeriod, "If non-zero, will re-list this often. Otherwise, re-list will be delayed aslong as possible (until the upstream source closes the watch or times out.")
-	fs.DurationVar(&s.IncidentTTL, "incident-ttl", s.IncidentTTL, "Garbage collects incidents older than this duration. Set to 0 to disable garbage collection.")
+	fs.DurationVar(&s.IncidentTTL, "incident-ttl", s.IncidentTTL, "Garbage collects recovered or resolved incidents older than this duration. Set to 0 to disable garbage collection.")
+	fs.DurationVar(&s.OpenIncidentTTL, "open-incident-ttl", s.OpenIncidentTTL, "Garbage collects open incidents older than this duration. Open incidents are kept, if 0.")
+	fs.StringVar(&s.IncidentArchiveDir, "incident-archive-dir", s.IncidentArchiveDir, "Directory, such as a mounted PersistentVolumeClaim, that incidents are archived in as JSON lines before they are deleted.")
+	fs.StringVar(&s.IncidentArchiveConfigMap, "incident-archive-configmap", s.IncidentArchiveConfigMap, "Name of ConfigMap in the namespace of operator that incidents are archived in as JSON lines before they are deleted.")
+	fs.StringVar(&s.CorrelateIncidentsBy, "correlate-incidents-by", s.CorrelateIncidentsBy, "Comma separated list of targets, node or workload, whose incidents are parents of the incidents of their pods.")
+	fs.DurationVar(&s.IncidentCorrelationWindow, "incident-correlation-window", s.IncidentCorrelationWindow, "Incidents whose problems start within this duration after the problem of an open incident are correlated under it. Set to 0 to disable.")
+	fs.DurationVar(&s.NotificationRetryPeriod, "notification-retry-period", s.NotificationRetryPeriod, "Retries failed notifications this often. Set to 0 to disable retries.")
+	fs.IntVar(&s.MaxNotificationAttempts, "max-notification-attempts", s.MaxNotificationAttempts, "Number of attempts to send a notification, before it is marked as failed.")
+	fs.DurationVar(&s.NotificationFlushPeriod, "notification-flush-period", s.NotificationFlushPeriod, "Sends notifications whose groupWait or escalateAfter has expired this often. Set to 0 to disable.")
+	fs.StringVar(&s.NotifierSecretName, "notifier-secret-name", s.NotifierSecretName, "Name of notifier Secret in the namespace of operator, used by receivers without any notifier Secret.")
+	fs.StringVar(&s.AcknowledgeURL, "acknowledge-url", s.AcknowledgeURL, "Public address of Searchlight server, such as https://searchlight.example.com, used in acknowledge links of notifications. Links are not sent, if empty.")
+	fs.DurationVar(&s.AcknowledgeLinkTTL, "acknowledge-link-ttl", s.AcknowledgeLinkTTL, "Duration for which acknowledge links of notifications are valid.")
 
 	fs.BoolVar(&api.EnableStatusSubresource, "enable-status-subresource", api.EnableStatusSubresource, "If true, uses sub resource for Voyager crds.")
+	fs.BoolVar(&api.EnableConversionWebhook, "enable-conversion-webhook", api.EnableConversionWebhook, "If true, serves alert crds in both v1alpha1 and v1beta1 using conversion webhook. Requires Kubernetes 1.15+.")
 }
 
 func (s *OperatorOptions) AddFlags(fs *pflag.FlagSet) {
@@ -60,13 +101,39 @@ func (s *OperatorOptions) AddFlags(fs *pflag.FlagSet) {
 func (s *OperatorOptions) ApplyTo(cfg *operator.OperatorConfig) error {
 	var err error
 
+	if s.IncidentArchiveDir != "" && s.IncidentArchiveConfigMap != "" {
+		return errors.New("only one of --incident-archive-dir and --incident-archive-configmap can be set")
+	}
+
 	cfg.ConfigRoot = s.ConfigRoot
 	cfg.ConfigSecretName = s.ConfigSecretName
 	cfg.ResyncPeriod = s.ResyncPeriod
 	cfg.MaxNumRequeues = s.MaxNumRequeues
 	cfg.NumThreads = s.NumThreads
 	cfg.IncidentTTL = s.IncidentTTL
+	cfg.OpenIncidentTTL = s.OpenIncidentTTL
+	cfg.IncidentArchiveDir = s.IncidentArchiveDir
+	cfg.IncidentArchiveConfigMap = s.IncidentArchiveConfigMap
+	cfg.IncidentCorrelation = notifier.Correlation{Window: s.IncidentCorrelationWindow}
+	for _, by := range strings.Split(s.CorrelateIncidentsBy, ",") {
+		switch strings.TrimSpace(by) {
+		case "":
+		case "node":
+			cfg.IncidentCorrelation.ByNode = true
+		case "workload":
+			cfg.IncidentCorrelation.ByWorkload = true
+		default:
+			return errors.Errorf("incidents can't be correlated by %s", by)
+		}
+	}
+	cfg.NotificationRetryPeriod = s.NotificationRetryPeriod
+	cfg.MaxNotificationAttempts = s.MaxNotificationAttempts
+	cfg.NotificationFlushPeriod = s.NotificationFlushPeriod
 	cfg.Verbosity = s.verbosity
+	api.OperatorNamespace = meta.Namespace()
+	if s.NotifierSecretName != "" {
+		api.DefaultNotifierSecret = &api.NotifierSecretReference{Namespace: meta.Namespace(), Name: s.NotifierSecretName}
+	}
 
 	if cfg.KubeClient, err = kubernetes.NewForConfig(cfg.ClientConfig); err != nil {
 		return err
@@ -83,6 +150,15 @@ func (s *OperatorOptions) ApplyTo(cfg *operator.OperatorConfig) error {
 	if err != nil {
 		return errors.Wrapf(err, "failed to load secret: %s", s.ConfigSecretName)
 	}
+	if s.AcknowledgeURL != "" {
+		key, found := secret.Data[acknowledgement.LinkSigningKey]
+		if !found {
+			return errors.Errorf("secret %s is missing key %s to sign acknowledge links", s.ConfigSecretName, acknowledgement.LinkSigningKey)
+		}
+		if cfg.AcknowledgeLinks, err = acknowledgement.NewLinks(s.AcknowledgeURL, key, s.AcknowledgeLinkTTL); err != nil {
+			return err
+		}
+	}
 
 	mgr := &icinga.Configurator{
 		ConfigRoot:       s.ConfigRoot,
@@ -8,6 +8,7 @@ import (
 	"github.com/appscode/go/log/golog"
 	incidentsv1alpha1 "github.com/appscode/searchlight/apis/incidents/v1alpha1"
 	"github.com/appscode/searchlight/pkg/operator"
+	"github.com/appscode/searchlight/pkg/registry/acknowledgement"
 	"github.com/appscode/searchlight/pkg/server"
 	_ "github.com/go-openapi/loads"
 	"github.com/spf13/cobra"
@@ -45,6 +46,8 @@ func NewSearchlightOptions(out, errOut io.Writer) *SearchlightOptions {
 	}
 	o.RecommendedOptions.Etcd = nil
 	o.RecommendedOptions.Admission = nil
+	// acknowledge links are authorized by their signature
+	o.RecommendedOptions.Authorization.WithAlwaysAllowPaths(acknowledgement.LinkPath)
 
 	return o
 }
@@ -82,6 +85,8 @@ func (o SearchlightOptions) Config() (*server.SearchlightConfig, error) {
 		"/swaggerapi",
 		"/apis/admission.monitoring.appscode.com/v1alpha1",
 		"/apis/admission.monitoring.appscode.com/v1alpha1/admissionreviews",
+		"/apis/conversion.monitoring.appscode.com/v1alpha1",
+		"/apis/conversion.monitoring.appscode.com/v1alpha1/conversionreviews",
 	}
 	serverConfig.EnableMetrics = true
 
@@ -27,6 +27,9 @@ const (
 	EventReasonSync           = "Sync"
 	EventReasonFailedToSync   = "FailedToSync"
 	EventReasonSuccessfulSync = "SuccessfulSync"
+
+	// Notification delivery event list
+	EventReasonFailedToNotify = "FailedToNotify"
 )
 
 func NewEventRecorder(client kubernetes.Interface, component string) record.EventRecorder {
@@ -0,0 +1,119 @@
+package icinga
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Icinga entry type of the comments added for acknowledgements
+const commentEntryTypeAcknowledgement = 4
+
+// Acknowledgement is the acknowledgement of the problem of an Icinga service.
+type Acknowledgement struct {
+	Host    string
+	Service string
+	// True for sticky acknowledgements, that are kept until the problem recovers
+	Sticky bool
+	// Zero, if acknowledgement does not expire
+	Expiry    time.Time
+	Author    string
+	Comment   string
+	Timestamp time.Time
+}
+
+type acknowledgedServices struct {
+	Results []struct {
+		Attrs struct {
+			Name                  string  `json:"name"`
+			HostName              string  `json:"host_name"`
+			Acknowledgement       float64 `json:"acknowledgement"`
+			AcknowledgementExpiry float64 `json:"acknowledgement_expiry"`
+		} `json:"attrs"`
+	} `json:"results"`
+}
+
+type acknowledgementComments struct {
+	Results []struct {
+		Attrs struct {
+			HostName    string  `json:"host_name"`
+			ServiceName string  `json:"service_name"`
+			Author      string  `json:"author"`
+			Text        string  `json:"text"`
+			EntryTime   float64 `json:"entry_time"`
+		} `json:"attrs"`
+	} `json:"results"`
+}
+
+// AcknowledgementKey returns the key of the acknowledgement of service of host in the result of GetAcknowledgements.
+func AcknowledgementKey(host, service string) string {
+	return host + "!" + service
+}
+
+// GetAcknowledgements returns the acknowledged problems of Icinga services, keyed by AcknowledgementKey.
+// Only the acknowledgement of service of host is returned, if they are not empty. Author and comment of
+// acknowledgements are read from their Icinga comments.
+func (c *Client) GetAcknowledgements(host, service string) (map[string]Acknowledgement, error) {
+	serviceFilter := "service.acknowledgement != 0"
+	commentFilter := fmt.Sprintf("comment.entry_type == %d", commentEntryTypeAcknowledgement)
+	if host != "" && service != "" {
+		serviceFilter += fmt.Sprintf(` && service.name == "%s" && host.name == "%s"`, service, host)
+		commentFilter += fmt.Sprintf(` && comment.service_name == "%s" && comment.host_name == "%s"`, service, host)
+	}
+
+	in, err := json.Marshal(map[string]interface{}{
+		"filter": serviceFilter,
+		"attrs":  []string{"name", "host_name", "acknowledgement", "acknowledgement_expiry"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var services acknowledgedServices
+	if _, err := c.Service("").Get([]string{}, string(in)).Do().Into(&services); err != nil {
+		return nil, errors.Wrap(err, "can't get acknowledged icinga services")
+	}
+	acks := make(map[string]Acknowledgement, len(services.Results))
+	for _, r := range services.Results {
+		ack := Acknowledgement{
+			Host:    r.Attrs.HostName,
+			Service: r.Attrs.Name,
+			// 1 for normal and 2 for sticky acknowledgements
+			Sticky: r.Attrs.Acknowledgement == 2,
+		}
+		if r.Attrs.AcknowledgementExpiry > 0 {
+			ack.Expiry = time.Unix(int64(r.Attrs.AcknowledgementExpiry), 0)
+		}
+		acks[AcknowledgementKey(ack.Host, ack.Service)] = ack
+	}
+	if len(acks) == 0 {
+		return acks, nil
+	}
+
+	in, err = json.Marshal(map[string]interface{}{
+		"filter": commentFilter,
+		"attrs":  []string{"host_name", "service_name", "author", "text", "entry_time"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var comments acknowledgementComments
+	if _, err := c.Comments("").Get([]string{}, string(in)).Do().Into(&comments); err != nil {
+		return nil, errors.Wrap(err, "can't get icinga comments of acknowledgements")
+	}
+	for _, r := range comments.Results {
+		key := AcknowledgementKey(r.Attrs.HostName, r.Attrs.ServiceName)
+		ack, found := acks[key]
+		entryTime := time.Unix(int64(r.Attrs.EntryTime), 0)
+		// problems acknowledged again have a comment for each acknowledgement
+		if !found || entryTime.Before(ack.Timestamp) {
+			continue
+		}
+		ack.Author = r.Attrs.Author
+		ack.Comment = r.Attrs.Text
+		ack.Timestamp = entryTime
+		acks[key] = ack
+	}
+	return acks, nil
+}
@@ -69,6 +69,14 @@ func (c *Client) Notifications(hostName string) *APIRequest {
 	return c.newRequest("/objects/notifications/" + hostName)
 }
 
+func (c *Client) Comments(name string) *APIRequest {
+	return c.newRequest("/objects/comments/" + name)
+}
+
+func (c *Client) Dependencies(hostName string) *APIRequest {
+	return c.newRequest("/objects/dependencies/" + hostName)
+}
+
 func (c *Client) Actions(action string) *APIRequest {
 	return c.newRequest("/actions/" + action)
 }
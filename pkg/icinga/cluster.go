@@ -51,6 +51,7 @@ func (h *ClusterHost) Apply(alert *api.ClusterAlert) error {
 	if alertSpec.CheckInterval.Seconds() > 0 {
 		attrs["check_interval"] = alertSpec.CheckInterval.Seconds()
 	}
+	setCheckAttempts(attrs, alertSpec.MaxCheckAttempts, alertSpec.RetryInterval, alertSpec.EnableFlapping, alertSpec.FlappingThresholdLow, alertSpec.FlappingThresholdHigh)
 	cmd, _ := api.ClusterCommands.Get(alertSpec.Check)
 	commandVars := cmd.Vars.Fields
 	for key, val := range alertSpec.Vars {
@@ -70,7 +71,11 @@ func (h *ClusterHost) Apply(alert *api.ClusterAlert) error {
 		}
 	}
 
-	return h.reconcileIcingaNotification(alert, kh)
+	return h.reconcileIcingaNotification(alert, kh, alertSpec.NotificationDelay.Duration)
+}
+
+func (h *ClusterHost) GetStatus(alert *api.ClusterAlert) (*api.TargetStatus, error) {
+	return h.getIcingaServiceStatus(alert.Name, h.getHost(alert.Namespace))
 }
 
 func (h *ClusterHost) Delete(namespace, name string) error {
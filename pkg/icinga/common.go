@@ -4,9 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type commonHost struct {
@@ -98,10 +100,11 @@ func (h *commonHost) ForceDeleteIcingaHost(kh IcingaHost) error {
 	if resp.Err != nil {
 		return errors.Wrap(resp.Err, "Failed to delete IcingaHost")
 	}
-	if resp.Status == 200 {
+	// Icinga responds with 404, when filter matches no host
+	if resp.Status == 200 || resp.Status == 404 {
 		return nil
 	}
-	return errors.New("can't delete Icinga host")
+	return errors.Errorf("can't delete Icinga host. Status: %d", resp.Status)
 }
 
 // createIcingaServiceForCluster
@@ -199,6 +202,47 @@ func (h *commonHost) checkIcingaService(svc string, kh IcingaHost) (bool, error)
 	return len(respService.Results) > 0, nil
 }
 
+// getIcingaServiceStatus returns the state and output of the last executed check of an Icinga service.
+// State is left empty if the service does not exist or has not been checked yet.
+func (h *commonHost) getIcingaServiceStatus(svc string, kh IcingaHost) (*api.TargetStatus, error) {
+	in := h.IcingaServiceSearchQuery(svc, kh)
+	var respService ResponseObject
+
+	if _, err := h.IcingaClient.Service("").Get([]string{}, in).Do().Into(&respService); err != nil {
+		return nil, errors.Wrap(err, "can't get icinga service")
+	}
+
+	status := &api.TargetStatus{
+		Name: kh.ObjectName,
+	}
+	if len(respService.Results) == 0 {
+		return status, nil
+	}
+	attrs := respService.Results[0].Attrs
+	if attrs.LastCheckResult == nil {
+		return status, nil
+	}
+	status.State = stateName(State(attrs.State))
+	status.LastCheckOutput = strings.TrimSpace(attrs.LastCheckResult.Output)
+	if attrs.LastCheck > 0 {
+		t := metav1.NewTime(time.Unix(int64(attrs.LastCheck), 0))
+		status.LastCheckTime = &t
+	}
+	return status, nil
+}
+
+func stateName(s State) string {
+	switch s {
+	case OK:
+		return "OK"
+	case Warning:
+		return "Warning"
+	case Critical:
+		return "Critical"
+	}
+	return "Unknown"
+}
+
 func (h *commonHost) IcingaServiceSearchQuery(svc string, kids ...IcingaHost) string {
 	matchHost := ""
 	for i, kh := range kids {
@@ -212,12 +256,36 @@ func (h *commonHost) IcingaServiceSearchQuery(svc string, kids ...IcingaHost) st
 	return fmt.Sprintf(`{"filter": "(%s)&&match(\"%s\",service.name)"}`, matchHost, svc)
 }
 
-func (h *commonHost) reconcileIcingaNotification(alert api.Alert, kh IcingaHost) error {
+// setCheckAttempts sets the attributes of Icinga Service that control soft states and flap detection.
+// Unset values are left to generic-service template, except enable_flapping which is always written
+// so that flap detection is turned off again once it is disabled in the alert.
+func setCheckAttempts(attrs map[string]interface{}, maxCheckAttempts int32, retryInterval metav1.Duration, enableFlapping bool, thresholdLow, thresholdHigh int32) {
+	if maxCheckAttempts > 0 {
+		attrs["max_check_attempts"] = maxCheckAttempts
+	}
+	if retryInterval.Seconds() > 0 {
+		attrs["retry_interval"] = retryInterval.Seconds()
+	}
+	attrs["enable_flapping"] = enableFlapping
+	if thresholdLow > 0 {
+		attrs["flapping_threshold_low"] = thresholdLow
+	}
+	if thresholdHigh > 0 {
+		attrs["flapping_threshold_high"] = thresholdHigh
+	}
+}
+
+// reconcileIcingaNotification creates or updates Icinga Notification of an alert. The first notification
+// of a problem is sent after delay.
+func (h *commonHost) reconcileIcingaNotification(alert api.Alert, kh IcingaHost, delay time.Duration) error {
 	obj := IcingaObject{
 		Templates: []string{"icinga2-notifier-template"},
 		Attrs: map[string]interface{}{
 			"interval": int(alert.GetAlertInterval().Seconds()),
 			"users":    []string{"searchlight_user"},
+			"times": map[string]interface{}{
+				"begin": int(delay.Seconds()),
+			},
 		},
 	}
 	jsonStr, err := json.Marshal(obj)
@@ -0,0 +1,212 @@
+package icinga
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeIcinga is an Icinga API that records the Service object sent to it.
+type fakeIcinga struct {
+	// serviceExists makes service queries return a result, so services are updated instead of created
+	serviceExists bool
+
+	method string
+	path   string
+	obj    IcingaObject
+}
+
+func (f *fakeIcinga) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/objects/services" && f.serviceExists:
+		w.Write([]byte(`{"results":[{"attrs":{"name":"existing"}}]}`))
+	case r.Method == http.MethodGet:
+		w.Write([]byte(`{"results":[]}`))
+	case strings.HasPrefix(r.URL.Path, "/objects/services/"):
+		f.method, f.path = r.Method, r.URL.Path
+		body, _ := ioutil.ReadAll(r.Body)
+		json.Unmarshal(body, &f.obj)
+		w.Write([]byte(`{}`))
+	default:
+		w.Write([]byte(`{}`))
+	}
+}
+
+func TestSetCheckAttempts(t *testing.T) {
+	cases := []struct {
+		name             string
+		maxCheckAttempts int32
+		retryInterval    time.Duration
+		enableFlapping   bool
+		thresholdLow     int32
+		thresholdHigh    int32
+		expected         map[string]interface{}
+	}{
+		{
+			name:     "unset",
+			expected: map[string]interface{}{"enable_flapping": false},
+		},
+		{
+			name:             "all set",
+			maxCheckAttempts: 3,
+			retryInterval:    30 * time.Second,
+			enableFlapping:   true,
+			thresholdLow:     20,
+			thresholdHigh:    30,
+			expected: map[string]interface{}{
+				"max_check_attempts":      int32(3),
+				"retry_interval":          float64(30),
+				"enable_flapping":         true,
+				"flapping_threshold_low":  int32(20),
+				"flapping_threshold_high": int32(30),
+			},
+		},
+		{
+			name:           "flapping with template thresholds",
+			enableFlapping: true,
+			expected:       map[string]interface{}{"enable_flapping": true},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			attrs := map[string]interface{}{}
+			setCheckAttempts(attrs, c.maxCheckAttempts, metav1.Duration{Duration: c.retryInterval}, c.enableFlapping, c.thresholdLow, c.thresholdHigh)
+			assert.Equal(t, c.expected, attrs)
+		})
+	}
+}
+
+func TestApplyServiceAttrs(t *testing.T) {
+	api.ClusterCommands.Insert("ca-cert", api.IcingaCommand{
+		Name: "ca-cert",
+		Vars: &api.PluginVars{Fields: map[string]api.PluginVarField{"warning": {}}},
+	})
+	defer api.ClusterCommands.Delete("ca-cert")
+
+	pod := &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "payments-0", Namespace: "demo"},
+		Status:     core.PodStatus{PodIP: "10.0.0.2"},
+	}
+	node := &core.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+		Status:     core.NodeStatus{Addresses: []core.NodeAddress{{Type: core.NodeInternalIP, Address: "10.0.1.1"}}},
+	}
+
+	cases := []struct {
+		name          string
+		serviceExists bool
+		apply         func(c *Client) error
+		method        string
+		path          string
+		expected      map[string]interface{}
+	}{
+		{
+			name: "pod alert with flapping created",
+			apply: func(c *Client) error {
+				return NewPodHost(c, "").Apply(&api.PodAlert{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-status", Namespace: "demo"},
+					Spec: api.PodAlertSpec{
+						Check:                 "pod-status",
+						CheckInterval:         metav1.Duration{Duration: time.Minute},
+						MaxCheckAttempts:      3,
+						RetryInterval:         metav1.Duration{Duration: 30 * time.Second},
+						EnableFlapping:        true,
+						FlappingThresholdLow:  20,
+						FlappingThresholdHigh: 30,
+						Vars:                  map[string]string{"container": "app"},
+					},
+				}, pod)
+			},
+			method: http.MethodPut,
+			path:   "/objects/services/demo@pod@payments-0!pod-status",
+			expected: map[string]interface{}{
+				"check_command":           "pod-status",
+				"check_interval":          float64(60),
+				"max_check_attempts":      float64(3),
+				"retry_interval":          float64(30),
+				"enable_flapping":         true,
+				"flapping_threshold_low":  float64(20),
+				"flapping_threshold_high": float64(30),
+				"vars.container":          "app",
+			},
+		},
+		{
+			name:          "pod alert with flapping disabled updated",
+			serviceExists: true,
+			apply: func(c *Client) error {
+				return NewPodHost(c, "").Apply(&api.PodAlert{
+					ObjectMeta: metav1.ObjectMeta{Name: "pod-status", Namespace: "demo"},
+					Spec:       api.PodAlertSpec{Check: "pod-status"},
+				}, pod)
+			},
+			method: http.MethodPost,
+			path:   "/objects/services/demo@pod@payments-0!pod-status",
+			expected: map[string]interface{}{
+				"enable_flapping": false,
+			},
+		},
+		{
+			name: "node alert created",
+			apply: func(c *Client) error {
+				return NewNodeHost(c, "").Apply(&api.NodeAlert{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-status", Namespace: "demo"},
+					Spec: api.NodeAlertSpec{
+						Check:            "node-status",
+						MaxCheckAttempts: 5,
+						RetryInterval:    metav1.Duration{Duration: 10 * time.Second},
+					},
+				}, node)
+			},
+			method: http.MethodPut,
+			path:   "/objects/services/demo@node@node-1!node-status",
+			expected: map[string]interface{}{
+				"check_command":      "node-status",
+				"max_check_attempts": float64(5),
+				"retry_interval":     float64(10),
+				"enable_flapping":    false,
+			},
+		},
+		{
+			name:          "cluster alert updated",
+			serviceExists: true,
+			apply: func(c *Client) error {
+				return NewClusterHost(c, "").Apply(&api.ClusterAlert{
+					ObjectMeta: metav1.ObjectMeta{Name: "ca-cert", Namespace: "demo"},
+					Spec: api.ClusterAlertSpec{
+						Check:          "ca-cert",
+						EnableFlapping: true,
+						Vars:           map[string]string{"warning": "240h", "unknown": "ignored"},
+					},
+				})
+			},
+			method: http.MethodPost,
+			path:   "/objects/services/demo@cluster!ca-cert",
+			expected: map[string]interface{}{
+				"enable_flapping": true,
+				"vars.warning":    "240h",
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			f := &fakeIcinga{serviceExists: c.serviceExists}
+			srv := httptest.NewServer(f)
+			defer srv.Close()
+
+			assert.NoError(t, c.apply(NewClient(Config{Endpoint: srv.URL})))
+			assert.Equal(t, c.method, f.method)
+			assert.Equal(t, c.path, f.path)
+			assert.Equal(t, []string{"generic-service"}, f.obj.Templates)
+			assert.Equal(t, c.expected, f.obj.Attrs)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package icinga
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/pkg/errors"
+)
+
+// IcingaDependency is the Icinga service of a parent alert.
+type IcingaDependency struct {
+	// Name of Icinga Dependency object. It is unique for a child service.
+	Name    string
+	Host    IcingaHost
+	Service string
+}
+
+type dependencyObject struct {
+	Results []struct {
+		Attrs struct {
+			Name              string `json:"name"`
+			ParentHostName    string `json:"parent_host_name"`
+			ParentServiceName string `json:"parent_service_name"`
+		} `json:"attrs"`
+	} `json:"results"`
+}
+
+// getParents returns Icinga services of parent alerts. NodeAlerts are looked up on the node of target.
+// Parents without Icinga service, such as a NodeAlert that is not applied to the node, are skipped.
+func (h *commonHost) getParents(alertNamespace string, deps []api.AlertDependency, nodeName string) ([]IcingaDependency, error) {
+	var parents []IcingaDependency
+	for _, dep := range deps {
+		parent := IcingaDependency{
+			Name:    strings.ToLower(dep.Kind) + "." + dep.Name,
+			Service: dep.Name,
+		}
+		switch dep.Kind {
+		case api.ResourceKindNodeAlert:
+			if nodeName == "" {
+				continue
+			}
+			parent.Host = IcingaHost{Type: TypeNode, AlertNamespace: alertNamespace, ObjectName: nodeName}
+		case api.ResourceKindClusterAlert:
+			parent.Host = IcingaHost{Type: TypeCluster, AlertNamespace: alertNamespace}
+		default:
+			continue
+		}
+
+		has, err := h.checkIcingaService(parent.Service, parent.Host)
+		if err != nil {
+			return nil, err
+		}
+		if has {
+			parents = append(parents, parent)
+		}
+	}
+	return parents, nil
+}
+
+// reconcileIcingaDependencies creates Icinga Dependency objects for parents of an Icinga service, so that
+// its notifications are disabled while a parent is Critical or Unknown. Dependencies whose parent has
+// changed, such as when pod is scheduled on another node, or is no longer declared are deleted.
+func (h *commonHost) reconcileIcingaDependencies(svc string, kh IcingaHost, parents []IcingaDependency) error {
+	host, err := kh.Name()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	in := fmt.Sprintf(`{"filter": "dependency.child_host_name==\"%s\"&&dependency.child_service_name==\"%s\""}`, host, svc)
+	var existing dependencyObject
+	if _, err := h.IcingaClient.Dependencies("").Get([]string{}, in).Do().Into(&existing); err != nil {
+		return errors.Wrap(err, "can't get Icinga dependencies")
+	}
+
+	desired := make(map[string]IcingaDependency, len(parents))
+	for _, p := range parents {
+		desired[p.Name] = p
+	}
+
+	for _, r := range existing.Results {
+		if p, found := desired[r.Attrs.Name]; found {
+			parentHost, _ := p.Host.Name()
+			if r.Attrs.ParentHostName == parentHost && r.Attrs.ParentServiceName == p.Service {
+				delete(desired, r.Attrs.Name)
+				continue
+			}
+		}
+		resp := h.IcingaClient.Dependencies(host).Delete([]string{svc, r.Attrs.Name}, "").Do()
+		if resp.Err != nil {
+			return errors.Wrap(resp.Err, "Failed to delete Icinga Dependency")
+		}
+		if resp.Status != 200 && resp.Status != 404 {
+			return errors.Errorf("can't delete Icinga dependency. Status: %d", resp.Status)
+		}
+	}
+
+	for _, p := range desired {
+		parentHost, err := p.Host.Name()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		obj := IcingaObject{
+			Attrs: map[string]interface{}{
+				"parent_host_name":      parentHost,
+				"parent_service_name":   p.Service,
+				"child_host_name":       host,
+				"child_service_name":    svc,
+				"disable_notifications": true,
+				"states":                []string{"OK", "Warning"},
+			},
+		}
+		jsonStr, err := json.Marshal(obj)
+		if err != nil {
+			return errors.Wrap(err, "Failed to Marshal IcingaObject")
+		}
+		resp := h.IcingaClient.Dependencies(host).Create([]string{svc, p.Name}, string(jsonStr)).Do()
+		if resp.Err != nil {
+			return errors.Wrap(resp.Err, "Failed to create Icinga Dependency")
+		}
+		if resp.Status != 200 && !strings.Contains(string(resp.ResponseBody), "already exists") {
+			return errors.Errorf("can't create Icinga dependency. Status: %d", resp.Status)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package icinga
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DowntimeAuthor returns the author of Icinga downtimes scheduled for a Downtime.
+// It is used to find those downtimes again, when they are removed.
+func DowntimeAuthor(namespace, name string) string {
+	return "searchlight/" + namespace + "/" + name
+}
+
+// ScheduleDowntime schedules a fixed downtime for the Icinga services of an alert on all of its hosts.
+// It is not an error, if the alert has no Icinga service yet.
+func (c *Client) ScheduleDowntime(hostType, alertNamespace, alertName, author, comment string, start, end time.Time) error {
+	mp := make(map[string]interface{})
+	mp["type"] = "Service"
+	mp["filter"] = fmt.Sprintf(`service.name == "%s" && match("%s@%s*", host.name)`, alertName, alertNamespace, hostType)
+	mp["start_time"] = start.Unix()
+	mp["end_time"] = end.Unix()
+	mp["fixed"] = true
+	mp["author"] = author
+	mp["comment"] = comment
+
+	return c.doAction("schedule-downtime", mp)
+}
+
+// RemoveDowntimes removes all Icinga downtimes scheduled by author.
+func (c *Client) RemoveDowntimes(author string) error {
+	mp := make(map[string]interface{})
+	mp["type"] = "Downtime"
+	mp["filter"] = fmt.Sprintf(`downtime.author == "%s"`, author)
+
+	return c.doAction("remove-downtime", mp)
+}
+
+// doAction runs an Icinga action. Icinga responds with 404, when filter matches no object.
+func (c *Client) doAction(action string, mp map[string]interface{}) error {
+	data, err := json.Marshal(mp)
+	if err != nil {
+		return err
+	}
+	resp := c.Actions(action).Update([]string{}, string(data)).Do()
+	if resp.Err != nil {
+		return errors.Wrapf(resp.Err, "failed to run Icinga action %s", action)
+	}
+	if resp.Status != http.StatusOK && resp.Status != http.StatusNotFound {
+		return errors.Errorf("failed to run Icinga action %s. Status: %d, Reason: %s", action, resp.Status, string(resp.ResponseBody))
+	}
+	return nil
+}
@@ -63,6 +63,7 @@ func (h *NodeHost) Apply(alert *api.NodeAlert, node *core.Node) error {
 	if alertSpec.CheckInterval.Seconds() > 0 {
 		attrs["check_interval"] = alertSpec.CheckInterval.Seconds()
 	}
+	setCheckAttempts(attrs, alertSpec.MaxCheckAttempts, alertSpec.RetryInterval, alertSpec.EnableFlapping, alertSpec.FlappingThresholdLow, alertSpec.FlappingThresholdHigh)
 	for key, val := range alertSpec.Vars {
 		attrs[IVar(key)] = val
 	}
@@ -78,7 +79,19 @@ func (h *NodeHost) Apply(alert *api.NodeAlert, node *core.Node) error {
 		}
 	}
 
-	return h.reconcileIcingaNotification(alert, kh)
+	parents, err := h.getParents(alert.Namespace, alertSpec.DependsOn, "")
+	if err != nil {
+		return err
+	}
+	if err := h.reconcileIcingaDependencies(alert.Name, kh, parents); err != nil {
+		return err
+	}
+
+	return h.reconcileIcingaNotification(alert, kh, alertSpec.NotificationDelay.Duration)
+}
+
+func (h *NodeHost) GetStatus(alert *api.NodeAlert, node *core.Node) (*api.TargetStatus, error) {
+	return h.getIcingaServiceStatus(alert.Name, h.getHost(alert.Namespace, node))
 }
 
 func (h *NodeHost) Delete(alertNamespace, alertName string, node *core.Node) error {
@@ -20,10 +20,11 @@ func NewPodHost(IcingaClient *Client, verbosity string) *PodHost {
 
 func (h *PodHost) getHost(namespace string, pod *core.Pod) IcingaHost {
 	return IcingaHost{
-		ObjectName:     pod.Name,
-		Type:           TypePod,
-		AlertNamespace: namespace,
-		IP:             pod.Status.PodIP,
+		ObjectName:      pod.Name,
+		ObjectNamespace: pod.Namespace,
+		Type:            TypePod,
+		AlertNamespace:  namespace,
+		IP:              pod.Status.PodIP,
 	}
 }
 
@@ -53,6 +54,7 @@ func (h *PodHost) Apply(alert *api.PodAlert, pod *core.Pod) error {
 	if alertSpec.CheckInterval.Seconds() > 0 {
 		attrs["check_interval"] = alertSpec.CheckInterval.Seconds()
 	}
+	setCheckAttempts(attrs, alertSpec.MaxCheckAttempts, alertSpec.RetryInterval, alertSpec.EnableFlapping, alertSpec.FlappingThresholdLow, alertSpec.FlappingThresholdHigh)
 
 	for key, val := range alertSpec.Vars {
 		attrs[IVar(key)] = val
@@ -69,7 +71,19 @@ func (h *PodHost) Apply(alert *api.PodAlert, pod *core.Pod) error {
 		}
 	}
 
-	return h.reconcileIcingaNotification(alert, kh)
+	parents, err := h.getParents(alert.Namespace, alertSpec.DependsOn, pod.Spec.NodeName)
+	if err != nil {
+		return err
+	}
+	if err := h.reconcileIcingaDependencies(alert.Name, kh, parents); err != nil {
+		return err
+	}
+
+	return h.reconcileIcingaNotification(alert, kh, alertSpec.NotificationDelay.Duration)
+}
+
+func (h *PodHost) GetStatus(alert *api.PodAlert, pod *core.Pod) (*api.TargetStatus, error) {
+	return h.getIcingaServiceStatus(alert.Name, h.getHost(alert.Namespace, pod))
 }
 
 func (h *PodHost) Delete(alertNamespace, alertName string, pod *core.Pod) error {
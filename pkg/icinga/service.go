@@ -0,0 +1,95 @@
+package icinga
+
+import (
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	core "k8s.io/api/core/v1"
+)
+
+type ServiceHost struct {
+	commonHost
+}
+
+func NewServiceHost(IcingaClient *Client, verbosity string) *ServiceHost {
+	return &ServiceHost{
+		commonHost: commonHost{
+			IcingaClient: IcingaClient,
+			verbosity:    verbosity,
+		},
+	}
+}
+
+// Headless services have no ClusterIP, so those are addressed by DNS name.
+func (h *ServiceHost) getHost(namespace string, svc *core.Service) IcingaHost {
+	ip := svc.Spec.ClusterIP
+	if ip == "" || ip == core.ClusterIPNone {
+		ip = svc.Name + "." + svc.Namespace + ".svc"
+	}
+	return IcingaHost{
+		ObjectName:     svc.Name,
+		Type:           TypeService,
+		AlertNamespace: namespace,
+		IP:             ip,
+	}
+}
+
+func (h *ServiceHost) Apply(alert *api.ServiceAlert, svc *core.Service) error {
+	alertSpec := alert.Spec
+	kh := h.getHost(alert.Namespace, svc)
+
+	if err := h.reconcileIcingaHost(kh); err != nil {
+		return err
+	}
+
+	has, err := h.checkIcingaService(alert.Name, kh)
+	if err != nil {
+		return err
+	}
+
+	if alertSpec.Paused {
+		if has {
+			if err := h.deleteIcingaService(alert.Name, kh); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attrs := make(map[string]interface{})
+	if alertSpec.CheckInterval.Seconds() > 0 {
+		attrs["check_interval"] = alertSpec.CheckInterval.Seconds()
+	}
+
+	for key, val := range alertSpec.Vars {
+		attrs[IVar(key)] = val
+	}
+
+	if !has {
+		attrs["check_command"] = alertSpec.Check
+		if err := h.createIcingaService(alert.Name, kh, attrs); err != nil {
+			return err
+		}
+	} else {
+		if err := h.updateIcingaService(alert.Name, kh, attrs); err != nil {
+			return err
+		}
+	}
+
+	return h.reconcileIcingaNotification(alert, kh, 0)
+}
+
+func (h *ServiceHost) GetStatus(alert *api.ServiceAlert, svc *core.Service) (*api.TargetStatus, error) {
+	return h.getIcingaServiceStatus(alert.Name, h.getHost(alert.Namespace, svc))
+}
+
+func (h *ServiceHost) Delete(alertNamespace, alertName string, svc *core.Service) error {
+	kh := h.getHost(alertNamespace, svc)
+
+	if err := h.deleteIcingaService(alertName, kh); err != nil {
+		return err
+	}
+	return h.deleteIcingaHost(kh)
+}
+
+func (h *ServiceHost) DeleteChecks(cmd string) error {
+	return h.deleteIcingaServiceForCheckCommand(cmd)
+}
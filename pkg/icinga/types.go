@@ -14,29 +14,58 @@ import (
 const (
 	internalIP = "InternalIP"
 
-	TypePod     = "pod"
-	TypeNode    = "node"
-	TypeCluster = "cluster"
+	TypePod      = "pod"
+	TypeService  = "service"
+	TypeWorkload = "workload"
+	TypeNode     = "node"
+	TypeCluster  = "cluster"
 )
 
 type IcingaHost struct {
 	Type           string
 	AlertNamespace string
 	ObjectName     string
-	IP             string
+	// ObjectNamespace is the namespace of pod. It is different from AlertNamespace
+	// for PodAlerts with namespaceSelector.
+	ObjectNamespace string
+	IP              string
 }
 
 func IsValidHostType(t string) bool {
 	switch t {
-	case TypePod, TypeNode, TypeCluster:
+	case TypePod, TypeService, TypeWorkload, TypeNode, TypeCluster:
 		return true
 	}
 	return false
 }
 
+// HostTypeForAlertKind returns the type of Icinga hosts created for alerts of kind.
+func HostTypeForAlertKind(kind string) (string, error) {
+	switch kind {
+	case api.ResourceKindPodAlert:
+		return TypePod, nil
+	case api.ResourceKindServiceAlert:
+		return TypeService, nil
+	case api.ResourceKindWorkloadAlert:
+		return TypeWorkload, nil
+	case api.ResourceKindNodeAlert:
+		return TypeNode, nil
+	case api.ResourceKindClusterAlert:
+		return TypeCluster, nil
+	}
+	return "", errors.Errorf("unknown alert kind %s", kind)
+}
+
 func (kh IcingaHost) Name() (string, error) {
 	switch kh.Type {
 	case TypePod:
+		if kh.ObjectNamespace != "" && kh.ObjectNamespace != kh.AlertNamespace {
+			return kh.AlertNamespace + "@" + kh.Type + "@" + kh.ObjectName + "@" + kh.ObjectNamespace, nil
+		}
+		return kh.AlertNamespace + "@" + kh.Type + "@" + kh.ObjectName, nil
+	case TypeService:
+		return kh.AlertNamespace + "@" + kh.Type + "@" + kh.ObjectName, nil
+	case TypeWorkload:
 		return kh.AlertNamespace + "@" + kh.Type + "@" + kh.ObjectName, nil
 	case TypeNode:
 		return kh.AlertNamespace + "@" + kh.Type + "@" + kh.ObjectName, nil
@@ -50,6 +79,10 @@ func (kh IcingaHost) GetAlert(extClient cs.Interface, alertName string) (api.Ale
 	switch kh.Type {
 	case TypePod:
 		return extClient.MonitoringV1alpha1().PodAlerts(kh.AlertNamespace).Get(alertName, metav1.GetOptions{})
+	case TypeService:
+		return extClient.MonitoringV1alpha1().ServiceAlerts(kh.AlertNamespace).Get(alertName, metav1.GetOptions{})
+	case TypeWorkload:
+		return extClient.MonitoringV1alpha1().WorkloadAlerts(kh.AlertNamespace).Get(alertName, metav1.GetOptions{})
 	case TypeNode:
 		return extClient.MonitoringV1alpha1().NodeAlerts(kh.AlertNamespace).Get(alertName, metav1.GetOptions{})
 	case TypeCluster:
@@ -58,14 +91,55 @@ func (kh IcingaHost) GetAlert(extClient cs.Interface, alertName string) (api.Ale
 	return nil, errors.Errorf("unknown host type %s", kh.Type)
 }
 
+// ParseIncident returns the Icinga host and service of incident, computed from its labels.
+func ParseIncident(incident *api.Incident) (host string, service string, err error) {
+	namespace, name := incident.Namespace, incident.Name
+	icingaHost := &IcingaHost{AlertNamespace: namespace}
+
+	service, ok := incident.Labels[api.LabelKeyAlert]
+	if !ok {
+		return "", "", errors.Errorf("incident %s/%s is missing label %s", namespace, name, api.LabelKeyAlert)
+	}
+	icingaHost.Type, ok = incident.Labels[api.LabelKeyAlertType]
+	if !ok {
+		return "", "", errors.Errorf("incident %s/%s is missing label %s", namespace, name, api.LabelKeyAlertType)
+	} else if !IsValidHostType(icingaHost.Type) {
+		return "", "", errors.Errorf("incident %s/%s has invalid value %s for label %s", namespace, name, icingaHost.Type, api.LabelKeyAlertType)
+	}
+	if icingaHost.Type != TypeCluster {
+		icingaHost.ObjectName, ok = incident.Labels[api.LabelKeyObjectName]
+		if !ok {
+			return "", "", errors.Errorf("incident %s/%s is missing label %s", namespace, name, api.LabelKeyObjectName)
+		}
+		// set for pods in other namespace than the alert
+		icingaHost.ObjectNamespace = incident.Labels[api.LabelKeyObjectNamespace]
+	}
+	host, err = icingaHost.Name()
+	return
+}
+
 func ParseHost(name string) (*IcingaHost, error) {
-	parts := strings.SplitN(name, "@", 3)
-	if !(len(parts) == 2 || len(parts) == 3) {
+	parts := strings.SplitN(name, "@", 4)
+	if !(len(parts) == 2 || len(parts) == 3 || len(parts) == 4) {
 		return nil, errors.Errorf("host %s has a bad format", name)
 	}
 	t := parts[1]
 	switch t {
-	case TypePod, TypeNode:
+	case TypePod:
+		if len(parts) < 3 {
+			return nil, errors.Errorf("host %s has a bad format", name)
+		}
+		kh := &IcingaHost{
+			AlertNamespace:  parts[0],
+			Type:            t,
+			ObjectName:      parts[2],
+			ObjectNamespace: parts[0],
+		}
+		if len(parts) == 4 {
+			kh.ObjectNamespace = parts[3]
+		}
+		return kh, nil
+	case TypeService, TypeWorkload, TypeNode:
 		if len(parts) != 3 {
 			return nil, errors.Errorf("host %s has a bad format", name)
 		}
@@ -97,8 +171,13 @@ type ResponseObject struct {
 			Name            string                 `json:"name"`
 			CheckInterval   float64                `json:"check_interval"`
 			Vars            map[string]interface{} `json:"vars"`
+			State           float64                `json:"state"`
 			LastState       float64                `json:"last_state"`
-			Acknowledgement float64                `json:"acknowledgement"`
+			LastCheck       float64                `json:"last_check"`
+			LastCheckResult *struct {
+				Output string `json:"output"`
+			} `json:"last_check_result"`
+			Acknowledgement float64 `json:"acknowledgement"`
 		} `json:"attrs"`
 		Name string `json:"name"`
 	} `json:"results"`
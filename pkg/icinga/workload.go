@@ -0,0 +1,113 @@
+package icinga
+
+import (
+	"strings"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type WorkloadHost struct {
+	commonHost
+}
+
+func NewWorkloadHost(IcingaClient *Client, verbosity string) *WorkloadHost {
+	return &WorkloadHost{
+		commonHost: commonHost{
+			IcingaClient: IcingaClient,
+			verbosity:    verbosity,
+		},
+	}
+}
+
+// WorkloadObjectName returns the object name of Icinga host for a workload, eg, deployment.nginx.
+// Kind is part of the name, since workloads of different kinds can have the same name.
+func WorkloadObjectName(kind, name string) string {
+	return strings.ToLower(kind) + "." + name
+}
+
+// ParseWorkloadObjectName returns the kind and name of workload from object name of an Icinga host.
+func ParseWorkloadObjectName(objectName string) (string, string, error) {
+	parts := strings.SplitN(objectName, ".", 2)
+	if len(parts) == 2 {
+		for _, kind := range []string{api.WorkloadKindDeployment, api.WorkloadKindStatefulSet, api.WorkloadKindDaemonSet} {
+			if strings.ToLower(kind) == parts[0] {
+				return kind, parts[1], nil
+			}
+		}
+	}
+	return "", "", errors.Errorf("workload %s has a bad format", objectName)
+}
+
+// Workloads have no address of their own. Checks find the workload from the host name.
+func (h *WorkloadHost) getHost(namespace, kind string, w metav1.ObjectMeta) IcingaHost {
+	return IcingaHost{
+		ObjectName:     WorkloadObjectName(kind, w.Name),
+		Type:           TypeWorkload,
+		AlertNamespace: namespace,
+		IP:             "127.0.0.1",
+	}
+}
+
+func (h *WorkloadHost) Apply(alert *api.WorkloadAlert, w metav1.ObjectMeta) error {
+	alertSpec := alert.Spec
+	kh := h.getHost(alert.Namespace, alertSpec.WorkloadKind, w)
+
+	if err := h.reconcileIcingaHost(kh); err != nil {
+		return err
+	}
+
+	has, err := h.checkIcingaService(alert.Name, kh)
+	if err != nil {
+		return err
+	}
+
+	if alertSpec.Paused {
+		if has {
+			if err := h.deleteIcingaService(alert.Name, kh); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	attrs := make(map[string]interface{})
+	if alertSpec.CheckInterval.Seconds() > 0 {
+		attrs["check_interval"] = alertSpec.CheckInterval.Seconds()
+	}
+
+	for key, val := range alertSpec.Vars {
+		attrs[IVar(key)] = val
+	}
+
+	if !has {
+		attrs["check_command"] = alertSpec.Check
+		if err := h.createIcingaService(alert.Name, kh, attrs); err != nil {
+			return err
+		}
+	} else {
+		if err := h.updateIcingaService(alert.Name, kh, attrs); err != nil {
+			return err
+		}
+	}
+
+	return h.reconcileIcingaNotification(alert, kh, 0)
+}
+
+func (h *WorkloadHost) GetStatus(alert *api.WorkloadAlert, w metav1.ObjectMeta) (*api.TargetStatus, error) {
+	return h.getIcingaServiceStatus(alert.Name, h.getHost(alert.Namespace, alert.Spec.WorkloadKind, w))
+}
+
+func (h *WorkloadHost) Delete(alertNamespace, alertName, kind string, w metav1.ObjectMeta) error {
+	kh := h.getHost(alertNamespace, kind, w)
+
+	if err := h.deleteIcingaService(alertName, kh); err != nil {
+		return err
+	}
+	return h.deleteIcingaHost(kh)
+}
+
+func (h *WorkloadHost) DeleteChecks(cmd string) error {
+	return h.deleteIcingaServiceForCheckCommand(cmd)
+}
@@ -0,0 +1,95 @@
+package operator
+
+import (
+	"reflect"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	mon_listers "github.com/appscode/searchlight/client/listers/monitoring/v1alpha1"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+func (op *Operator) initAlertTemplateWatcher() {
+	op.atInformer = op.monInformerFactory.Monitoring().V1alpha1().AlertTemplates().Informer()
+	op.atQueue = queue.New("AlertTemplate", op.MaxNumRequeues, op.NumThreads, op.reconcileAlertTemplate)
+	op.atInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			queue.Enqueue(op.atQueue.GetQueue(), obj)
+		},
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*api.AlertTemplate)
+			nu := newObj.(*api.AlertTemplate)
+			if !reflect.DeepEqual(old.Spec, nu.Spec) {
+				queue.Enqueue(op.atQueue.GetQueue(), nu)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			queue.Enqueue(op.atQueue.GetQueue(), obj)
+		},
+	})
+	op.atLister = op.monInformerFactory.Monitoring().V1alpha1().AlertTemplates().Lister()
+}
+
+// reconcileAlertTemplate requeues the alerts that reference an AlertTemplate, so that changes of
+// the template are applied to their Icinga objects.
+func (op *Operator) reconcileAlertTemplate(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		glog.Errorf("Splitting key %s failed with %v", key, err)
+		return err
+	}
+	log.Infof("Sync/Add/Update for AlertTemplate %s\n", key)
+
+	cas, err := op.caLister.ClusterAlerts(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, alert := range cas {
+		if usesTemplate(alert, name) && op.isValid(alert) {
+			queue.Enqueue(op.caQueue.GetQueue(), alert)
+		}
+	}
+	nas, err := op.naLister.NodeAlerts(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, alert := range nas {
+		if usesTemplate(alert, name) && op.isValid(alert) {
+			queue.Enqueue(op.naQueue.GetQueue(), alert)
+		}
+	}
+	pas, err := op.paLister.PodAlerts(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, alert := range pas {
+		if usesTemplate(alert, name) && op.isValid(alert) {
+			queue.Enqueue(op.paQueue.GetQueue(), alert)
+		}
+	}
+	return nil
+}
+
+func usesTemplate(alert api.TemplatedAlert, name string) bool {
+	ref := alert.GetTemplateRef()
+	return ref != nil && ref.Name == name
+}
+
+// setTemplateDefaults sets the fields of alert that are not set from its AlertTemplate. alert must
+// not be an object of informer cache.
+func setTemplateDefaults(lister mon_listers.AlertTemplateLister, alert api.TemplatedAlert) error {
+	ref := alert.GetTemplateRef()
+	if ref == nil {
+		return nil
+	}
+	t, err := lister.AlertTemplates(alert.GetNamespace()).Get(ref.Name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get AlertTemplate %s", ref.Name)
+	}
+	alert.SetTemplateDefaults(t)
+	return nil
+}
@@ -0,0 +1,135 @@
+package operator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	core_util "kmodules.xyz/client-go/core/v1"
+)
+
+// Maximum size of the data of archive ConfigMap, that is kept below the 1MiB limit of ConfigMaps
+const maxConfigMapArchiveSize = 900 * 1024
+
+// incidentArchiver appends incidents to an archive, before they are deleted.
+type incidentArchiver interface {
+	Archive(incident *api.Incident) error
+}
+
+// archiveKey returns the name of the file or ConfigMap key that incidents deleted at t are archived in.
+// Incidents are archived as JSON lines, in one file per month.
+func archiveKey(t time.Time) string {
+	return "incidents-" + t.UTC().Format("2006-01") + ".jsonl"
+}
+
+// archiveLine returns the JSON line of incident in archive.
+func archiveLine(incident *api.Incident) ([]byte, error) {
+	in := incident.DeepCopy()
+	in.APIVersion = api.SchemeGroupVersion.String()
+	in.Kind = api.ResourceKindIncident
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// dirArchiver archives incidents in a directory, such as a mounted PersistentVolumeClaim.
+type dirArchiver struct {
+	dir string
+	mu  sync.Mutex
+}
+
+var _ incidentArchiver = &dirArchiver{}
+
+func (a *dirArchiver) Archive(incident *api.Incident) error {
+	line, err := archiveLine(incident)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	f, err := os.OpenFile(filepath.Join(a.dir, archiveKey(time.Now())), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(line); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// configMapArchiver archives incidents in a ConfigMap. The oldest incidents are dropped from the archive,
+// when it grows beyond maxConfigMapArchiveSize.
+type configMapArchiver struct {
+	kubeClient kubernetes.Interface
+	meta       metav1.ObjectMeta
+	mu         sync.Mutex
+}
+
+var _ incidentArchiver = &configMapArchiver{}
+
+func (a *configMapArchiver) Archive(incident *api.Incident) error {
+	line, err := archiveLine(incident)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, _, err = core_util.CreateOrPatchConfigMap(a.kubeClient, a.meta, func(in *core.ConfigMap) *core.ConfigMap {
+		if in.Data == nil {
+			in.Data = map[string]string{}
+		}
+		key := archiveKey(time.Now())
+		in.Data[key] += string(line)
+		trimArchive(in.Data, maxConfigMapArchiveSize)
+		return in
+	})
+	return err
+}
+
+// trimArchive drops the oldest incidents from the archive in data, until its size is within max.
+func trimArchive(data map[string]string, max int) {
+	size := 0
+	keys := make([]string, 0, len(data))
+	for k, v := range data {
+		size += len(k) + len(v)
+		keys = append(keys, k)
+	}
+	// keys of months sort by time
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if size <= max {
+			return
+		}
+		v := data[k]
+		for size > max && v != "" {
+			i := strings.IndexByte(v, '\n')
+			if i < 0 {
+				i = len(v) - 1
+			}
+			size -= i + 1
+			v = v[i+1:]
+		}
+		if v == "" {
+			delete(data, k)
+			size -= len(k)
+		} else {
+			data[k] = v
+		}
+	}
+}
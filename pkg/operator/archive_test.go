@@ -0,0 +1,58 @@
+package operator
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestTrimArchive(t *testing.T) {
+	data := map[string]string{
+		"incidents-2018-04.jsonl": "a1\na2\n",
+		"incidents-2018-05.jsonl": "b1\nb2\n",
+	}
+	trimArchive(data, 100)
+	assert.Len(t, data, 2)
+
+	// drops the oldest line of the oldest month
+	trimArchive(data, 2*len("incidents-2018-04.jsonl")+9)
+	assert.Equal(t, map[string]string{
+		"incidents-2018-04.jsonl": "a2\n",
+		"incidents-2018-05.jsonl": "b1\nb2\n",
+	}, data)
+
+	// drops months that are emptied
+	trimArchive(data, len("incidents-2018-05.jsonl")+3)
+	assert.Equal(t, map[string]string{
+		"incidents-2018-05.jsonl": "b2\n",
+	}, data)
+}
+
+func TestDirArchiver(t *testing.T) {
+	dir, err := ioutil.TempDir("", "searchlight-archive")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	a := &dirArchiver{dir: dir}
+	for _, name := range []string{"cluster.pod-exists-demo-0.20180428-1109", "cluster.pod-exists-demo-0.20180429-1109"} {
+		assert.Nil(t, a.Archive(&api.Incident{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo"}}))
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, archiveKey(time.Now())))
+	assert.Nil(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	assert.Len(t, lines, 2)
+
+	var incident api.Incident
+	assert.Nil(t, json.Unmarshal([]byte(lines[1]), &incident))
+	assert.Equal(t, api.ResourceKindIncident, incident.Kind)
+	assert.Equal(t, "cluster.pod-exists-demo-0.20180429-1109", incident.Name)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/appscode/go/log"
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
 	"github.com/appscode/searchlight/pkg/eventer"
+	"github.com/appscode/searchlight/pkg/icinga"
 	"github.com/golang/glog"
 	core "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
@@ -53,13 +54,17 @@ func (op *Operator) reconcileClusterAlert(key string) error {
 		if err != nil {
 			return err
 		}
+		op.resolveIncidents(incidentFilter{namespace: namespace, alert: name, alertType: icinga.TypeCluster}, "ClusterAlert is deleted")
 		return op.clusterHost.Delete(namespace, name)
 	}
 
 	alert := obj.(*api.ClusterAlert).DeepCopy()
 	log.Infof("Sync/Add/Update for ClusterAlert %s\n", alert.GetName())
 
-	err = op.clusterHost.Apply(alert)
+	err = setTemplateDefaults(op.atLister, alert)
+	if err == nil {
+		err = op.clusterHost.Apply(alert)
+	}
 	if err != nil {
 		op.recorder.Eventf(
 			alert.ObjectReference(),
@@ -68,6 +73,12 @@ func (op *Operator) reconcileClusterAlert(key string) error {
 			`Reason: %v`,
 			err,
 		)
+	} else {
+		op.requeueDependentAlerts(alert.Namespace, api.ResourceKindClusterAlert, alert.Name)
+	}
+	if alert.Spec.Paused {
+		op.resolveIncidents(incidentFilter{namespace: alert.Namespace, alert: alert.Name, alertType: icinga.TypeCluster}, "ClusterAlert is paused")
 	}
+	op.setClusterAlertStatus(alert, err)
 	return err
 }
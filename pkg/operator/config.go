@@ -3,16 +3,21 @@ package operator
 import (
 	"time"
 
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
 	cs "github.com/appscode/searchlight/client/clientset/versioned"
 	mon_informers "github.com/appscode/searchlight/client/informers/externalversions"
 	"github.com/appscode/searchlight/pkg/eventer"
 	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/pkg/registry/acknowledgement"
+	"github.com/appscode/searchlight/plugins/notifier"
 	crd_cs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	reg_util "kmodules.xyz/client-go/admissionregistration/v1beta1"
 	"kmodules.xyz/client-go/discovery"
+	"kmodules.xyz/client-go/meta"
 	hooks "kmodules.xyz/webhook-runtime/admission/v1beta1"
 )
 
@@ -27,6 +32,20 @@ type Config struct {
 	MaxNumRequeues   int
 	NumThreads       int
 	IncidentTTL      time.Duration
+	// Age after which open incidents are garbage collected, kept if 0
+	OpenIncidentTTL time.Duration
+	// Directory that incidents are archived in before they are deleted
+	IncidentArchiveDir string
+	// Name of ConfigMap in the namespace of operator that incidents are archived in before they are deleted
+	IncidentArchiveConfigMap string
+	// How incidents are correlated under parent incidents
+	IncidentCorrelation notifier.Correlation
+	// Period of retrying notifications that notifier failed to send
+	NotificationRetryPeriod time.Duration
+	// Number of attempts to send a notification, before it is given up
+	MaxNotificationAttempts int
+	// Period of sending notifications whose groupWait or escalateAfter has expired
+	NotificationFlushPeriod time.Duration
 	// V logging level, the value of the -v flag
 	Verbosity string
 }
@@ -40,6 +59,8 @@ type OperatorConfig struct {
 	CRDClient      crd_cs.ApiextensionsV1beta1Interface
 	IcingaClient   *icinga.Client // TODO: init
 	AdmissionHooks []hooks.AdmissionHook
+	// Signs acknowledge links of notifications, nil if they are not sent
+	AcknowledgeLinks *acknowledgement.Links
 }
 
 func NewOperatorConfig(clientConfig *rest.Config) *OperatorConfig {
@@ -52,6 +73,10 @@ func (c *OperatorConfig) New() (*Operator, error) {
 	if err := discovery.IsDefaultSupportedVersion(c.KubeClient); err != nil {
 		return nil, err
 	}
+	var err error
+	if api.EnableAdditionalProperties, err = discovery.CheckAPIVersion(c.KubeClient.Discovery(), ">= 1.11"); err != nil {
+		return nil, err
+	}
 
 	op := &Operator{
 		Config:              c.Config,
@@ -65,6 +90,8 @@ func (c *OperatorConfig) New() (*Operator, error) {
 		clusterHost:         icinga.NewClusterHost(c.IcingaClient, c.Verbosity),
 		nodeHost:            icinga.NewNodeHost(c.IcingaClient, c.Verbosity),
 		podHost:             icinga.NewPodHost(c.IcingaClient, c.Verbosity),
+		serviceHost:         icinga.NewServiceHost(c.IcingaClient, c.Verbosity),
+		workloadHost:        icinga.NewWorkloadHost(c.IcingaClient, c.Verbosity),
 		recorder:            eventer.NewEventRecorder(c.KubeClient, "Searchlight operator"),
 	}
 
@@ -77,9 +104,26 @@ func (c *OperatorConfig) New() (*Operator, error) {
 	op.initNamespaceWatcher()
 	op.initNodeWatcher()
 	op.initPodWatcher()
+	op.initServiceWatcher()
+	op.initEndpointsWatcher()
+	op.initWorkloadWatcher()
 	op.initClusterAlertWatcher()
 	op.initNodeAlertWatcher()
 	op.initPodAlertWatcher()
+	op.initServiceAlertWatcher()
+	op.initWorkloadAlertWatcher()
+	op.initDowntimeWatcher()
+	op.initAlertTemplateWatcher()
 	op.initPluginWatcher()
+	op.initIncidentWatcher()
+	if c.IncidentArchiveDir != "" {
+		op.archiver = &dirArchiver{dir: c.IncidentArchiveDir}
+	} else if c.IncidentArchiveConfigMap != "" {
+		op.archiver = &configMapArchiver{
+			kubeClient: c.KubeClient,
+			meta:       metav1.ObjectMeta{Name: c.IncidentArchiveConfigMap, Namespace: meta.Namespace()},
+		}
+	}
+	op.dispatcher = notifier.NewDispatcher(op.kubeClient, op.extClient, op.kubeInformerFactory, op.monInformerFactory, c.AcknowledgeLinks, c.IncidentCorrelation)
 	return op, nil
 }
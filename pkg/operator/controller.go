@@ -8,12 +8,14 @@ import (
 	mon_informers "github.com/appscode/searchlight/client/informers/externalversions"
 	mon_listers "github.com/appscode/searchlight/client/listers/monitoring/v1alpha1"
 	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins/notifier"
 	"github.com/golang/glog"
 	crd_api "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	ecs "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/typed/apiextensions/v1beta1"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	apps_listers "k8s.io/client-go/listers/apps/v1"
 	core_listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
@@ -32,14 +34,21 @@ type Operator struct {
 	extClient    cs.Interface
 	icingaClient *icinga.Client // TODO: init
 
-	clusterHost *icinga.ClusterHost
-	nodeHost    *icinga.NodeHost
-	podHost     *icinga.PodHost
-	recorder    record.EventRecorder
+	clusterHost  *icinga.ClusterHost
+	nodeHost     *icinga.NodeHost
+	podHost      *icinga.PodHost
+	serviceHost  *icinga.ServiceHost
+	workloadHost *icinga.WorkloadHost
+	recorder     record.EventRecorder
 
 	kubeInformerFactory informers.SharedInformerFactory
 	monInformerFactory  mon_informers.SharedInformerFactory
 
+	// sends the notifications posted by hyperalert
+	dispatcher *notifier.Dispatcher
+	// archives incidents before they are deleted, nil if they are not archived
+	archiver incidentArchiver
+
 	// Namespace
 	nsInformer cache.SharedIndexInformer
 	nsLister   core_listers.NamespaceLister
@@ -54,6 +63,23 @@ type Operator struct {
 	podInformer cache.SharedIndexInformer
 	podLister   core_listers.PodLister
 
+	// Service
+	svcQueue    *queue.Worker
+	svcInformer cache.SharedIndexInformer
+	svcLister   core_listers.ServiceLister
+
+	// Endpoints
+	epInformer cache.SharedIndexInformer
+
+	// Deployment, StatefulSet and DaemonSet share one queue, keyed by kind/namespace/name
+	workloadQueue  *queue.Worker
+	deployInformer cache.SharedIndexInformer
+	deployLister   apps_listers.DeploymentLister
+	stsInformer    cache.SharedIndexInformer
+	stsLister      apps_listers.StatefulSetLister
+	dsInformer     cache.SharedIndexInformer
+	dsLister       apps_listers.DaemonSetLister
+
 	// ClusterAlert
 	caQueue    *queue.Worker
 	caInformer cache.SharedIndexInformer
@@ -69,20 +95,66 @@ type Operator struct {
 	paInformer cache.SharedIndexInformer
 	paLister   mon_listers.PodAlertLister
 
+	// ServiceAlert
+	saQueue    *queue.Worker
+	saInformer cache.SharedIndexInformer
+	saLister   mon_listers.ServiceAlertLister
+
+	// WorkloadAlert
+	waQueue    *queue.Worker
+	waInformer cache.SharedIndexInformer
+	waLister   mon_listers.WorkloadAlertLister
+
+	// Downtime
+	dtQueue    *queue.Worker
+	dtInformer cache.SharedIndexInformer
+	dtLister   mon_listers.DowntimeLister
+
+	// AlertTemplate
+	atQueue    *queue.Worker
+	atInformer cache.SharedIndexInformer
+	atLister   mon_listers.AlertTemplateLister
+
 	// SearchlightPlugin
 	pluginQueue    *queue.Worker
 	pluginInformer cache.SharedIndexInformer
 	pluginLister   mon_listers.SearchlightPluginLister
+
+	// Incident
+	incidentQueue    *queue.Worker
+	incidentInformer cache.SharedIndexInformer
+	incidentLister   mon_listers.IncidentLister
+}
+
+// Dispatcher returns the dispatcher of notifications, that reads from the informer caches of operator.
+func (op *Operator) Dispatcher() *notifier.Dispatcher {
+	return op.dispatcher
 }
 
 func (op *Operator) ensureCustomResourceDefinitions() error {
-	crds := []*crd_api.CustomResourceDefinition{
+	alertCRDs := []*crd_api.CustomResourceDefinition{
 		api.ClusterAlert{}.CustomResourceDefinition(),
 		api.NodeAlert{}.CustomResourceDefinition(),
 		api.PodAlert{}.CustomResourceDefinition(),
+	}
+	crds := []*crd_api.CustomResourceDefinition{
+		api.ServiceAlert{}.CustomResourceDefinition(),
+		api.WorkloadAlert{}.CustomResourceDefinition(),
+		api.Downtime{}.CustomResourceDefinition(),
+		api.Silence{}.CustomResourceDefinition(),
+		api.AlertTemplate{}.CustomResourceDefinition(),
+		api.OnCallSchedule{}.CustomResourceDefinition(),
+		api.NotificationPolicy{}.CustomResourceDefinition(),
 		api.Incident{}.CustomResourceDefinition(),
 		api.SearchlightPlugin{}.CustomResourceDefinition(),
 	}
+	if api.EnableConversionWebhook {
+		if err := op.registerMultiVersionCRDs(alertCRDs); err != nil {
+			return err
+		}
+	} else {
+		crds = append(alertCRDs, crds...)
+	}
 	return apiext_util.RegisterCRDs(op.crdClient, crds)
 }
 
@@ -110,22 +182,33 @@ func (op *Operator) RunInformers(stopCh <-chan struct{}) {
 
 	op.nodeQueue.Run(stopCh)
 	op.podQueue.Run(stopCh)
+	op.svcQueue.Run(stopCh)
+	op.workloadQueue.Run(stopCh)
 	op.caQueue.Run(stopCh)
 	op.naQueue.Run(stopCh)
 	op.paQueue.Run(stopCh)
+	op.saQueue.Run(stopCh)
+	op.waQueue.Run(stopCh)
+	op.dtQueue.Run(stopCh)
+	op.atQueue.Run(stopCh)
 	op.pluginQueue.Run(stopCh)
+	op.incidentQueue.Run(stopCh)
 
 	<-stopCh
 	glog.Info("Stopping Searchlight controller")
 }
 
 func (op *Operator) Run(stopCh <-chan struct{}) error {
-	err := op.MigrateAlerts()
-	if err != nil {
+	if api.EnableConversionWebhook {
+		// alerts are upgraded by conversion webhook
+		go op.migrateStorageVersion(stopCh)
+	} else if err := op.MigrateAlerts(); err != nil {
 		return err
 	}
 
 	op.gcIncidents()
+	op.drainOutbox()
+	op.flushNotifications()
 
 	// Create build-in SearchlighPlugin
 	if err := op.createBuiltinSearchlightPlugin(); err != nil {
@@ -0,0 +1,43 @@
+package operator
+
+import (
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/labels"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+// requeueDependentAlerts requeues PodAlerts and NodeAlerts that depend on a parent alert, since
+// Icinga Dependency objects are only created once the Icinga service of parent exists.
+func (op *Operator) requeueDependentAlerts(namespace, kind, name string) {
+	pas, err := op.paLister.PodAlerts(namespace).List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, alert := range pas {
+		if dependsOn(alert.Spec.DependsOn, kind, name) {
+			queue.Enqueue(op.paQueue.GetQueue(), alert)
+		}
+	}
+
+	nas, err := op.naLister.NodeAlerts(namespace).List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, alert := range nas {
+		if dependsOn(alert.Spec.DependsOn, kind, name) {
+			queue.Enqueue(op.naQueue.GetQueue(), alert)
+		}
+	}
+}
+
+func dependsOn(deps []api.AlertDependency, kind, name string) bool {
+	for _, dep := range deps {
+		if dep.Kind == kind && dep.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,217 @@
+package operator
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/appscode/go/log"
+	utilerrors "github.com/appscode/go/util/errors"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	util "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/util"
+	"github.com/appscode/searchlight/pkg/eventer"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/golang/glog"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+func (op *Operator) initDowntimeWatcher() {
+	op.dtInformer = op.monInformerFactory.Monitoring().V1alpha1().Downtimes().Informer()
+	op.dtQueue = queue.New("Downtime", op.MaxNumRequeues, op.NumThreads, op.reconcileDowntime)
+	op.dtInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			queue.Enqueue(op.dtQueue.GetQueue(), obj)
+		},
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*api.Downtime)
+			nu := newObj.(*api.Downtime)
+			if !reflect.DeepEqual(old.Spec, nu.Spec) {
+				queue.Enqueue(op.dtQueue.GetQueue(), nu)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			queue.Enqueue(op.dtQueue.GetQueue(), obj)
+		},
+	})
+	op.dtLister = op.monInformerFactory.Monitoring().V1alpha1().Downtimes().Lister()
+}
+
+func (op *Operator) reconcileDowntime(key string) error {
+	obj, exists, err := op.dtInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		log.Warningf("Downtime %s does not exist anymore\n", key)
+
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return err
+		}
+		return op.icingaClient.RemoveDowntimes(icinga.DowntimeAuthor(namespace, name))
+	}
+
+	d := obj.(*api.Downtime).DeepCopy()
+	log.Infof("Sync/Add/Update for Downtime %s\n", key)
+
+	if err := d.IsValid(); err != nil {
+		op.recorder.Eventf(
+			d.ObjectReference(),
+			core.EventTypeWarning,
+			eventer.EventReasonFailedToSync,
+			`invalid downtime. Reason: %v`,
+			err,
+		)
+		return nil
+	}
+
+	next, err := op.ensureDowntime(d, time.Now())
+	if next > 0 {
+		// revisit when the maintenance window starts or ends
+		op.dtQueue.GetQueue().AddAfter(key, next)
+	}
+	return err
+}
+
+type alertRef struct {
+	kind string
+	name string
+}
+
+// findDowntimeAlerts returns the alerts in the namespace of Downtime that it is applied to.
+func (op *Operator) findDowntimeAlerts(d *api.Downtime) ([]alertRef, error) {
+	var out []alertRef
+	add := func(kind string, alert metav1.Object) {
+		if d.Selects(kind, alert) {
+			out = append(out, alertRef{kind: kind, name: alert.GetName()})
+		}
+	}
+
+	cas, err := op.caLister.ClusterAlerts(d.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range cas {
+		add(api.ResourceKindClusterAlert, a)
+	}
+	nas, err := op.naLister.NodeAlerts(d.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range nas {
+		add(api.ResourceKindNodeAlert, a)
+	}
+	pas, err := op.paLister.PodAlerts(d.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range pas {
+		add(api.ResourceKindPodAlert, a)
+	}
+	sas, err := op.saLister.ServiceAlerts(d.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range sas {
+		add(api.ResourceKindServiceAlert, a)
+	}
+	was, err := op.waLister.WorkloadAlerts(d.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range was {
+		add(api.ResourceKindWorkloadAlert, a)
+	}
+	return out, nil
+}
+
+// ensureDowntime schedules Icinga downtimes for the maintenance window in progress at now or the next one.
+// It returns the duration after which Downtime needs to be reconciled again, zero if no window is left.
+func (op *Operator) ensureDowntime(d *api.Downtime, now time.Time) (time.Duration, error) {
+	start, end, ok := d.Window(now)
+
+	var alerts []alertRef
+	if ok {
+		var err error
+		if alerts, err = op.findDowntimeAlerts(d); err != nil {
+			return 0, err
+		}
+	}
+
+	// Icinga downtimes are already scheduled for this window
+	if d.Status.ObservedGeneration == d.Generation &&
+		d.Status.MatchedAlerts == int32(len(alerts)) &&
+		windowEqual(d.Status.WindowStart, d.Status.WindowEnd, start, end, ok) {
+		return nextDowntimeCheck(start, end, ok, now), op.updateDowntimeStatus(d, start, end, ok, len(alerts), now)
+	}
+
+	var errlist []error
+	author := icinga.DowntimeAuthor(d.Namespace, d.Name)
+	if err := op.icingaClient.RemoveDowntimes(author); err != nil {
+		return 0, err
+	}
+	for _, a := range alerts {
+		hostType, err := icinga.HostTypeForAlertKind(a.kind)
+		if err != nil {
+			errlist = append(errlist, err)
+			continue
+		}
+		err = op.icingaClient.ScheduleDowntime(hostType, d.Namespace, a.name, author, d.Spec.Comment, start, end)
+		if err != nil {
+			op.recorder.Eventf(
+				d.ObjectReference(),
+				core.EventTypeWarning,
+				eventer.EventReasonFailedToSync,
+				`failed to schedule downtime for %s %s. Reason: %s`,
+				a.kind, a.name, err,
+			)
+			errlist = append(errlist, err)
+		}
+	}
+	if len(errlist) == 0 {
+		if err := op.updateDowntimeStatus(d, start, end, ok, len(alerts), now); err != nil {
+			errlist = append(errlist, err)
+		}
+	}
+	return nextDowntimeCheck(start, end, ok, now), utilerrors.NewAggregate(errlist)
+}
+
+func (op *Operator) updateDowntimeStatus(d *api.Downtime, start, end time.Time, ok bool, matched int, now time.Time) error {
+	_, err := util.UpdateDowntimeStatus(op.extClient.MonitoringV1alpha1(), d, func(in *api.DowntimeStatus) *api.DowntimeStatus {
+		in.ObservedGeneration = d.Generation
+		in.Active = ok && !now.Before(start)
+		in.MatchedAlerts = int32(matched)
+		if ok {
+			windowStart, windowEnd := metav1.NewTime(start), metav1.NewTime(end)
+			in.WindowStart = &windowStart
+			in.WindowEnd = &windowEnd
+		} else {
+			in.WindowStart = nil
+			in.WindowEnd = nil
+		}
+		return in
+	}, api.EnableStatusSubresource)
+	return err
+}
+
+func windowEqual(curStart, curEnd *metav1.Time, start, end time.Time, ok bool) bool {
+	if !ok {
+		return curStart == nil && curEnd == nil
+	}
+	return curStart != nil && curEnd != nil && curStart.Unix() == start.Unix() && curEnd.Unix() == end.Unix()
+}
+
+func nextDowntimeCheck(start, end time.Time, ok bool, now time.Time) time.Duration {
+	if !ok {
+		return 0
+	}
+	if now.Before(start) {
+		return start.Sub(now)
+	}
+	return end.Sub(now)
+}
@@ -0,0 +1,187 @@
+package operator
+
+import (
+	"time"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// flushNotifications periodically sends the notifications that wait for groupWait of routes or of notification
+// groups, or for escalateAfter of receivers, once it expires. Icinga may not notify a problem again before
+// alertInterval, and never if it is 0, so they are not left to the next notification.
+func (op *Operator) flushNotifications() {
+	if op.NotificationFlushPeriod <= 0 {
+		log.Warningln("skipping notifications of expired group waits and escalations")
+		return
+	}
+
+	ticker := time.NewTicker(op.NotificationFlushPeriod)
+	go func() {
+		for t := range ticker.C {
+			if err := op.notifyWaitingRoutes(t); err != nil {
+				log.Errorln(err)
+			}
+			if err := op.flushNotificationGroups(t); err != nil {
+				log.Errorln(err)
+			}
+		}
+	}()
+}
+
+// notifyWaitingRoutes notifies the receivers of routes, whose groupWait for the problem of open incidents
+// has expired at now.
+func (op *Operator) notifyWaitingRoutes(now time.Time) error {
+	incidents, err := op.incidentLister.List(labels.SelectorFromSet(map[string]string{
+		api.LabelKeyProblemRecovered: "false",
+	}))
+	if err != nil {
+		return err
+	}
+	for _, incident := range incidents {
+		if len(incident.Status.DueWaitingRoutes(now)) == 0 {
+			continue
+		}
+		receivers, err := op.dispatcher.NotifyWaitingRoutes(incident, metav1.NewTime(now))
+		if err != nil {
+			log.Errorf("failed to notify waiting routes of Incident %s/%s. Reason: %v", incident.Namespace, incident.Name, err)
+			continue
+		}
+		log.Infof("Problem of Incident %s/%s is sent to %d receivers of waiting routes", incident.Namespace, incident.Name, len(receivers))
+	}
+	return nil
+}
+
+// escalateIncidents notifies the receivers of the escalation steps of open incidents, that are due at now as
+// their problem stays unacknowledged.
+func (op *Operator) escalateIncidents(now time.Time) error {
+	incidents, err := op.incidentLister.List(labels.SelectorFromSet(map[string]string{
+		api.LabelKeyProblemRecovered: "false",
+	}))
+	if err != nil {
+		return err
+	}
+	for _, incident := range incidents {
+		if !incident.Status.EscalationDue(now) {
+			continue
+		}
+		receivers, err := op.dispatcher.EscalateDue(incident, metav1.NewTime(now))
+		if err != nil {
+			log.Errorf("failed to escalate Incident %s/%s. Reason: %v", incident.Namespace, incident.Name, err)
+			continue
+		}
+		log.Infof("Problem of Incident %s/%s is escalated to %d receivers", incident.Namespace, incident.Name, len(receivers))
+	}
+	return nil
+}
+
+// groupedAlert is an alert whose notifications may be grouped, with the type of its Icinga hosts.
+type groupedAlert struct {
+	api.GroupedAlert
+	hostType string
+	status   api.AlertStatus
+}
+
+func (op *Operator) listGroupedAlerts() ([]groupedAlert, error) {
+	var out []groupedAlert
+	podAlerts, err := op.paLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range podAlerts {
+		out = append(out, groupedAlert{a, icinga.TypePod, a.Status})
+	}
+	nodeAlerts, err := op.naLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range nodeAlerts {
+		out = append(out, groupedAlert{a, icinga.TypeNode, a.Status})
+	}
+	serviceAlerts, err := op.saLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range serviceAlerts {
+		out = append(out, groupedAlert{a, icinga.TypeService, a.Status})
+	}
+	workloadAlerts, err := op.waLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range workloadAlerts {
+		out = append(out, groupedAlert{a, icinga.TypeWorkload, a.Status})
+	}
+	return out, nil
+}
+
+// flushNotificationGroups sends the first digest of notification groups, whose groupWait has expired at now.
+// Digest is sent by a notification of a target in problem state, that was recorded in the group before.
+func (op *Operator) flushNotificationGroups(now time.Time) error {
+	alerts, err := op.listGroupedAlerts()
+	if err != nil {
+		return err
+	}
+	for _, a := range alerts {
+		grouping := a.GetGrouping()
+		if grouping == nil {
+			continue
+		}
+		for _, g := range a.status.NotificationGroups {
+			if !groupDue(g, grouping.GroupWait.Duration, now) {
+				continue
+			}
+			incident, err := op.groupIncident(a, g)
+			if err != nil {
+				log.Errorln(err)
+				continue
+			}
+			if incident == nil {
+				continue
+			}
+			targets, err := op.dispatcher.FlushGroup(incident, metav1.NewTime(now))
+			if err != nil {
+				log.Errorf("failed to send digest of %s %s/%s. Reason: %v", a.ObjectReference().Kind, a.GetNamespace(), a.GetName(), err)
+				continue
+			}
+			log.Infof("Digest of %d targets of %s %s/%s is sent", targets, a.ObjectReference().Kind, a.GetNamespace(), a.GetName())
+		}
+	}
+	return nil
+}
+
+// groupDue returns true, if the first digest of group should be sent at now.
+func groupDue(g api.NotificationGroup, groupWait time.Duration, now time.Time) bool {
+	return g.NotifiedTimestamp == nil && now.Sub(g.FirstTimestamp.Time) >= groupWait
+}
+
+// groupIncident returns the open incident of a target of group in problem state, nil if there is none.
+func (op *Operator) groupIncident(a groupedAlert, g api.NotificationGroup) (*api.Incident, error) {
+	targets := sets.NewString()
+	for _, t := range g.Targets {
+		if t.State != "OK" {
+			targets.Insert(t.Name)
+		}
+	}
+	if targets.Len() == 0 {
+		return nil, nil
+	}
+	incidents, err := op.incidentLister.Incidents(a.GetNamespace()).List(labels.SelectorFromSet(map[string]string{
+		api.LabelKeyAlertType:        a.hostType,
+		api.LabelKeyAlert:            a.GetName(),
+		api.LabelKeyProblemRecovered: "false",
+	}))
+	if err != nil {
+		return nil, err
+	}
+	for _, incident := range incidents {
+		if targets.Has(incident.Labels[api.LabelKeyObjectName]) {
+			return incident, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,74 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	mon_listers "github.com/appscode/searchlight/client/listers/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestGroupDue(t *testing.T) {
+	now := time.Now()
+	g := api.NotificationGroup{FirstTimestamp: metav1.NewTime(now.Add(-2 * time.Minute))}
+	assert.True(t, groupDue(g, 2*time.Minute, now))
+	assert.False(t, groupDue(g, 3*time.Minute, now))
+
+	// later digests are sent with notifications of targets
+	notified := metav1.NewTime(now)
+	g.NotifiedTimestamp = &notified
+	assert.False(t, groupDue(g, 2*time.Minute, now))
+}
+
+func TestGroupIncident(t *testing.T) {
+	incident := func(name, alertType, object, recovered string) *api.Incident {
+		return &api.Incident{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "demo",
+				Labels: map[string]string{
+					api.LabelKeyAlertType:        alertType,
+					api.LabelKeyAlert:            "pod-exec",
+					api.LabelKeyObjectName:       object,
+					api.LabelKeyProblemRecovered: recovered,
+				},
+			},
+		}
+	}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range []*api.Incident{
+		incident("pod.payments-0.pod-exec.20190107-0900", icinga.TypePod, "payments-0", "true"),
+		incident("node.payments-1.pod-exec.20190107-0900", icinga.TypeNode, "payments-1", "false"),
+		incident("pod.payments-2.pod-exec.20190107-0900", icinga.TypePod, "payments-2", "false"),
+	} {
+		assert.NoError(t, indexer.Add(obj))
+	}
+	op := &Operator{incidentLister: mon_listers.NewIncidentLister(indexer)}
+	alert := groupedAlert{
+		GroupedAlert: &api.PodAlert{ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"}},
+		hostType:     icinga.TypePod,
+	}
+
+	g := api.NotificationGroup{
+		Targets: []api.GroupedTarget{
+			{Name: "payments-0", State: "Critical"},
+			{Name: "payments-1", State: "Critical"},
+			{Name: "payments-2", State: "OK"},
+		},
+	}
+	// recovered incidents and incidents of other alerts are skipped
+	incidentOf, err := op.groupIncident(alert, g)
+	assert.NoError(t, err)
+	assert.Nil(t, incidentOf)
+
+	g.Targets[2].State = "Warning"
+	incidentOf, err = op.groupIncident(alert, g)
+	assert.NoError(t, err)
+	if assert.NotNil(t, incidentOf) {
+		assert.Equal(t, "pod.payments-2.pod-exec.20190107-0900", incidentOf.Name)
+	}
+}
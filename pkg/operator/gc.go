@@ -9,13 +9,18 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// Period of garbage collection of incidents
+const incidentGCPeriod = time.Hour
+
+// gcIncidents periodically deletes incidents older than the retention of their state. Deleted incidents
+// are archived first, if an archive is configured.
 func (op *Operator) gcIncidents() {
-	if op.IncidentTTL <= 0 {
+	if op.IncidentTTL <= 0 && op.OpenIncidentTTL <= 0 {
 		log.Warningln("skipping garbage collection of incidents")
 		return
 	}
 
-	ticker := time.NewTicker(op.IncidentTTL)
+	ticker := time.NewTicker(incidentGCPeriod)
 	go func() {
 		for t := range ticker.C {
 			log.Infoln("Incident GC run at", t)
@@ -27,11 +32,19 @@ func (op *Operator) gcIncidents() {
 			}
 
 			for _, item := range objects.Items {
-				if item.Status.LastNotificationType == api.NotificationRecovery &&
-					t.Sub(item.CreationTimestamp.Time) > op.IncidentTTL {
+				if incidentExpired(&item, t, op.IncidentTTL, op.OpenIncidentTTL) {
 					op.extClient.MonitoringV1alpha1().Incidents(item.Namespace).Delete(item.Name, nil)
 				}
 			}
 		}
 	}()
 }
+
+// incidentExpired returns true, if incident is older than ttl of recovered or resolved incidents, or openTTL
+// of open incidents. Incidents are kept, if the ttl of their state is 0.
+func incidentExpired(incident *api.Incident, now time.Time, ttl, openTTL time.Duration) bool {
+	if incident.Labels[api.LabelKeyProblemRecovered] != "true" {
+		ttl = openTTL
+	}
+	return ttl > 0 && now.Sub(incident.CreationTimestamp.Time) > ttl
+}
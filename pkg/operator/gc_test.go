@@ -0,0 +1,32 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIncidentExpired(t *testing.T) {
+	now := time.Now()
+	incident := &api.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "cluster.pod-exists-demo-0.20180428-1109",
+			Namespace:         "demo",
+			CreationTimestamp: metav1.NewTime(now.Add(-48 * time.Hour)),
+			Labels:            map[string]string{api.LabelKeyProblemRecovered: "false"},
+		},
+	}
+
+	// open incidents are kept by default
+	assert.False(t, incidentExpired(incident, now, 24*time.Hour, 0))
+	assert.True(t, incidentExpired(incident, now, 0, 24*time.Hour))
+	assert.False(t, incidentExpired(incident, now, 0, 72*time.Hour))
+
+	incident.Labels[api.LabelKeyProblemRecovered] = "true"
+	assert.True(t, incidentExpired(incident, now, 24*time.Hour, 0))
+	assert.False(t, incidentExpired(incident, now, 72*time.Hour, 24*time.Hour))
+	assert.False(t, incidentExpired(incident, now, 0, 24*time.Hour))
+}
@@ -0,0 +1,248 @@
+package operator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/util"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins/notifier"
+	"github.com/golang/glog"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+// Author of the resolutions of incidents made by Searchlight operator
+const autoResolveAuthor = "searchlight"
+
+func (op *Operator) initIncidentWatcher() {
+	op.incidentInformer = op.monInformerFactory.Monitoring().V1alpha1().Incidents().Informer()
+	op.incidentQueue = queue.New("Incident", op.MaxNumRequeues, op.NumThreads, op.reconcileIncident)
+	op.incidentInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			queue.Enqueue(op.incidentQueue.GetQueue(), obj)
+		},
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*api.Incident)
+			nu := newObj.(*api.Incident)
+
+			if reflect.DeepEqual(old.DeletionTimestamp, nu.DeletionTimestamp) &&
+				reflect.DeepEqual(old.Finalizers, nu.Finalizers) &&
+				reflect.DeepEqual(old.Status.ParentRef, nu.Status.ParentRef) {
+				return
+			}
+			queue.Enqueue(op.incidentQueue.GetQueue(), nu)
+		},
+	})
+	op.incidentLister = op.monInformerFactory.Monitoring().V1alpha1().Incidents().Lister()
+}
+
+func (op *Operator) reconcileIncident(key string) error {
+	obj, exists, err := op.incidentInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	incident := obj.(*api.Incident)
+	if err := op.reconcileArchiveFinalizer(key, incident); err != nil {
+		return err
+	}
+	if err := op.reconcileOwnerReference(incident); err != nil {
+		log.Errorf("failed to set owner reference of incident %s. Reason: %v", key, err)
+		return err
+	}
+	if err := op.dispatcher.Correlate(incident); err != nil {
+		log.Errorf("failed to correlate incident %s. Reason: %v", key, err)
+		return err
+	}
+	return nil
+}
+
+// reconcileArchiveFinalizer archives incident before it is deleted, if an archive is configured. Incidents
+// are kept from deletion by IncidentArchiveFinalizer until they are archived. The finalizer is removed from
+// incidents, if no archive is configured.
+func (op *Operator) reconcileArchiveFinalizer(key string, incident *api.Incident) error {
+	finalized := core_util.HasFinalizer(incident.ObjectMeta, api.IncidentArchiveFinalizer)
+	if incident.DeletionTimestamp != nil {
+		if !finalized {
+			return nil
+		}
+		if op.archiver != nil {
+			// open incidents of deleted alerts are resolved in archive
+			archived := incident.DeepCopy()
+			notifier.ResolveIncident(archived, autoResolveAuthor, "Incident is deleted", metav1.Now())
+			if err := op.archiver.Archive(archived); err != nil {
+				log.Errorf("failed to archive incident %s. Reason: %v", key, err)
+				return err
+			}
+			log.Infof("Incident %s is archived", key)
+		}
+	} else if finalized == (op.archiver != nil) {
+		return nil
+	}
+
+	_, _, err := util.PatchIncident(op.extClient.MonitoringV1alpha1(), incident, func(in *api.Incident) *api.Incident {
+		if op.archiver != nil && in.DeletionTimestamp == nil {
+			in.ObjectMeta = core_util.AddFinalizer(in.ObjectMeta, api.IncidentArchiveFinalizer)
+		} else {
+			in.ObjectMeta = core_util.RemoveFinalizer(in.ObjectMeta, api.IncidentArchiveFinalizer)
+		}
+		return in
+	})
+	if kerr.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// reconcileOwnerReference sets the owner reference of incident to its alert, so that incidents created
+// without it are garbage collected with their alert too. Owner references to a deleted alert of the same
+// name are replaced.
+func (op *Operator) reconcileOwnerReference(incident *api.Incident) error {
+	if incident.DeletionTimestamp != nil {
+		return nil
+	}
+	alert, err := op.getIncidentAlert(incident)
+	if kerr.IsNotFound(err) {
+		// open incidents of deleted alerts are resolved with their alert
+		return nil
+	} else if err != nil {
+		return err
+	}
+	ref := notifier.AlertOwnerReference(alert)
+	for _, cur := range incident.OwnerReferences {
+		if cur.UID == ref.UID {
+			return nil
+		}
+	}
+
+	_, _, err = util.PatchIncident(op.extClient.MonitoringV1alpha1(), incident, func(in *api.Incident) *api.Incident {
+		refs := []metav1.OwnerReference{ref}
+		for _, cur := range in.OwnerReferences {
+			if cur.Kind != ref.Kind || cur.Name != ref.Name {
+				refs = append(refs, cur)
+			}
+		}
+		in.OwnerReferences = refs
+		return in
+	})
+	if kerr.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// getIncidentAlert returns the alert of incident from cache.
+func (op *Operator) getIncidentAlert(incident *api.Incident) (api.Alert, error) {
+	name := incident.Labels[api.LabelKeyAlert]
+	switch incident.Labels[api.LabelKeyAlertType] {
+	case icinga.TypePod:
+		alert, err := op.paLister.PodAlerts(incident.Namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return alert, nil
+	case icinga.TypeNode:
+		alert, err := op.naLister.NodeAlerts(incident.Namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return alert, nil
+	case icinga.TypeService:
+		alert, err := op.saLister.ServiceAlerts(incident.Namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return alert, nil
+	case icinga.TypeWorkload:
+		alert, err := op.waLister.WorkloadAlerts(incident.Namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return alert, nil
+	case icinga.TypeCluster:
+		alert, err := op.caLister.ClusterAlerts(incident.Namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return alert, nil
+	}
+	return nil, fmt.Errorf("unknown alert type %s", incident.Labels[api.LabelKeyAlertType])
+}
+
+// incidentFilter selects the incidents of an alert or of a target.
+type incidentFilter struct {
+	// namespace of alert, all namespaces if empty
+	namespace string
+	alert     string
+	alertType string
+	// name and namespace of target
+	objectName      string
+	objectNamespace string
+}
+
+func (f incidentFilter) String() string {
+	return fmt.Sprintf("alert %s/%s of type %s, target %s/%s", f.namespace, f.alert, f.alertType, f.objectNamespace, f.objectName)
+}
+
+// resolveIncidents resolves the open incidents selected by f on behalf of Searchlight operator. This is
+// done when their alert is deleted or paused, or their target disappears, as no further notification
+// would recover them.
+func (op *Operator) resolveIncidents(f incidentFilter, comment string) {
+	set := labels.Set{
+		api.LabelKeyProblemRecovered: "false",
+		api.LabelKeyAlertType:        f.alertType,
+	}
+	if f.alert != "" {
+		set[api.LabelKeyAlert] = f.alert
+	}
+	if f.objectName != "" {
+		set[api.LabelKeyObjectName] = f.objectName
+	}
+	var incidents []*api.Incident
+	var err error
+	if f.namespace == "" {
+		incidents, err = op.incidentLister.List(labels.SelectorFromSet(set))
+	} else {
+		incidents, err = op.incidentLister.Incidents(f.namespace).List(labels.SelectorFromSet(set))
+	}
+	if err != nil {
+		log.Errorf("failed to list incidents of %s. Reason: %v", f, err)
+		return
+	}
+
+	for _, incident := range incidents {
+		if f.objectNamespace != "" && incidentObjectNamespace(incident) != f.objectNamespace {
+			continue
+		}
+		_, err := op.dispatcher.UpdateIncident(incident.Namespace, incident.Name, func(in *api.Incident) *api.Incident {
+			notifier.ResolveIncident(in, autoResolveAuthor, comment, metav1.Now())
+			return in
+		})
+		if kerr.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			log.Errorf("failed to resolve incident %s/%s. Reason: %v", incident.Namespace, incident.Name, err)
+			continue
+		}
+		log.Infof("Incident %s/%s is resolved. Reason: %s", incident.Namespace, incident.Name, comment)
+	}
+}
+
+// incidentObjectNamespace returns the namespace of the target of incident.
+func incidentObjectNamespace(incident *api.Incident) string {
+	if ns := incident.Labels[api.LabelKeyObjectNamespace]; ns != "" {
+		return ns
+	}
+	return incident.Namespace
+}
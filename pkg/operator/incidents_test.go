@@ -0,0 +1,66 @@
+package operator
+
+import (
+	"testing"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/fake"
+	mon_listers "github.com/appscode/searchlight/client/listers/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestReconcileOwnerReference(t *testing.T) {
+	alert := &api.PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo", UID: types.UID("2")},
+	}
+	incident := func(name string, refs ...metav1.OwnerReference) *api.Incident {
+		return &api.Incident{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "demo",
+				Labels: map[string]string{
+					api.LabelKeyAlertType:        icinga.TypePod,
+					api.LabelKeyAlert:            alert.Name,
+					api.LabelKeyProblemRecovered: "false",
+				},
+				OwnerReferences: refs,
+			},
+		}
+	}
+	stale := metav1.OwnerReference{APIVersion: api.SchemeGroupVersion.String(), Kind: api.ResourceKindPodAlert, Name: alert.Name, UID: types.UID("1")}
+	other := metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: types.UID("3")}
+
+	cases := []struct {
+		name     string
+		incident *api.Incident
+		alerts   []*api.PodAlert
+		expected []types.UID
+	}{
+		{"missing owner reference", incident("pod.payments-0.pod-exec.20190107-0900"), []*api.PodAlert{alert}, []types.UID{"2"}},
+		{"owner reference of deleted alert", incident("pod.payments-0.pod-exec.20190107-0900", stale, other), []*api.PodAlert{alert}, []types.UID{"2", "3"}},
+		{"alert is deleted", incident("pod.payments-0.pod-exec.20190107-0900", other), nil, []types.UID{"3"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, a := range c.alerts {
+				assert.NoError(t, indexer.Add(a))
+			}
+			extClient := fake.NewSimpleClientset(c.incident)
+			op := &Operator{extClient: extClient, paLister: mon_listers.NewPodAlertLister(indexer)}
+
+			assert.NoError(t, op.reconcileOwnerReference(c.incident))
+			cur, err := extClient.MonitoringV1alpha1().Incidents("demo").Get(c.incident.Name, metav1.GetOptions{})
+			assert.NoError(t, err)
+			var uids []types.UID
+			for _, ref := range cur.OwnerReferences {
+				uids = append(uids, ref.UID)
+			}
+			assert.Equal(t, c.expected, uids)
+		})
+	}
+}
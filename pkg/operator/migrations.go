@@ -1,31 +1,100 @@
 package operator
 
 import (
-	"strings"
+	"time"
 
+	"github.com/appscode/go/log"
 	utilerrors "github.com/appscode/go/util/errors"
-	"github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/apis/monitoring"
+	"github.com/appscode/searchlight/apis/monitoring/install"
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/apis/monitoring/v1beta1"
 	"github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/util"
+	crd_api "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
 )
 
+var monitoringScheme = runtime.NewScheme()
+
+func init() {
+	install.Install(monitoringScheme)
+}
+
+// registerMultiVersionCRDs registers alert crds that are served in both v1alpha1 and v1beta1.
+// apiext_util.RegisterCRDs can't be used, since it does not update versions of existing crds
+// and lists objects to check readiness, which requires conversion webhook served by this apiserver.
+func (op *Operator) registerMultiVersionCRDs(crds []*crd_api.CustomResourceDefinition) error {
+	for _, crd := range crds {
+		crd.Spec.Conversion.WebhookClientConfig.CABundle = op.clientConfig.CAData
+
+		existing, err := op.crdClient.CustomResourceDefinitions().Get(crd.Name, metav1.GetOptions{})
+		if kerr.IsNotFound(err) {
+			_, err = op.crdClient.CustomResourceDefinitions().Create(crd)
+		} else if err == nil {
+			existing.Spec.Version = crd.Spec.Version
+			existing.Spec.Versions = crd.Spec.Versions
+			existing.Spec.Conversion = crd.Spec.Conversion
+			existing.Spec.Validation = crd.Spec.Validation
+			existing.Spec.Subresources = crd.Spec.Subresources
+			existing.Spec.AdditionalPrinterColumns = crd.Spec.AdditionalPrinterColumns
+			existing.Spec.Names.Categories = crd.Spec.Names.Categories
+			existing.Spec.Names.ShortNames = crd.Spec.Names.ShortNames
+			_, err = op.crdClient.CustomResourceDefinitions().Update(existing)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return wait.PollImmediate(3*time.Second, 5*time.Minute, func() (bool, error) {
+		for _, crd := range crds {
+			cur, err := op.crdClient.CustomResourceDefinitions().Get(crd.Name, metav1.GetOptions{})
+			if err != nil {
+				return false, err
+			}
+			established := false
+			for _, cond := range cur.Status.Conditions {
+				if cond.Type == crd_api.Established && cond.Status == crd_api.ConditionTrue {
+					established = true
+				}
+			}
+			if !established {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+}
+
+// MigrateAlerts rewrites alerts created by older releases of Searchlight using the conversion
+// functions of monitoring.appscode.com API group.
 func (op *Operator) MigrateAlerts() error {
 	var errs []error
-	if err := op.MigrateClusterAlerts(); err != nil {
+	if err := op.migrateClusterAlerts(); err != nil {
 		errs = append(errs, err)
 	}
-	if err := op.MigratePodAlert(); err != nil {
+	if err := op.migratePodAlerts(); err != nil {
 		errs = append(errs, err)
 	}
-	if err := op.MigrateNodeAlert(); err != nil {
+	if err := op.migrateNodeAlerts(); err != nil {
 		errs = append(errs, err)
 	}
-
 	return utilerrors.NewAggregate(errs)
 }
 
-func (op *Operator) MigrateClusterAlerts() error {
+// upgradeAlert converts a v1alpha1 alert to the internal version and back into out.
+func upgradeAlert(in, out runtime.Object) error {
+	internal, err := monitoringScheme.ConvertToVersion(in.DeepCopyObject(), monitoring.SchemeGroupVersion)
+	if err != nil {
+		return err
+	}
+	return monitoringScheme.Convert(internal, out, nil)
+}
+
+func (op *Operator) migrateClusterAlerts() error {
 	ca, err := op.extClient.MonitoringV1alpha1().ClusterAlerts(metav1.NamespaceAll).List(metav1.ListOptions{})
 	if err != nil {
 		return err
@@ -33,23 +102,23 @@ func (op *Operator) MigrateClusterAlerts() error {
 
 	var errs []error
 	for i := range ca.Items {
-		_, _, err := util.PatchClusterAlert(op.extClient.MonitoringV1alpha1(), &ca.Items[i], func(alert *v1alpha1.ClusterAlert) *v1alpha1.ClusterAlert {
-			check := strings.Replace(alert.Spec.Check, "_", "-", -1)
-			alert.Spec.Check = check
+		upgraded := &api.ClusterAlert{}
+		if err := upgradeAlert(&ca.Items[i], upgraded); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		_, _, err := util.PatchClusterAlert(op.extClient.MonitoringV1alpha1(), &ca.Items[i], func(alert *api.ClusterAlert) *api.ClusterAlert {
+			alert.Spec = upgraded.Spec
 			return alert
 		})
 		if err != nil {
 			errs = append(errs, err)
 		}
 	}
-	if len(errs) > 0 {
-		return utilerrors.NewAggregate(errs)
-	}
-
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
-func (op *Operator) MigratePodAlert() error {
+func (op *Operator) migratePodAlerts() error {
 	poa, err := op.extClient.MonitoringV1alpha1().PodAlerts(metav1.NamespaceAll).List(metav1.ListOptions{})
 	if err != nil {
 		return err
@@ -57,23 +126,23 @@ func (op *Operator) MigratePodAlert() error {
 
 	var errs []error
 	for i := range poa.Items {
-		_, _, err := util.PatchPodAlert(op.extClient.MonitoringV1alpha1(), &poa.Items[i], func(alert *v1alpha1.PodAlert) *v1alpha1.PodAlert {
-			check := strings.Replace(alert.Spec.Check, "_", "-", -1)
-			alert.Spec.Check = check
+		upgraded := &api.PodAlert{}
+		if err := upgradeAlert(&poa.Items[i], upgraded); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		_, _, err := util.PatchPodAlert(op.extClient.MonitoringV1alpha1(), &poa.Items[i], func(alert *api.PodAlert) *api.PodAlert {
+			alert.Spec = upgraded.Spec
 			return alert
 		})
 		if err != nil {
 			errs = append(errs, err)
 		}
 	}
-	if len(errs) > 0 {
-		return utilerrors.NewAggregate(errs)
-	}
-
-	return nil
+	return utilerrors.NewAggregate(errs)
 }
 
-func (op *Operator) MigrateNodeAlert() error {
+func (op *Operator) migrateNodeAlerts() error {
 	noa, err := op.extClient.MonitoringV1alpha1().NodeAlerts(metav1.NamespaceAll).List(metav1.ListOptions{})
 	if err != nil {
 		return err
@@ -81,27 +150,99 @@ func (op *Operator) MigrateNodeAlert() error {
 
 	var errs []error
 	for i := range noa.Items {
-		_, _, err := util.PatchNodeAlert(op.extClient.MonitoringV1alpha1(), &noa.Items[i], func(alert *v1alpha1.NodeAlert) *v1alpha1.NodeAlert {
-			check := strings.Replace(alert.Spec.Check, "_", "-", -1)
-			alert.Spec.Check = check
-
-			if check == api.CheckNodeVolume {
-				mp, found := alert.Spec.Vars["mountpoint"]
-				if found {
-					delete(alert.Spec.Vars, "mountpoint")
-					alert.Spec.Vars["mountPoint"] = mp
-				}
-			}
-
+		upgraded := &api.NodeAlert{}
+		if err := upgradeAlert(&noa.Items[i], upgraded); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		_, _, err := util.PatchNodeAlert(op.extClient.MonitoringV1alpha1(), &noa.Items[i], func(alert *api.NodeAlert) *api.NodeAlert {
+			alert.Spec = upgraded.Spec
+			alert.Annotations = upgraded.Annotations
 			return alert
 		})
 		if err != nil {
 			errs = append(errs, err)
 		}
 	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// migrateStorageVersion writes every alert back through v1beta1 API, so that alerts stored by older
+// releases are stored in v1beta1. Then v1alpha1 is removed from stored versions of alert crds.
+// Conversion webhook is served by this apiserver, so alerts are listed until the apiserver is ready.
+func (op *Operator) migrateStorageVersion(stopCh <-chan struct{}) {
+	var errs []error
+	err := wait.PollImmediateUntil(10*time.Second, func() (bool, error) {
+		var err error
+		if errs, err = op.rewriteAlerts(); err != nil {
+			log.Errorf("failed to list alerts in %s. Reason: %v", v1beta1.SchemeGroupVersion, err)
+			return false, nil
+		}
+		return true, nil
+	}, stopCh)
+	if err != nil {
+		return
+	}
 	if len(errs) > 0 {
-		return utilerrors.NewAggregate(errs)
+		log.Errorf("failed to migrate alerts to %s. Reason: %v", v1beta1.SchemeGroupVersion, utilerrors.NewAggregate(errs))
+		return
 	}
 
-	return nil
+	for _, plural := range []string{api.ResourcePluralClusterAlert, api.ResourcePluralNodeAlert, api.ResourcePluralPodAlert} {
+		if err := op.setStoredVersion(plural + "." + api.SchemeGroupVersion.Group); err != nil {
+			log.Errorln(err)
+		}
+	}
+}
+
+// rewriteAlerts returns errors of individual updates separately, since those are not retried.
+// Alerts modified or deleted since listing are already stored in v1beta1 or gone.
+func (op *Operator) rewriteAlerts() ([]error, error) {
+	client := op.extClient.MonitoringV1beta1()
+	var errs []error
+	collect := func(err error) {
+		if err != nil && !kerr.IsNotFound(err) && !kerr.IsConflict(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	ca, err := client.ClusterAlerts(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	noa, err := client.NodeAlerts(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	poa, err := client.PodAlerts(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range ca.Items {
+		_, err := client.ClusterAlerts(ca.Items[i].Namespace).Update(&ca.Items[i])
+		collect(err)
+	}
+	for i := range noa.Items {
+		_, err := client.NodeAlerts(noa.Items[i].Namespace).Update(&noa.Items[i])
+		collect(err)
+	}
+	for i := range poa.Items {
+		_, err := client.PodAlerts(poa.Items[i].Namespace).Update(&poa.Items[i])
+		collect(err)
+	}
+	return errs, nil
+}
+
+func (op *Operator) setStoredVersion(name string) error {
+	crd, err := op.crdClient.CustomResourceDefinitions().Get(name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if len(crd.Status.StoredVersions) == 1 && crd.Status.StoredVersions[0] == v1beta1.SchemeGroupVersion.Version {
+		return nil
+	}
+	crd.Status.StoredVersions = []string{v1beta1.SchemeGroupVersion.Version}
+	_, err = op.crdClient.CustomResourceDefinitions().UpdateStatus(crd)
+	return err
 }
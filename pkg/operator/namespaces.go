@@ -1,21 +1,70 @@
 package operator
 
 import (
+	"reflect"
+
+	"github.com/appscode/go/log"
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 )
 
 func (op *Operator) initNamespaceWatcher() {
 	op.nsInformer = op.kubeInformerFactory.Core().V1().Namespaces().Informer()
 	op.nsInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*core.Namespace)
+			nu := newObj.(*core.Namespace)
+			if !reflect.DeepEqual(old.Labels, nu.Labels) {
+				op.requeuePodsForNamespaceSelector(nu.Name)
+			}
+		},
 		DeleteFunc: func(obj interface{}) {
 			if ns, ok := obj.(*core.Namespace); ok {
 				op.extClient.MonitoringV1alpha1().ClusterAlerts(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
 				op.extClient.MonitoringV1alpha1().NodeAlerts(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
 				op.extClient.MonitoringV1alpha1().PodAlerts(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
+				op.extClient.MonitoringV1alpha1().ServiceAlerts(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
+				op.extClient.MonitoringV1alpha1().WorkloadAlerts(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
+				op.extClient.MonitoringV1alpha1().Downtimes(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
+				op.extClient.MonitoringV1alpha1().Silences(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
+				op.extClient.MonitoringV1alpha1().AlertTemplates(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
+				op.extClient.MonitoringV1alpha1().OnCallSchedules(ns.Name).DeleteCollection(&metav1.DeleteOptions{}, metav1.ListOptions{})
 			}
 		},
 	})
 	op.nsLister = op.kubeInformerFactory.Core().V1().Namespaces().Lister()
 }
+
+// requeuePodsForNamespaceSelector requeues pods of a namespace whose labels changed, if any
+// PodAlert has namespaceSelector. PodAlerts may then start or stop applying to these pods.
+func (op *Operator) requeuePodsForNamespaceSelector(namespace string) {
+	alerts, err := op.paLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	found := false
+	for _, alert := range alerts {
+		if alert.Spec.NamespaceSelector != nil {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return
+	}
+
+	pods, err := op.podLister.Pods(namespace).List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, pod := range pods {
+		key, err := cache.MetaNamespaceKeyFunc(pod)
+		if err == nil {
+			op.podQueue.GetQueue().Add(key)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/appscode/go/log"
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
 	"github.com/golang/glog"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
@@ -54,6 +55,7 @@ func (op *Operator) reconcileNodeAlert(key string) error {
 		if err != nil {
 			return err
 		}
+		op.resolveIncidents(incidentFilter{namespace: namespace, alert: name, alertType: icinga.TypeNode}, "NodeAlert is deleted")
 		return op.ensureNodeAlertDeleted(namespace, name)
 	}
 
@@ -62,6 +64,9 @@ func (op *Operator) reconcileNodeAlert(key string) error {
 
 	op.ensureNodeAlert(alert)
 	op.ensureNodeAlertDeleted(alert.Namespace, alert.Name)
+	if alert.Spec.Paused {
+		op.resolveIncidents(incidentFilter{namespace: alert.Namespace, alert: alert.Name, alertType: icinga.TypeNode}, "NodeAlert is paused")
+	}
 	return nil
 }
 
@@ -78,7 +83,10 @@ func (op *Operator) ensureNodeAlert(alert *api.NodeAlert) error {
 		return nil
 	}
 
-	sel := labels.SelectorFromSet(alert.Spec.Selector)
+	sel, err := alert.NodeSelector()
+	if err != nil {
+		return err
+	}
 	nodes, err := op.nodeLister.List(sel)
 	if err != nil {
 		return err
@@ -53,6 +53,8 @@ func (op *Operator) reconcileNode(key string) error {
 			return err
 		}
 
+		op.removeNodeFromAlertStatus(name)
+		op.resolveIncidents(incidentFilter{alertType: icinga.TypeNode, objectName: name}, "Node is deleted")
 		return op.forceDeleteIcingaObjectsForNode(name)
 	}
 
@@ -75,7 +77,7 @@ func (op *Operator) ensureNode(node *core.Node) error {
 		oldAlerts.Insert(keys...)
 	}
 
-	newAlerts, err := findNodeAlert(op.kubeClient, op.naLister, node.ObjectMeta)
+	newAlerts, err := findNodeAlert(op.kubeClient, op.naLister, op.atLister, node.ObjectMeta)
 	if err != nil {
 		return err
 	}
@@ -94,11 +96,14 @@ func (op *Operator) ensureNode(node *core.Node) error {
 			)
 			errlist = append(errlist, err)
 		}
+		op.setNodeAlertTarget(alert, node, err)
 
 		key, _ := cache.MetaNamespaceKeyFunc(alert)
 		newKeys[i] = key
 		if oldAlerts.Has(key) {
 			oldAlerts.Delete(key)
+		} else if err == nil {
+			op.requeueDependentAlerts(alert.Namespace, api.ResourceKindNodeAlert, alert.Name)
 		}
 	}
 
@@ -121,7 +126,10 @@ func (op *Operator) ensureNode(node *core.Node) error {
 				)
 			}
 			errlist = append(errlist, err)
+			continue
 		}
+		op.removeNodeAlertTarget(namespace, name, node.Name)
+		op.resolveIncidents(incidentFilter{namespace: namespace, alert: name, alertType: icinga.TypeNode, objectName: node.Name}, "Node is not selected by NodeAlert")
 	}
 
 	_, _, err = core_util.PatchNode(op.kubeClient, node, func(in *core.Node) *core.Node {
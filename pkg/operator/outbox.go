@@ -0,0 +1,138 @@
+package operator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/util"
+	"github.com/appscode/searchlight/pkg/eventer"
+	"github.com/appscode/searchlight/plugins/notifier"
+	"github.com/prometheus/client_golang/prometheus"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var deliveryFailures = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "searchlight",
+		Name:      "notification_delivery_failures_total",
+		Help:      "Number of notifications that could not be delivered after all attempts.",
+	},
+	[]string{"notifier"},
+)
+
+func init() {
+	prometheus.MustRegister(deliveryFailures)
+}
+
+// drainOutbox periodically retries the notifications that notifier failed to send, queued in the
+// status of incidents.
+func (op *Operator) drainOutbox() {
+	if op.NotificationRetryPeriod <= 0 {
+		log.Warningln("skipping retries of failed notifications")
+		return
+	}
+
+	ticker := time.NewTicker(op.NotificationRetryPeriod)
+	go func() {
+		for t := range ticker.C {
+			objects, err := op.extClient.MonitoringV1alpha1().Incidents(core.NamespaceAll).List(metav1.ListOptions{
+				LabelSelector: api.LabelKeyDeliveryPending + "=true",
+			})
+			if err != nil {
+				log.Errorln(err)
+				continue
+			}
+
+			for i := range objects.Items {
+				if err := op.retryDeliveries(&objects.Items[i], t); err != nil {
+					log.Errorln(err)
+				}
+			}
+		}
+	}()
+}
+
+// retryDeliveries sends the pending deliveries of incident that are due.
+func (op *Operator) retryDeliveries(incident *api.Incident, now time.Time) error {
+	var retried []api.NotificationDelivery
+	for _, d := range incident.Status.Deliveries {
+		if d.Phase != api.DeliveryPhasePending || d.Message == nil {
+			continue
+		}
+		if d.NextAttemptTimestamp != nil && d.NextAttemptTimestamp.After(now) {
+			continue
+		}
+		retried = append(retried, op.retryDelivery(incident, d))
+	}
+
+	if len(retried) > 0 {
+		var err error
+		incident, err = util.UpdateIncidentStatus(op.extClient.MonitoringV1alpha1(), incident, func(in *api.IncidentStatus) *api.IncidentStatus {
+			for _, d := range retried {
+				in.SetDelivery(d)
+			}
+			return in
+		}, api.EnableStatusSubresource)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !incident.Status.HasPendingDeliveries() {
+		_, _, err := util.PatchIncident(op.extClient.MonitoringV1alpha1(), incident, func(in *api.Incident) *api.Incident {
+			delete(in.Labels, api.LabelKeyDeliveryPending)
+			return in
+		})
+		return err
+	}
+	return nil
+}
+
+// retryDelivery makes another attempt to send d. Delivery fails for good, once it runs out of attempts.
+func (op *Operator) retryDelivery(incident *api.Incident, d api.NotificationDelivery) api.NotificationDelivery {
+	d.Attempts++
+	d.LastAttemptTimestamp = metav1.Now()
+
+	err := op.deliver(d)
+	if err == nil {
+		log.Infof("Notification sent to %v via %s for Incident %s/%s\n", d.To, d.Notifier, incident.Namespace, incident.Name)
+		d.Phase = api.DeliveryPhaseSent
+		d.LastError = ""
+		d.Message = nil
+		d.NextAttemptTimestamp = nil
+		return d
+	}
+
+	d.LastError = err.Error()
+	if op.MaxNotificationAttempts > 0 && d.Attempts >= int32(op.MaxNotificationAttempts) {
+		d.Phase = api.DeliveryPhaseFailed
+		d.Message = nil
+		d.NextAttemptTimestamp = nil
+		deliveryFailures.WithLabelValues(d.Notifier).Inc()
+		op.recorder.Eventf(
+			incident.ObjectReference(),
+			core.EventTypeWarning,
+			eventer.EventReasonFailedToNotify,
+			`failed to send %s notification to %v via %s after %d attempts. Reason: %v`,
+			d.Type, d.To, d.Notifier, d.Attempts, err,
+		)
+		return d
+	}
+	next := metav1.NewTime(d.LastAttemptTimestamp.Add(notifier.RetryBackoff(d.Attempts)))
+	d.NextAttemptTimestamp = &next
+	return d
+}
+
+func (op *Operator) deliver(d api.NotificationDelivery) error {
+	if d.NotifierSecret == nil {
+		return errors.New("notifier secret is not set")
+	}
+	secret, err := op.kubeClient.CoreV1().Secrets(d.NotifierSecret.Namespace).Get(d.NotifierSecret.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	return notifier.Deliver(d.Notifier, d.To, *d.Message, notifier.SecretLoader(secret))
+}
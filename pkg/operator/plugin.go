@@ -82,6 +82,10 @@ func (op *Operator) ensureCheckCommand(wp *api.SearchlightPlugin) error {
 			api.NodeCommands.Insert(wp.Name, ic)
 		} else if t == api.ResourceKindPodAlert {
 			api.PodCommands.Insert(wp.Name, ic)
+		} else if t == api.ResourceKindServiceAlert {
+			api.ServiceCommands.Insert(wp.Name, ic)
+		} else if t == api.ResourceKindWorkloadAlert {
+			api.WorkloadCommands.Insert(wp.Name, ic)
 		}
 	}
 
@@ -127,6 +131,38 @@ func (op *Operator) ensureCheckCommandDeleted(name string) error {
 			errs = append(errs, err)
 		}
 	}
+	{
+		// Pause all ServiceAlerts for this plugin
+		err = cache.ListAll(op.saInformer.GetIndexer(), labels.Everything(), func(obj interface{}) {
+			_, _, err = util.PatchServiceAlert(op.extClient.MonitoringV1alpha1(), obj.(*api.ServiceAlert), func(alert *api.ServiceAlert) *api.ServiceAlert {
+				pause := alert.Spec.Check == name
+				alert.Spec.Paused = pause
+				return alert
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	{
+		// Pause all WorkloadAlerts for this plugin
+		err = cache.ListAll(op.waInformer.GetIndexer(), labels.Everything(), func(obj interface{}) {
+			_, _, err = util.PatchWorkloadAlert(op.extClient.MonitoringV1alpha1(), obj.(*api.WorkloadAlert), func(alert *api.WorkloadAlert) *api.WorkloadAlert {
+				pause := alert.Spec.Check == name
+				alert.Spec.Paused = pause
+				return alert
+			})
+			if err != nil {
+				errs = append(errs, err)
+			}
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
 	{
 		// Pause all NodeAlerts for this plugin
 		err = cache.ListAll(op.naInformer.GetIndexer(), labels.Everything(), func(obj interface{}) {
@@ -159,11 +195,19 @@ func (op *Operator) ensureCheckCommandDeleted(name string) error {
 	if err := icinga.NewPodHost(op.icingaClient, "").DeleteChecks(name); err != nil {
 		return err
 	}
+	if err := icinga.NewServiceHost(op.icingaClient, "").DeleteChecks(name); err != nil {
+		return err
+	}
+	if err := icinga.NewWorkloadHost(op.icingaClient, "").DeleteChecks(name); err != nil {
+		return err
+	}
 
 	// Delete IcingaCommand definition from Maps
 	api.ClusterCommands.Delete(name)
 	api.NodeCommands.Delete(name)
 	api.PodCommands.Delete(name)
+	api.ServiceCommands.Delete(name)
+	api.WorkloadCommands.Delete(name)
 
 	// Remove CheckCommand config file from custom.d folder
 	path := filepath.Join(op.ConfigRoot, "custom.d", fmt.Sprintf("%s.conf", name))
@@ -281,6 +325,10 @@ func (op *Operator) createBuiltinSearchlightPlugin() error {
 		plugin.GetPodStatusPlugin(),
 		plugin.GetPodVolumePlugin(),
 		plugin.GetPodExecPlugin(),
+		plugin.GetServiceEndpointsPlugin(),
+		plugin.GetWorkloadRolloutPlugin(),
+		plugin.GetWorkloadReplicasPlugin(),
+		plugin.GetWorkloadUnavailablePlugin(),
 	}
 
 	var errs []error
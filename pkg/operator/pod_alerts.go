@@ -6,6 +6,7 @@ import (
 
 	"github.com/appscode/go/log"
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
 	"github.com/golang/glog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -55,6 +56,7 @@ func (op *Operator) reconcilePodAlert(key string) error {
 		if err != nil {
 			return err
 		}
+		op.resolveIncidents(incidentFilter{namespace: namespace, alert: name, alertType: icinga.TypePod}, "PodAlert is deleted")
 		return op.ensurePodAlertDeleted(namespace, name)
 	}
 
@@ -63,10 +65,41 @@ func (op *Operator) reconcilePodAlert(key string) error {
 
 	op.ensurePodAlert(alert)
 	op.ensurePodAlertDeleted(alert.Namespace, alert.Name)
+	if alert.Spec.Paused {
+		op.resolveIncidents(incidentFilter{namespace: alert.Namespace, alert: alert.Name, alertType: icinga.TypePod}, "PodAlert is paused")
+	}
 	return nil
 }
 
 func (op *Operator) ensurePodAlert(alert *api.PodAlert) error {
+	if alert.Spec.NamespaceSelector != nil {
+		nsSel, err := metav1.LabelSelectorAsSelector(alert.Spec.NamespaceSelector)
+		if err != nil {
+			return err
+		}
+		namespaces, err := op.nsLister.List(nsSel)
+		if err != nil {
+			return err
+		}
+		sel, err := metav1.LabelSelectorAsSelector(alert.Spec.Selector)
+		if err != nil {
+			return err
+		}
+		for _, ns := range namespaces {
+			pods, err := op.podLister.Pods(ns.Name).List(sel)
+			if err != nil {
+				return err
+			}
+			for _, pod := range pods {
+				key, err := cache.MetaNamespaceKeyFunc(pod)
+				if err == nil {
+					op.podQueue.GetQueue().Add(key)
+				}
+			}
+		}
+		return nil
+	}
+
 	if alert.Spec.PodName != nil {
 		pod, err := op.podLister.Pods(alert.Namespace).Get(*alert.Spec.PodName)
 		if err != nil {
@@ -97,6 +130,22 @@ func (op *Operator) ensurePodAlert(alert *api.PodAlert) error {
 	return nil
 }
 
+// podAlertKey returns the name of a PodAlert in the alerts annotation of pod. PodAlerts from
+// other namespaces, selected via namespaceSelector, are written as namespace/name.
+func podAlertKey(alertNamespace, alertName, podNamespace string) string {
+	if alertNamespace == podNamespace {
+		return alertName
+	}
+	return alertNamespace + "/" + alertName
+}
+
+func splitPodAlertKey(key, podNamespace string) (string, string) {
+	if parts := strings.SplitN(key, "/", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return podNamespace, key
+}
+
 func alertAppliedToPod(a map[string]string, key string) bool {
 	if a == nil {
 		return false
@@ -112,13 +161,15 @@ func alertAppliedToPod(a map[string]string, key string) bool {
 	return false
 }
 
+// ensurePodAlertDeleted requeues pods of all namespaces, since namespaceSelector of a deleted
+// alert is not known.
 func (op *Operator) ensurePodAlertDeleted(alertNamespace, alertName string) error {
-	pods, err := op.podLister.Pods(alertNamespace).List(labels.Everything())
+	pods, err := op.podLister.List(labels.Everything())
 	if err != nil {
 		return err
 	}
 	for _, pod := range pods {
-		if alertAppliedToPod(pod.Annotations, alertName) {
+		if alertAppliedToPod(pod.Annotations, podAlertKey(alertNamespace, alertName, pod.Namespace)) {
 			key, err := cache.MetaNamespaceKeyFunc(pod)
 			if err == nil {
 				op.podQueue.GetQueue().Add(key)
@@ -32,7 +32,7 @@ func (op *Operator) initPodWatcher() {
 		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
 			old := oldObj.(*core.Pod)
 			nu := newObj.(*core.Pod)
-			if !reflect.DeepEqual(old.Labels, nu.Labels) || old.Status.PodIP != nu.Status.PodIP {
+			if !reflect.DeepEqual(old.Labels, nu.Labels) || old.Status.PodIP != nu.Status.PodIP || old.Spec.NodeName != nu.Spec.NodeName {
 				queue.Enqueue(op.podQueue.GetQueue(), newObj)
 			}
 		},
@@ -57,11 +57,26 @@ func (op *Operator) reconcilePod(key string) error {
 		if err != nil {
 			return err
 		}
-		return op.podHost.ForceDeleteIcingaHost(icinga.IcingaHost{
+		op.removePodFromAlertStatus(namespace, name)
+		op.resolveIncidents(incidentFilter{alertType: icinga.TypePod, objectName: name, objectNamespace: namespace}, "Pod is deleted")
+		var errlist []error
+		if err := op.podHost.ForceDeleteIcingaHost(icinga.IcingaHost{
 			Type:           icinga.TypePod,
 			AlertNamespace: namespace,
 			ObjectName:     name,
-		})
+		}); err != nil {
+			errlist = append(errlist, err)
+		}
+		// Icinga hosts of PodAlerts from other namespaces are matched by wildcard.
+		if err := op.podHost.ForceDeleteIcingaHost(icinga.IcingaHost{
+			Type:            icinga.TypePod,
+			AlertNamespace:  "*",
+			ObjectName:      name,
+			ObjectNamespace: namespace,
+		}); err != nil {
+			errlist = append(errlist, err)
+		}
+		return utilerrors.NewAggregate(errlist)
 	}
 
 	log.Infof("Sync/Add/Update for Pod %s\n", key)
@@ -82,7 +97,7 @@ func (op *Operator) ensurePod(pod *core.Pod) error {
 		oldAlerts.Insert(names...)
 	}
 
-	newAlerts, err := findPodAlert(op.kubeClient, op.paLister, pod.ObjectMeta)
+	newAlerts, err := findPodAlert(op.kubeClient, op.paLister, op.atLister, op.nsLister, pod.ObjectMeta)
 	if err != nil {
 		return err
 	}
@@ -101,17 +116,20 @@ func (op *Operator) ensurePod(pod *core.Pod) error {
 			)
 			errlist = append(errlist, err)
 		}
+		op.setPodAlertTarget(alert, pod, err)
 
-		newNames[i] = alert.Name
-		if oldAlerts.Has(alert.Name) {
-			oldAlerts.Delete(alert.Name)
+		key := podAlertKey(alert.Namespace, alert.Name, pod.Namespace)
+		newNames[i] = key
+		if oldAlerts.Has(key) {
+			oldAlerts.Delete(key)
 		}
 	}
 
-	for _, name := range oldAlerts.List() {
-		err = op.podHost.Delete(pod.Namespace, name, pod)
+	for _, key := range oldAlerts.List() {
+		namespace, name := splitPodAlertKey(key, pod.Namespace)
+		err = op.podHost.Delete(namespace, name, pod)
 		if err != nil {
-			if alert, e2 := op.paLister.PodAlerts(pod.Namespace).Get(name); e2 == nil {
+			if alert, e2 := op.paLister.PodAlerts(namespace).Get(name); e2 == nil {
 				op.recorder.Eventf(
 					alert.ObjectReference(),
 					core.EventTypeWarning,
@@ -121,7 +139,10 @@ func (op *Operator) ensurePod(pod *core.Pod) error {
 				)
 			}
 			errlist = append(errlist, err)
+			continue
 		}
+		op.removePodAlertTarget(namespace, name, api.PodTargetName(namespace, pod.Namespace, pod.Name))
+		op.resolveIncidents(incidentFilter{namespace: namespace, alert: name, alertType: icinga.TypePod, objectName: pod.Name, objectNamespace: pod.Namespace}, "Pod is not selected by PodAlert")
 	}
 
 	_, _, err = core_util.PatchPod(op.kubeClient, pod, func(in *core.Pod) *core.Pod {
@@ -0,0 +1,134 @@
+package operator
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+func (op *Operator) initServiceAlertWatcher() {
+	op.saInformer = op.monInformerFactory.Monitoring().V1alpha1().ServiceAlerts().Informer()
+	op.saQueue = queue.New("ServiceAlert", op.MaxNumRequeues, op.NumThreads, op.reconcileServiceAlert)
+	op.saInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			alert := obj.(*api.ServiceAlert)
+			if op.isValid(alert) {
+				queue.Enqueue(op.saQueue.GetQueue(), obj)
+			}
+		},
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*api.ServiceAlert)
+			nu := newObj.(*api.ServiceAlert)
+
+			if reflect.DeepEqual(old.Spec, nu.Spec) {
+				return
+			}
+			if op.isValid(nu) {
+				queue.Enqueue(op.saQueue.GetQueue(), nu)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			queue.Enqueue(op.saQueue.GetQueue(), obj)
+		},
+	})
+	op.saLister = op.monInformerFactory.Monitoring().V1alpha1().ServiceAlerts().Lister()
+}
+
+func (op *Operator) reconcileServiceAlert(key string) error {
+	obj, exists, err := op.saInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		log.Warningf("ServiceAlert %s does not exist anymore\n", key)
+
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return err
+		}
+		op.resolveIncidents(incidentFilter{namespace: namespace, alert: name, alertType: icinga.TypeService}, "ServiceAlert is deleted")
+		return op.ensureServiceAlertDeleted(namespace, name)
+	}
+
+	alert := obj.(*api.ServiceAlert).DeepCopy()
+	log.Infof("Sync/Add/Update for ServiceAlert %s\n", alert.GetName())
+
+	op.ensureServiceAlert(alert)
+	op.ensureServiceAlertDeleted(alert.Namespace, alert.Name)
+	if alert.Spec.Paused {
+		op.resolveIncidents(incidentFilter{namespace: alert.Namespace, alert: alert.Name, alertType: icinga.TypeService}, "ServiceAlert is paused")
+	}
+	return nil
+}
+
+func (op *Operator) ensureServiceAlert(alert *api.ServiceAlert) error {
+	if alert.Spec.ServiceName != nil {
+		svc, err := op.svcLister.Services(alert.Namespace).Get(*alert.Spec.ServiceName)
+		if err != nil {
+			return err
+		}
+		key, err := cache.MetaNamespaceKeyFunc(svc)
+		if err == nil {
+			op.svcQueue.GetQueue().Add(key)
+		}
+		return nil
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(alert.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	services, err := op.svcLister.Services(alert.Namespace).List(sel)
+	if err != nil {
+		return err
+	}
+	for i := range services {
+		svc := services[i]
+		key, err := cache.MetaNamespaceKeyFunc(svc)
+		if err == nil {
+			op.svcQueue.GetQueue().Add(key)
+		}
+	}
+	return nil
+}
+
+func alertAppliedToService(a map[string]string, key string) bool {
+	if a == nil {
+		return false
+	}
+	if val, ok := a[api.AnnotationKeyAlerts]; ok {
+		names := strings.Split(val, ",")
+		for _, name := range names {
+			if name == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (op *Operator) ensureServiceAlertDeleted(alertNamespace, alertName string) error {
+	services, err := op.svcLister.Services(alertNamespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, svc := range services {
+		if alertAppliedToService(svc.Annotations, alertName) {
+			key, err := cache.MetaNamespaceKeyFunc(svc)
+			if err == nil {
+				op.svcQueue.GetQueue().Add(key)
+			}
+		}
+	}
+	return nil
+}
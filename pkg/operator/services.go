@@ -0,0 +1,165 @@
+package operator
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/appscode/go/log"
+	utilerrors "github.com/appscode/go/util/errors"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/eventer"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/golang/glog"
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	core_util "kmodules.xyz/client-go/core/v1"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+func (op *Operator) initServiceWatcher() {
+	op.svcInformer = op.kubeInformerFactory.Core().V1().Services().Informer()
+	op.svcQueue = queue.New("Service", op.MaxNumRequeues, op.NumThreads, op.reconcileService)
+	op.svcInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			queue.Enqueue(op.svcQueue.GetQueue(), obj)
+		},
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*core.Service)
+			nu := newObj.(*core.Service)
+			if !reflect.DeepEqual(old.Labels, nu.Labels) || old.Spec.ClusterIP != nu.Spec.ClusterIP {
+				queue.Enqueue(op.svcQueue.GetQueue(), newObj)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			queue.Enqueue(op.svcQueue.GetQueue(), obj)
+		},
+	})
+	op.svcLister = op.kubeInformerFactory.Core().V1().Services().Lister()
+}
+
+// initEndpointsWatcher requeues the Service of changed Endpoints, so that the status of ServiceAlerts
+// applied to it is refreshed. Endpoints have the same namespace and name as their Service.
+func (op *Operator) initEndpointsWatcher() {
+	op.epInformer = op.kubeInformerFactory.Core().V1().Endpoints().Informer()
+	op.epInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*core.Endpoints)
+			nu := newObj.(*core.Endpoints)
+			if reflect.DeepEqual(old.Subsets, nu.Subsets) {
+				return
+			}
+			svc, err := op.svcLister.Services(nu.Namespace).Get(nu.Name)
+			if err != nil {
+				return
+			}
+			if _, found := svc.Annotations[api.AnnotationKeyAlerts]; found {
+				queue.Enqueue(op.svcQueue.GetQueue(), svc)
+			}
+		},
+	})
+}
+
+func (op *Operator) reconcileService(key string) error {
+	obj, exists, err := op.svcInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		log.Debugf("Service %s does not exist anymore\n", key)
+
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return err
+		}
+		op.removeServiceFromAlertStatus(namespace, name)
+		op.resolveIncidents(incidentFilter{namespace: namespace, alertType: icinga.TypeService, objectName: name}, "Service is deleted")
+		return op.serviceHost.ForceDeleteIcingaHost(icinga.IcingaHost{
+			Type:           icinga.TypeService,
+			AlertNamespace: namespace,
+			ObjectName:     name,
+		})
+	}
+
+	log.Infof("Sync/Add/Update for Service %s\n", key)
+	svc := obj.(*core.Service).DeepCopy()
+	err = op.ensureService(svc)
+	if err != nil {
+		log.Errorf("failed to reconcile alert for service %s. reason: %s", key, err)
+	}
+	return err
+}
+
+func (op *Operator) ensureService(svc *core.Service) error {
+	var errlist []error
+
+	oldAlerts := sets.NewString()
+	if val, ok := svc.Annotations[api.AnnotationKeyAlerts]; ok {
+		names := strings.Split(val, ",")
+		oldAlerts.Insert(names...)
+	}
+
+	newAlerts, err := findServiceAlert(op.kubeClient, op.saLister, svc.ObjectMeta)
+	if err != nil {
+		return err
+	}
+	newNames := make([]string, len(newAlerts))
+	for i := range newAlerts {
+		alert := newAlerts[i]
+
+		err = op.serviceHost.Apply(alert, svc)
+		if err != nil {
+			op.recorder.Eventf(
+				alert.ObjectReference(),
+				core.EventTypeWarning,
+				eventer.EventReasonFailedToSync,
+				`failed to  apply to service %s/%s. Reason: %s`,
+				svc.Namespace, svc.Name, err,
+			)
+			errlist = append(errlist, err)
+		}
+		op.setServiceAlertTarget(alert, svc, err)
+
+		newNames[i] = alert.Name
+		if oldAlerts.Has(alert.Name) {
+			oldAlerts.Delete(alert.Name)
+		}
+	}
+
+	for _, name := range oldAlerts.List() {
+		err = op.serviceHost.Delete(svc.Namespace, name, svc)
+		if err != nil {
+			if alert, e2 := op.saLister.ServiceAlerts(svc.Namespace).Get(name); e2 == nil {
+				op.recorder.Eventf(
+					alert.ObjectReference(),
+					core.EventTypeWarning,
+					eventer.EventReasonFailedToDelete,
+					`failed to  delete for service %s/%s. Reason: %s`,
+					svc.Namespace, svc.Name, err,
+				)
+			}
+			errlist = append(errlist, err)
+			continue
+		}
+		op.removeServiceAlertTarget(svc.Namespace, name, svc.Name)
+		op.resolveIncidents(incidentFilter{namespace: svc.Namespace, alert: name, alertType: icinga.TypeService, objectName: svc.Name}, "Service is not selected by ServiceAlert")
+	}
+
+	_, _, err = core_util.PatchService(op.kubeClient, svc, func(in *core.Service) *core.Service {
+		if in.Annotations == nil {
+			in.Annotations = make(map[string]string, 0)
+		}
+		if len(newNames) > 0 {
+			in.Annotations[api.AnnotationKeyAlerts] = strings.Join(newNames, ",")
+		} else {
+			delete(in.Annotations, api.AnnotationKeyAlerts)
+		}
+		return in
+	})
+	if err != nil {
+		errlist = append(errlist, err)
+	}
+	return utilerrors.NewAggregate(errlist)
+}
@@ -0,0 +1,455 @@
+package operator
+
+import (
+	"reflect"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	util "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/util"
+	"github.com/appscode/searchlight/pkg/eventer"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// setSyncConditions records the result of applying a valid alert to Icinga.
+func setSyncConditions(status *api.AlertStatus, generation int64, paused bool, syncErr error) {
+	status.ObservedGeneration = generation
+
+	if syncErr != nil {
+		status.SetCondition(api.AlertCondition{
+			Type:    api.AlertConditionSynced,
+			Status:  core.ConditionFalse,
+			Reason:  eventer.EventReasonFailedToSync,
+			Message: syncErr.Error(),
+		})
+	} else {
+		status.SetCondition(api.AlertCondition{
+			Type:   api.AlertConditionSynced,
+			Status: core.ConditionTrue,
+			Reason: eventer.EventReasonSuccessfulSync,
+		})
+	}
+
+	if paused {
+		status.SetCondition(api.AlertCondition{
+			Type:    api.AlertConditionPaused,
+			Status:  core.ConditionTrue,
+			Reason:  "Paused",
+			Message: "Icinga services are removed",
+		})
+	} else {
+		status.SetCondition(api.AlertCondition{
+			Type:   api.AlertConditionPaused,
+			Status: core.ConditionFalse,
+		})
+	}
+
+	status.SetCondition(api.AlertCondition{
+		Type:   api.AlertConditionInvalidCommand,
+		Status: core.ConditionFalse,
+	})
+}
+
+// setValidityConditions records the result of alert validation. Invalid alerts are not synced.
+func setValidityConditions(status *api.AlertStatus, generation int64, err error) {
+	if err == nil {
+		status.SetCondition(api.AlertCondition{
+			Type:   api.AlertConditionInvalidCommand,
+			Status: core.ConditionFalse,
+		})
+		return
+	}
+
+	status.ObservedGeneration = generation
+	status.SetCondition(api.AlertCondition{
+		Type:    api.AlertConditionInvalidCommand,
+		Status:  core.ConditionTrue,
+		Reason:  eventer.EventReasonAlertInvalid,
+		Message: err.Error(),
+	})
+	status.SetCondition(api.AlertCondition{
+		Type:    api.AlertConditionSynced,
+		Status:  core.ConditionFalse,
+		Reason:  eventer.EventReasonAlertInvalid,
+		Message: err.Error(),
+	})
+}
+
+func (op *Operator) setAlertValidity(alert api.Alert, err error) {
+	var status api.AlertStatus
+	var generation int64
+	switch a := alert.(type) {
+	case *api.ClusterAlert:
+		status, generation = a.Status, a.Generation
+	case *api.NodeAlert:
+		status, generation = a.Status, a.Generation
+	case *api.PodAlert:
+		status, generation = a.Status, a.Generation
+	case *api.ServiceAlert:
+		status, generation = a.Status, a.Generation
+	case *api.WorkloadAlert:
+		status, generation = a.Status, a.Generation
+	default:
+		return
+	}
+
+	// Only write status when InvalidCommand condition needs to change.
+	// Missing condition is treated as False.
+	_, cond := status.GetCondition(api.AlertConditionInvalidCommand)
+	invalid := cond != nil && cond.Status == core.ConditionTrue
+	if err == nil && !invalid {
+		return
+	}
+	if err != nil && invalid && cond.Message == err.Error() {
+		return
+	}
+
+	transform := func(in *api.AlertStatus) *api.AlertStatus {
+		setValidityConditions(in, generation, err)
+		return in
+	}
+
+	var e2 error
+	switch a := alert.(type) {
+	case *api.ClusterAlert:
+		e2 = op.updateClusterAlertStatus(a.Namespace, a.Name, transform)
+	case *api.NodeAlert:
+		e2 = op.updateNodeAlertStatus(a.Namespace, a.Name, transform)
+	case *api.PodAlert:
+		e2 = op.updatePodAlertStatus(a.Namespace, a.Name, transform)
+	case *api.ServiceAlert:
+		e2 = op.updateServiceAlertStatus(a.Namespace, a.Name, transform)
+	case *api.WorkloadAlert:
+		e2 = op.updateWorkloadAlertStatus(a.Namespace, a.Name, transform)
+	}
+	if e2 != nil {
+		log.Errorf("failed to update status of %s %s/%s. Reason: %v", alert.ObjectReference().Kind, alert.GetNamespace(), alert.GetName(), e2)
+	}
+}
+
+func (op *Operator) setClusterAlertStatus(alert *api.ClusterAlert, syncErr error) {
+	target := &api.TargetStatus{}
+	if syncErr == nil && !alert.Spec.Paused {
+		if t, err := op.clusterHost.GetStatus(alert); err != nil {
+			log.Errorf("failed to get Icinga state for ClusterAlert %s/%s. Reason: %v", alert.Namespace, alert.Name, err)
+		} else {
+			target = t
+		}
+	}
+
+	err := op.updateClusterAlertStatus(alert.Namespace, alert.Name, func(in *api.AlertStatus) *api.AlertStatus {
+		setSyncConditions(in, alert.Generation, alert.Spec.Paused, syncErr)
+		setTarget(in, *target)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of ClusterAlert %s/%s. Reason: %v", alert.Namespace, alert.Name, err)
+	}
+}
+
+// setTarget sets the status of target, unless its state is unchanged. Output and time of the last check
+// are only updated with the state, so that resyncs do not write status of every alert for every check.
+func setTarget(status *api.AlertStatus, target api.TargetStatus) {
+	for _, t := range status.Targets {
+		if t.Name == target.Name && t.State == target.State {
+			return
+		}
+	}
+	status.SetTarget(target)
+}
+
+func (op *Operator) setPodAlertTarget(alert *api.PodAlert, pod *core.Pod, syncErr error) {
+	target := &api.TargetStatus{}
+	if syncErr == nil && !alert.Spec.Paused {
+		if t, err := op.podHost.GetStatus(alert, pod); err != nil {
+			log.Errorf("failed to get Icinga state for PodAlert %s/%s on pod %s/%s. Reason: %v", alert.Namespace, alert.Name, pod.Namespace, pod.Name, err)
+		} else {
+			target = t
+		}
+	}
+	target.Name = api.PodTargetName(alert.Namespace, pod.Namespace, pod.Name)
+
+	err := op.updatePodAlertStatus(alert.Namespace, alert.Name, func(in *api.AlertStatus) *api.AlertStatus {
+		setSyncConditions(in, alert.Generation, alert.Spec.Paused, syncErr)
+		setTarget(in, *target)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of PodAlert %s/%s. Reason: %v", alert.Namespace, alert.Name, err)
+	}
+}
+
+func (op *Operator) removePodAlertTarget(namespace, alertName, podName string) {
+	err := op.updatePodAlertStatus(namespace, alertName, func(in *api.AlertStatus) *api.AlertStatus {
+		in.RemoveTarget(podName)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of PodAlert %s/%s. Reason: %v", namespace, alertName, err)
+	}
+}
+
+// removePodFromAlertStatus removes a deleted pod from the status of all PodAlerts applied to it.
+func (op *Operator) removePodFromAlertStatus(namespace, podName string) {
+	alerts, err := op.paLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, alert := range alerts {
+		if alert.Namespace != namespace && alert.Spec.NamespaceSelector == nil {
+			continue
+		}
+		name := api.PodTargetName(alert.Namespace, namespace, podName)
+		for _, t := range alert.Status.Targets {
+			if t.Name == name {
+				op.removePodAlertTarget(alert.Namespace, alert.Name, name)
+				break
+			}
+		}
+	}
+}
+
+func (op *Operator) setServiceAlertTarget(alert *api.ServiceAlert, svc *core.Service, syncErr error) {
+	target := &api.TargetStatus{Name: svc.Name}
+	if syncErr == nil && !alert.Spec.Paused {
+		if t, err := op.serviceHost.GetStatus(alert, svc); err != nil {
+			log.Errorf("failed to get Icinga state for ServiceAlert %s/%s on service %s. Reason: %v", alert.Namespace, alert.Name, svc.Name, err)
+		} else {
+			target = t
+		}
+	}
+
+	err := op.updateServiceAlertStatus(alert.Namespace, alert.Name, func(in *api.AlertStatus) *api.AlertStatus {
+		setSyncConditions(in, alert.Generation, alert.Spec.Paused, syncErr)
+		setTarget(in, *target)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of ServiceAlert %s/%s. Reason: %v", alert.Namespace, alert.Name, err)
+	}
+}
+
+func (op *Operator) removeServiceAlertTarget(namespace, alertName, serviceName string) {
+	err := op.updateServiceAlertStatus(namespace, alertName, func(in *api.AlertStatus) *api.AlertStatus {
+		in.RemoveTarget(serviceName)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of ServiceAlert %s/%s. Reason: %v", namespace, alertName, err)
+	}
+}
+
+// removeServiceFromAlertStatus removes a deleted service from the status of all ServiceAlerts in its namespace.
+func (op *Operator) removeServiceFromAlertStatus(namespace, serviceName string) {
+	alerts, err := op.saLister.ServiceAlerts(namespace).List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, alert := range alerts {
+		for _, t := range alert.Status.Targets {
+			if t.Name == serviceName {
+				op.removeServiceAlertTarget(alert.Namespace, alert.Name, serviceName)
+				break
+			}
+		}
+	}
+}
+
+// workloadTargetName is the name of a workload in status of WorkloadAlerts, eg, Deployment/nginx.
+func workloadTargetName(kind, name string) string {
+	return kind + "/" + name
+}
+
+func (op *Operator) setWorkloadAlertTarget(alert *api.WorkloadAlert, w metav1.ObjectMeta, syncErr error) {
+	target := &api.TargetStatus{}
+	if syncErr == nil && !alert.Spec.Paused {
+		if t, err := op.workloadHost.GetStatus(alert, w); err != nil {
+			log.Errorf("failed to get Icinga state for WorkloadAlert %s/%s on %s %s. Reason: %v", alert.Namespace, alert.Name, alert.Spec.WorkloadKind, w.Name, err)
+		} else {
+			target = t
+		}
+	}
+	target.Name = workloadTargetName(alert.Spec.WorkloadKind, w.Name)
+
+	err := op.updateWorkloadAlertStatus(alert.Namespace, alert.Name, func(in *api.AlertStatus) *api.AlertStatus {
+		setSyncConditions(in, alert.Generation, alert.Spec.Paused, syncErr)
+		setTarget(in, *target)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of WorkloadAlert %s/%s. Reason: %v", alert.Namespace, alert.Name, err)
+	}
+}
+
+func (op *Operator) removeWorkloadAlertTarget(namespace, alertName, targetName string) {
+	err := op.updateWorkloadAlertStatus(namespace, alertName, func(in *api.AlertStatus) *api.AlertStatus {
+		in.RemoveTarget(targetName)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of WorkloadAlert %s/%s. Reason: %v", namespace, alertName, err)
+	}
+}
+
+// removeWorkloadFromAlertStatus removes a deleted workload from the status of all WorkloadAlerts in its namespace.
+func (op *Operator) removeWorkloadFromAlertStatus(namespace, kind, name string) {
+	alerts, err := op.waLister.WorkloadAlerts(namespace).List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	targetName := workloadTargetName(kind, name)
+	for _, alert := range alerts {
+		for _, t := range alert.Status.Targets {
+			if t.Name == targetName {
+				op.removeWorkloadAlertTarget(alert.Namespace, alert.Name, targetName)
+				break
+			}
+		}
+	}
+}
+
+func (op *Operator) setNodeAlertTarget(alert *api.NodeAlert, node *core.Node, syncErr error) {
+	target := &api.TargetStatus{Name: node.Name}
+	if syncErr == nil && !alert.Spec.Paused {
+		if t, err := op.nodeHost.GetStatus(alert, node); err != nil {
+			log.Errorf("failed to get Icinga state for NodeAlert %s/%s on node %s. Reason: %v", alert.Namespace, alert.Name, node.Name, err)
+		} else {
+			target = t
+		}
+	}
+
+	err := op.updateNodeAlertStatus(alert.Namespace, alert.Name, func(in *api.AlertStatus) *api.AlertStatus {
+		setSyncConditions(in, alert.Generation, alert.Spec.Paused, syncErr)
+		setTarget(in, *target)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of NodeAlert %s/%s. Reason: %v", alert.Namespace, alert.Name, err)
+	}
+}
+
+func (op *Operator) removeNodeAlertTarget(namespace, alertName, nodeName string) {
+	err := op.updateNodeAlertStatus(namespace, alertName, func(in *api.AlertStatus) *api.AlertStatus {
+		in.RemoveTarget(nodeName)
+		return in
+	})
+	if err != nil {
+		log.Errorf("failed to update status of NodeAlert %s/%s. Reason: %v", namespace, alertName, err)
+	}
+}
+
+// removeNodeFromAlertStatus removes a deleted node from the status of all NodeAlerts.
+func (op *Operator) removeNodeFromAlertStatus(nodeName string) {
+	alerts, err := op.naLister.List(labels.Everything())
+	if err != nil {
+		log.Errorln(err)
+		return
+	}
+	for _, alert := range alerts {
+		for _, t := range alert.Status.Targets {
+			if t.Name == nodeName {
+				op.removeNodeAlertTarget(alert.Namespace, alert.Name, nodeName)
+				break
+			}
+		}
+	}
+}
+
+// Alert status is first compared against the cached object, so that the API server is only called when
+// status changes. It is then computed from the latest object, since informer cache may not yet include
+// status written while processing previous targets.
+
+// statusChanged returns true, if transform changes status.
+func statusChanged(status api.AlertStatus, transform func(*api.AlertStatus) *api.AlertStatus) bool {
+	return !reflect.DeepEqual(status, *transform(status.DeepCopy()))
+}
+
+func (op *Operator) updateClusterAlertStatus(namespace, name string, transform func(*api.AlertStatus) *api.AlertStatus) error {
+	if cached, err := op.caLister.ClusterAlerts(namespace).Get(name); err == nil && !statusChanged(cached.Status, transform) {
+		return nil
+	}
+	cur, err := op.extClient.MonitoringV1alpha1().ClusterAlerts(namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !statusChanged(cur.Status, transform) {
+		return nil
+	}
+	_, err = util.UpdateClusterAlertStatus(op.extClient.MonitoringV1alpha1(), cur, transform, api.EnableStatusSubresource)
+	return err
+}
+
+func (op *Operator) updateNodeAlertStatus(namespace, name string, transform func(*api.AlertStatus) *api.AlertStatus) error {
+	if cached, err := op.naLister.NodeAlerts(namespace).Get(name); err == nil && !statusChanged(cached.Status, transform) {
+		return nil
+	}
+	cur, err := op.extClient.MonitoringV1alpha1().NodeAlerts(namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !statusChanged(cur.Status, transform) {
+		return nil
+	}
+	_, err = util.UpdateNodeAlertStatus(op.extClient.MonitoringV1alpha1(), cur, transform, api.EnableStatusSubresource)
+	return err
+}
+
+func (op *Operator) updatePodAlertStatus(namespace, name string, transform func(*api.AlertStatus) *api.AlertStatus) error {
+	if cached, err := op.paLister.PodAlerts(namespace).Get(name); err == nil && !statusChanged(cached.Status, transform) {
+		return nil
+	}
+	cur, err := op.extClient.MonitoringV1alpha1().PodAlerts(namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !statusChanged(cur.Status, transform) {
+		return nil
+	}
+	_, err = util.UpdatePodAlertStatus(op.extClient.MonitoringV1alpha1(), cur, transform, api.EnableStatusSubresource)
+	return err
+}
+
+func (op *Operator) updateServiceAlertStatus(namespace, name string, transform func(*api.AlertStatus) *api.AlertStatus) error {
+	if cached, err := op.saLister.ServiceAlerts(namespace).Get(name); err == nil && !statusChanged(cached.Status, transform) {
+		return nil
+	}
+	cur, err := op.extClient.MonitoringV1alpha1().ServiceAlerts(namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !statusChanged(cur.Status, transform) {
+		return nil
+	}
+	_, err = util.UpdateServiceAlertStatus(op.extClient.MonitoringV1alpha1(), cur, transform, api.EnableStatusSubresource)
+	return err
+}
+
+func (op *Operator) updateWorkloadAlertStatus(namespace, name string, transform func(*api.AlertStatus) *api.AlertStatus) error {
+	if cached, err := op.waLister.WorkloadAlerts(namespace).Get(name); err == nil && !statusChanged(cached.Status, transform) {
+		return nil
+	}
+	cur, err := op.extClient.MonitoringV1alpha1().WorkloadAlerts(namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !statusChanged(cur.Status, transform) {
+		return nil
+	}
+	_, err = util.UpdateWorkloadAlertStatus(op.extClient.MonitoringV1alpha1(), cur, transform, api.EnableStatusSubresource)
+	return err
+}
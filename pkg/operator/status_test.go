@@ -0,0 +1,31 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSetTarget(t *testing.T) {
+	checked := metav1.NewTime(time.Now().Add(-time.Minute))
+	status := api.AlertStatus{
+		MatchedTargets: 1,
+		Targets:        []api.TargetStatus{{Name: "node-1", State: "OK", LastCheckOutput: "Ready", LastCheckTime: &checked}},
+	}
+	transform := func(target api.TargetStatus) func(*api.AlertStatus) *api.AlertStatus {
+		return func(in *api.AlertStatus) *api.AlertStatus {
+			setTarget(in, target)
+			return in
+		}
+	}
+
+	// a later check with the same state does not change status
+	now := metav1.Now()
+	assert.False(t, statusChanged(status, transform(api.TargetStatus{Name: "node-1", State: "OK", LastCheckOutput: "Ready", LastCheckTime: &now})))
+
+	assert.True(t, statusChanged(status, transform(api.TargetStatus{Name: "node-1", State: "Critical", LastCheckTime: &now})))
+	assert.True(t, statusChanged(status, transform(api.TargetStatus{Name: "node-2", State: "OK", LastCheckTime: &now})))
+}
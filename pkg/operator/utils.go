@@ -7,13 +7,24 @@ import (
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
+	core_listers "k8s.io/client-go/listers/core/v1"
 )
 
 func (op *Operator) isValid(alert api.Alert) bool {
 	// Validate IcingaCommand & it's variables.
 	// And also check supported IcingaState
-	err := alert.IsValid(op.kubeClient)
+	var err error
+	if t, ok := alert.(api.TemplatedAlert); ok && t.GetTemplateRef() != nil {
+		// alert is validated together with its template, on a copy of the object in informer cache
+		t = t.(runtime.Object).DeepCopyObject().(api.TemplatedAlert)
+		if err = setTemplateDefaults(op.atLister, t); err == nil {
+			err = t.IsValid(op.kubeClient)
+		}
+	} else {
+		err = alert.IsValid(op.kubeClient)
+	}
 	if err != nil {
 		op.recorder.Eventf(
 			alert.ObjectReference(),
@@ -23,18 +34,45 @@ func (op *Operator) isValid(alert api.Alert) bool {
 			err,
 		)
 	}
+	op.setAlertValidity(alert, err)
 	return err == nil
 }
 
-func findPodAlert(kc kubernetes.Interface, lister mon_listers.PodAlertLister, obj metav1.ObjectMeta) ([]*api.PodAlert, error) {
-	alerts, err := lister.PodAlerts(obj.Namespace).List(labels.Everything())
+// findPodAlert returns PodAlerts in the namespace of pod, and PodAlerts from any namespace
+// whose namespaceSelector matches the namespace of pod.
+func findPodAlert(kc kubernetes.Interface, lister mon_listers.PodAlertLister, atLister mon_listers.AlertTemplateLister, nsLister core_listers.NamespaceLister, obj metav1.ObjectMeta) ([]*api.PodAlert, error) {
+	alerts, err := lister.List(labels.Everything())
 	if err != nil {
 		return nil, err
 	}
 
+	var nsLabels labels.Set
 	result := make([]*api.PodAlert, 0)
 	for i := range alerts {
 		alert := alerts[i]
+		if alert.Spec.NamespaceSelector == nil {
+			if alert.Namespace != obj.Namespace {
+				continue
+			}
+		} else {
+			if nsLabels == nil {
+				ns, err := nsLister.Get(obj.Namespace)
+				if err != nil {
+					return nil, err
+				}
+				nsLabels = labels.Set(ns.Labels)
+			}
+			selector, err := metav1.LabelSelectorAsSelector(alert.Spec.NamespaceSelector)
+			if err != nil || !selector.Matches(nsLabels) {
+				continue
+			}
+		}
+		if alert.Spec.TemplateRef != nil {
+			alert = alert.DeepCopy()
+			if err := setTemplateDefaults(atLister, alert); err != nil {
+				continue
+			}
+		}
 		if err := alert.IsValid(kc); err != nil {
 			continue
 		}
@@ -54,7 +92,66 @@ func findPodAlert(kc kubernetes.Interface, lister mon_listers.PodAlertLister, ob
 	return result, nil
 }
 
-func findNodeAlert(kc kubernetes.Interface, lister mon_listers.NodeAlertLister, obj metav1.ObjectMeta) ([]*api.NodeAlert, error) {
+func findServiceAlert(kc kubernetes.Interface, lister mon_listers.ServiceAlertLister, obj metav1.ObjectMeta) ([]*api.ServiceAlert, error) {
+	alerts, err := lister.ServiceAlerts(obj.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*api.ServiceAlert, 0)
+	for i := range alerts {
+		alert := alerts[i]
+		if err := alert.IsValid(kc); err != nil {
+			continue
+		}
+
+		if alert.Spec.ServiceName != nil {
+			if *alert.Spec.ServiceName == obj.Name {
+				result = append(result, alert)
+			}
+		} else if alert.Spec.Selector != nil {
+			if selector, err := metav1.LabelSelectorAsSelector(alert.Spec.Selector); err == nil {
+				if selector.Matches(labels.Set(obj.Labels)) {
+					result = append(result, alert)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func findWorkloadAlert(kc kubernetes.Interface, lister mon_listers.WorkloadAlertLister, kind string, obj metav1.ObjectMeta) ([]*api.WorkloadAlert, error) {
+	alerts, err := lister.WorkloadAlerts(obj.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*api.WorkloadAlert, 0)
+	for i := range alerts {
+		alert := alerts[i]
+		if alert.Spec.WorkloadKind != kind {
+			continue
+		}
+		if err := alert.IsValid(kc); err != nil {
+			continue
+		}
+
+		if alert.Spec.WorkloadName != nil {
+			if *alert.Spec.WorkloadName == obj.Name {
+				result = append(result, alert)
+			}
+		} else if alert.Spec.Selector != nil {
+			if selector, err := metav1.LabelSelectorAsSelector(alert.Spec.Selector); err == nil {
+				if selector.Matches(labels.Set(obj.Labels)) {
+					result = append(result, alert)
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+func findNodeAlert(kc kubernetes.Interface, lister mon_listers.NodeAlertLister, atLister mon_listers.AlertTemplateLister, obj metav1.ObjectMeta) ([]*api.NodeAlert, error) {
 	alerts, err := lister.NodeAlerts(obj.Namespace).List(labels.Everything())
 	if err != nil {
 		return nil, err
@@ -63,6 +160,12 @@ func findNodeAlert(kc kubernetes.Interface, lister mon_listers.NodeAlertLister,
 	result := make([]*api.NodeAlert, 0)
 	for i := range alerts {
 		alert := alerts[i]
+		if alert.Spec.TemplateRef != nil {
+			alert = alert.DeepCopy()
+			if err := setTemplateDefaults(atLister, alert); err != nil {
+				continue
+			}
+		}
 		if err := alert.IsValid(kc); err != nil {
 			continue
 		}
@@ -72,7 +175,10 @@ func findNodeAlert(kc kubernetes.Interface, lister mon_listers.NodeAlertLister,
 				result = append(result, alert)
 			}
 		} else {
-			selector := labels.SelectorFromSet(alert.Spec.Selector)
+			selector, err := alert.NodeSelector()
+			if err != nil {
+				continue
+			}
 			if selector.Matches(labels.Set(obj.Labels)) {
 				result = append(result, alert)
 			}
@@ -0,0 +1,128 @@
+package operator
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/golang/glog"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+func (op *Operator) initWorkloadAlertWatcher() {
+	op.waInformer = op.monInformerFactory.Monitoring().V1alpha1().WorkloadAlerts().Informer()
+	op.waQueue = queue.New("WorkloadAlert", op.MaxNumRequeues, op.NumThreads, op.reconcileWorkloadAlert)
+	op.waInformer.AddEventHandler(&cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			alert := obj.(*api.WorkloadAlert)
+			if op.isValid(alert) {
+				queue.Enqueue(op.waQueue.GetQueue(), obj)
+			}
+		},
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old := oldObj.(*api.WorkloadAlert)
+			nu := newObj.(*api.WorkloadAlert)
+
+			if reflect.DeepEqual(old.Spec, nu.Spec) {
+				return
+			}
+			if op.isValid(nu) {
+				queue.Enqueue(op.waQueue.GetQueue(), nu)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			queue.Enqueue(op.waQueue.GetQueue(), obj)
+		},
+	})
+	op.waLister = op.monInformerFactory.Monitoring().V1alpha1().WorkloadAlerts().Lister()
+}
+
+func (op *Operator) reconcileWorkloadAlert(key string) error {
+	obj, exists, err := op.waInformer.GetIndexer().GetByKey(key)
+	if err != nil {
+		glog.Errorf("Fetching object with key %s from store failed with %v", key, err)
+		return err
+	}
+
+	if !exists {
+		log.Warningf("WorkloadAlert %s does not exist anymore\n", key)
+
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
+		if err != nil {
+			return err
+		}
+		op.resolveIncidents(incidentFilter{namespace: namespace, alert: name, alertType: icinga.TypeWorkload}, "WorkloadAlert is deleted")
+		return op.ensureWorkloadAlertDeleted(namespace, name)
+	}
+
+	alert := obj.(*api.WorkloadAlert).DeepCopy()
+	log.Infof("Sync/Add/Update for WorkloadAlert %s\n", alert.GetName())
+
+	op.ensureWorkloadAlert(alert)
+	op.ensureWorkloadAlertDeleted(alert.Namespace, alert.Name)
+	if alert.Spec.Paused {
+		op.resolveIncidents(incidentFilter{namespace: alert.Namespace, alert: alert.Name, alertType: icinga.TypeWorkload}, "WorkloadAlert is paused")
+	}
+	return nil
+}
+
+func (op *Operator) ensureWorkloadAlert(alert *api.WorkloadAlert) error {
+	kind := alert.Spec.WorkloadKind
+	if alert.Spec.WorkloadName != nil {
+		w, err := op.getWorkload(kind, alert.Namespace, *alert.Spec.WorkloadName)
+		if err != nil {
+			return err
+		}
+		op.workloadQueue.GetQueue().Add(workloadKey(kind, *w))
+		return nil
+	}
+
+	sel, err := metav1.LabelSelectorAsSelector(alert.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	workloads, err := op.listWorkloads(kind, alert.Namespace, sel)
+	if err != nil {
+		return err
+	}
+	for _, w := range workloads {
+		op.workloadQueue.GetQueue().Add(workloadKey(kind, w))
+	}
+	return nil
+}
+
+func alertAppliedToWorkload(a map[string]string, key string) bool {
+	if a == nil {
+		return false
+	}
+	if val, ok := a[api.AnnotationKeyAlerts]; ok {
+		names := strings.Split(val, ",")
+		for _, name := range names {
+			if name == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ensureWorkloadAlertDeleted requeues workloads of all kinds, since kind of a deleted alert is not known.
+func (op *Operator) ensureWorkloadAlertDeleted(alertNamespace, alertName string) error {
+	for _, kind := range []string{api.WorkloadKindDeployment, api.WorkloadKindStatefulSet, api.WorkloadKindDaemonSet} {
+		workloads, err := op.listWorkloads(kind, alertNamespace, labels.Everything())
+		if err != nil {
+			return err
+		}
+		for _, w := range workloads {
+			if alertAppliedToWorkload(w.Annotations, alertName) {
+				op.workloadQueue.GetQueue().Add(workloadKey(kind, w))
+			}
+		}
+	}
+	return nil
+}
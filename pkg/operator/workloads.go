@@ -0,0 +1,271 @@
+package operator
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/appscode/go/log"
+	utilerrors "github.com/appscode/go/util/errors"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/eventer"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/pkg/errors"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+	"kmodules.xyz/client-go/tools/queue"
+)
+
+func (op *Operator) initWorkloadWatcher() {
+	op.workloadQueue = queue.New("Workload", op.MaxNumRequeues, op.NumThreads, op.reconcileWorkload)
+
+	op.deployInformer = op.kubeInformerFactory.Apps().V1().Deployments().Informer()
+	op.deployInformer.AddEventHandler(op.workloadEventHandler(api.WorkloadKindDeployment, func(obj interface{}) interface{} {
+		return obj.(*apps.Deployment).Status
+	}))
+	op.deployLister = op.kubeInformerFactory.Apps().V1().Deployments().Lister()
+
+	op.stsInformer = op.kubeInformerFactory.Apps().V1().StatefulSets().Informer()
+	op.stsInformer.AddEventHandler(op.workloadEventHandler(api.WorkloadKindStatefulSet, func(obj interface{}) interface{} {
+		return obj.(*apps.StatefulSet).Status
+	}))
+	op.stsLister = op.kubeInformerFactory.Apps().V1().StatefulSets().Lister()
+
+	op.dsInformer = op.kubeInformerFactory.Apps().V1().DaemonSets().Informer()
+	op.dsInformer.AddEventHandler(op.workloadEventHandler(api.WorkloadKindDaemonSet, func(obj interface{}) interface{} {
+		return obj.(*apps.DaemonSet).Status
+	}))
+	op.dsLister = op.kubeInformerFactory.Apps().V1().DaemonSets().Lister()
+}
+
+// workloadEventHandler enqueues workloads of kind when labels change. Workloads with alerts
+// are also requeued when their status changes, so that the status of WorkloadAlerts is refreshed.
+func (op *Operator) workloadEventHandler(kind string, statusOf func(obj interface{}) interface{}) cache.ResourceEventHandler {
+	enqueue := func(obj interface{}) {
+		if key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj); err == nil {
+			op.workloadQueue.GetQueue().Add(kind + "/" + key)
+		}
+	}
+	return &cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+			old, err := meta.Accessor(oldObj)
+			if err != nil {
+				return
+			}
+			nu, err := meta.Accessor(newObj)
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(old.GetLabels(), nu.GetLabels()) {
+				enqueue(newObj)
+				return
+			}
+			if _, found := nu.GetAnnotations()[api.AnnotationKeyAlerts]; found && !reflect.DeepEqual(statusOf(oldObj), statusOf(newObj)) {
+				enqueue(newObj)
+			}
+		},
+		DeleteFunc: enqueue,
+	}
+}
+
+func workloadKey(kind string, w metav1.ObjectMeta) string {
+	return kind + "/" + w.Namespace + "/" + w.Name
+}
+
+func (op *Operator) getWorkload(kind, namespace, name string) (*metav1.ObjectMeta, error) {
+	switch kind {
+	case api.WorkloadKindDeployment:
+		obj, err := op.deployLister.Deployments(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	case api.WorkloadKindStatefulSet:
+		obj, err := op.stsLister.StatefulSets(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	case api.WorkloadKindDaemonSet:
+		obj, err := op.dsLister.DaemonSets(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		return &obj.ObjectMeta, nil
+	}
+	return nil, errors.Errorf("unknown workload kind %s", kind)
+}
+
+func (op *Operator) listWorkloads(kind, namespace string, sel labels.Selector) ([]metav1.ObjectMeta, error) {
+	var result []metav1.ObjectMeta
+	switch kind {
+	case api.WorkloadKindDeployment:
+		objs, err := op.deployLister.Deployments(namespace).List(sel)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			result = append(result, obj.ObjectMeta)
+		}
+	case api.WorkloadKindStatefulSet:
+		objs, err := op.stsLister.StatefulSets(namespace).List(sel)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			result = append(result, obj.ObjectMeta)
+		}
+	case api.WorkloadKindDaemonSet:
+		objs, err := op.dsLister.DaemonSets(namespace).List(sel)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			result = append(result, obj.ObjectMeta)
+		}
+	default:
+		return nil, errors.Errorf("unknown workload kind %s", kind)
+	}
+	return result, nil
+}
+
+// patchWorkloadAlerts sets the names of alerts applied to a workload in its annotation.
+func (op *Operator) patchWorkloadAlerts(kind string, w metav1.ObjectMeta, names []string) error {
+	var val *string
+	if len(names) > 0 {
+		v := strings.Join(names, ",")
+		if w.Annotations[api.AnnotationKeyAlerts] == v {
+			return nil
+		}
+		val = &v
+	} else if _, found := w.Annotations[api.AnnotationKeyAlerts]; !found {
+		return nil
+	}
+
+	data, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{
+				api.AnnotationKeyAlerts: val,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	c := op.kubeClient.AppsV1()
+	switch kind {
+	case api.WorkloadKindDeployment:
+		_, err = c.Deployments(w.Namespace).Patch(w.Name, types.MergePatchType, data)
+	case api.WorkloadKindStatefulSet:
+		_, err = c.StatefulSets(w.Namespace).Patch(w.Name, types.MergePatchType, data)
+	case api.WorkloadKindDaemonSet:
+		_, err = c.DaemonSets(w.Namespace).Patch(w.Name, types.MergePatchType, data)
+	default:
+		err = errors.Errorf("unknown workload kind %s", kind)
+	}
+	return err
+}
+
+func (op *Operator) reconcileWorkload(key string) error {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) != 2 {
+		return errors.Errorf("workload key %s has a bad format", key)
+	}
+	kind := parts[0]
+	namespace, name, err := cache.SplitMetaNamespaceKey(parts[1])
+	if err != nil {
+		return err
+	}
+
+	w, err := op.getWorkload(kind, namespace, name)
+	if kerr.IsNotFound(err) {
+		log.Debugf("%s %s does not exist anymore\n", kind, parts[1])
+
+		op.removeWorkloadFromAlertStatus(namespace, kind, name)
+		op.resolveIncidents(incidentFilter{namespace: namespace, alertType: icinga.TypeWorkload, objectName: icinga.WorkloadObjectName(kind, name)}, kind+" is deleted")
+		return op.workloadHost.ForceDeleteIcingaHost(icinga.IcingaHost{
+			Type:           icinga.TypeWorkload,
+			AlertNamespace: namespace,
+			ObjectName:     icinga.WorkloadObjectName(kind, name),
+		})
+	} else if err != nil {
+		return err
+	}
+
+	log.Infof("Sync/Add/Update for %s %s\n", kind, parts[1])
+	err = op.ensureWorkload(kind, *w.DeepCopy())
+	if err != nil {
+		log.Errorf("failed to reconcile alert for %s %s. reason: %s", kind, parts[1], err)
+	}
+	return err
+}
+
+func (op *Operator) ensureWorkload(kind string, w metav1.ObjectMeta) error {
+	var errlist []error
+
+	oldAlerts := sets.NewString()
+	if val, ok := w.Annotations[api.AnnotationKeyAlerts]; ok {
+		names := strings.Split(val, ",")
+		oldAlerts.Insert(names...)
+	}
+
+	newAlerts, err := findWorkloadAlert(op.kubeClient, op.waLister, kind, w)
+	if err != nil {
+		return err
+	}
+	newNames := make([]string, len(newAlerts))
+	for i := range newAlerts {
+		alert := newAlerts[i]
+
+		err = op.workloadHost.Apply(alert, w)
+		if err != nil {
+			op.recorder.Eventf(
+				alert.ObjectReference(),
+				core.EventTypeWarning,
+				eventer.EventReasonFailedToSync,
+				`failed to  apply to %s %s/%s. Reason: %s`,
+				kind, w.Namespace, w.Name, err,
+			)
+			errlist = append(errlist, err)
+		}
+		op.setWorkloadAlertTarget(alert, w, err)
+
+		newNames[i] = alert.Name
+		if oldAlerts.Has(alert.Name) {
+			oldAlerts.Delete(alert.Name)
+		}
+	}
+
+	for _, name := range oldAlerts.List() {
+		err = op.workloadHost.Delete(w.Namespace, name, kind, w)
+		if err != nil {
+			if alert, e2 := op.waLister.WorkloadAlerts(w.Namespace).Get(name); e2 == nil {
+				op.recorder.Eventf(
+					alert.ObjectReference(),
+					core.EventTypeWarning,
+					eventer.EventReasonFailedToDelete,
+					`failed to  delete for %s %s/%s. Reason: %s`,
+					kind, w.Namespace, w.Name, err,
+				)
+			}
+			errlist = append(errlist, err)
+			continue
+		}
+		op.removeWorkloadAlertTarget(w.Namespace, name, workloadTargetName(kind, w.Name))
+		op.resolveIncidents(incidentFilter{namespace: w.Namespace, alert: name, alertType: icinga.TypeWorkload, objectName: icinga.WorkloadObjectName(kind, w.Name)}, kind+" is not selected by WorkloadAlert")
+	}
+
+	if err = op.patchWorkloadAlerts(kind, w, newNames); err != nil {
+		errlist = append(errlist, err)
+	}
+	return utilerrors.NewAggregate(errlist)
+}
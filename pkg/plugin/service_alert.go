@@ -0,0 +1,33 @@
+package plugin
+
+import (
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func GetServiceEndpointsPlugin() *api.SearchlightPlugin {
+	return &api.SearchlightPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: api.CheckServiceEndpoints,
+		},
+		TypeMeta: PluginTypeMeta,
+		Spec: api.SearchlightPluginSpec{
+			Command:    "hyperalert check_service_endpoints",
+			AlertKinds: []string{api.ResourceKindServiceAlert},
+			Arguments: api.PluginArguments{
+				Vars: &api.PluginVars{
+					Fields: map[string]api.PluginVarField{
+						"minReady": {
+							Type: api.VarTypeInteger,
+						},
+					},
+				},
+				Host: map[string]string{
+					"host": "name",
+					"v":    "vars.verbosity",
+				},
+			},
+			States: []string{stateOK, stateWarning, stateCritical, stateUnknown},
+		},
+	}
+}
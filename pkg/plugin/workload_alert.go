@@ -0,0 +1,80 @@
+package plugin
+
+import (
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func GetWorkloadRolloutPlugin() *api.SearchlightPlugin {
+	return &api.SearchlightPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: api.CheckWorkloadRollout,
+		},
+		TypeMeta: PluginTypeMeta,
+		Spec: api.SearchlightPluginSpec{
+			Command:    "hyperalert check_workload_rollout",
+			AlertKinds: []string{api.ResourceKindWorkloadAlert},
+			Arguments: api.PluginArguments{
+				Host: map[string]string{
+					"host": "name",
+					"v":    "vars.verbosity",
+				},
+			},
+			States: []string{stateOK, stateWarning, stateCritical, stateUnknown},
+		},
+	}
+}
+
+func GetWorkloadReplicasPlugin() *api.SearchlightPlugin {
+	return &api.SearchlightPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: api.CheckWorkloadReplicas,
+		},
+		TypeMeta: PluginTypeMeta,
+		Spec: api.SearchlightPluginSpec{
+			Command:    "hyperalert check_workload_replicas",
+			AlertKinds: []string{api.ResourceKindWorkloadAlert},
+			Arguments: api.PluginArguments{
+				Vars: &api.PluginVars{
+					Fields: map[string]api.PluginVarField{
+						"minAvailable": {
+							Type: api.VarTypeInteger,
+						},
+					},
+				},
+				Host: map[string]string{
+					"host": "name",
+					"v":    "vars.verbosity",
+				},
+			},
+			States: []string{stateOK, stateWarning, stateCritical, stateUnknown},
+		},
+	}
+}
+
+func GetWorkloadUnavailablePlugin() *api.SearchlightPlugin {
+	return &api.SearchlightPlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: api.CheckWorkloadUnavailable,
+		},
+		TypeMeta: PluginTypeMeta,
+		Spec: api.SearchlightPluginSpec{
+			Command:    "hyperalert check_workload_unavailable",
+			AlertKinds: []string{api.ResourceKindWorkloadAlert},
+			Arguments: api.PluginArguments{
+				Vars: &api.PluginVars{
+					Fields: map[string]api.PluginVarField{
+						"duration": {
+							Type: api.VarTypeDuration,
+						},
+					},
+				},
+				Host: map[string]string{
+					"host": "name",
+					"v":    "vars.verbosity",
+				},
+			},
+			States: []string{stateOK, stateWarning, stateCritical, stateUnknown},
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/appscode/go/log"
 	"github.com/appscode/searchlight/apis/incidents"
@@ -14,7 +15,9 @@ import (
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -31,6 +34,10 @@ type REST struct {
 var _ rest.Creater = &REST{}
 var _ rest.Scoper = &REST{}
 var _ rest.GracefulDeleter = &REST{}
+var _ rest.Getter = &REST{}
+var _ rest.Lister = &REST{}
+var _ rest.Watcher = &REST{}
+var _ rest.TableConvertor = &REST{}
 var _ rest.GroupVersionKindProvider = &REST{}
 var _ rest.CategoriesProvider = &REST{}
 
@@ -49,6 +56,10 @@ func (r *REST) New() runtime.Object {
 	return &incidents.Acknowledgement{}
 }
 
+func (r *REST) NewList() runtime.Object {
+	return &incidents.AcknowledgementList{}
+}
+
 func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
 	return v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindAcknowledgement)
 }
@@ -64,38 +75,16 @@ func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateOb
 		return nil, apierrors.NewInvalid(schema.GroupKind{Group: incidents.GroupName, Kind: v1alpha1.ResourceKindAcknowledgement}, req.Name, errs)
 	}
 
-	host, service, err := r.getIcingaObjects(req.Namespace, req.Name)
-	if err != nil {
-		return nil, err
-	}
-
-	mp := make(map[string]interface{})
-	mp["type"] = "Service"
-	mp["filter"] = fmt.Sprintf(`service.name == "%s" && host.name == "%s"`, service, host)
-	mp["comment"] = req.Request.Comment
-	mp["notify"] = !req.Request.SkipNotify
+	var author string
 	if user, ok := apirequest.UserFrom(ctx); ok {
-		mp["author"] = user.GetName()
-	}
-
-	ack, err := json.Marshal(mp)
-	if err != nil {
-		return nil, err
-	}
-	response := r.ic.Actions("acknowledge-problem").Update([]string{}, string(ack)).Do()
-	if response.Err != nil {
-		return nil, response.Err
+		author = user.GetName()
 	}
-	var icingaresp icinga.APIResponse
-	status, err := response.Into(&icingaresp)
-	if err != nil {
+	if err := r.Acknowledge(req.Namespace, req.Name, author, req.Request); err != nil {
 		return nil, err
 	}
-	if status != 200 {
-		return nil, errors.New(string(icingaresp.ResponseBody))
-	}
 	req.Response = incidents.AcknowledgementResponse{
 		Timestamp: metav1.Now(),
+		Author:    author,
 	}
 	return req, nil
 }
@@ -108,6 +97,10 @@ func validate(o *incidents.Acknowledgement) field.ErrorList {
 		errs = append(errs,
 			field.Invalid(field.NewPath("request", "comment"), o.Request.Comment, "comment must not be empty"))
 	}
+	if o.Request.Expiry != nil && !o.Request.Expiry.After(time.Now()) {
+		errs = append(errs,
+			field.Invalid(field.NewPath("request", "expiry"), o.Request.Expiry, "expiry must be in future"))
+	}
 
 	// perform validation here and add to errlist using field.Invalid
 	return errs
@@ -119,43 +112,195 @@ func (r *REST) Delete(ctx context.Context, name string, options *metav1.DeleteOp
 		return nil, false, apierrors.NewBadRequest("namespace missing")
 	}
 
+	if err := r.Unacknowledge(namespace, name); err != nil {
+		return nil, false, err
+	}
+
+	resp := &incidents.Acknowledgement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Response: incidents.AcknowledgementResponse{
+			Timestamp: metav1.Now(),
+		},
+	}
+
+	return resp, true, nil
+}
+
+// Acknowledge acknowledges the problem of incident in Icinga on behalf of author, as requested by req.
+func (r *REST) Acknowledge(namespace, name, author string, req incidents.AcknowledgementRequest) error {
 	host, service, err := r.getIcingaObjects(namespace, name)
 	if err != nil {
-		return nil, false, err
+		return err
 	}
 
 	mp := make(map[string]interface{})
 	mp["type"] = "Service"
 	mp["filter"] = fmt.Sprintf(`service.name == "%s" && host.name == "%s"`, service, host)
+	mp["comment"] = req.Comment
+	mp["notify"] = !req.SkipNotify
+	mp["sticky"] = req.Sticky
+	if req.Expiry != nil {
+		mp["expiry"] = req.Expiry.Unix()
+	}
+	if author != "" {
+		mp["author"] = author
+	}
+
 	ack, err := json.Marshal(mp)
 	if err != nil {
-		return nil, false, err
+		return err
+	}
+	response := r.ic.Actions("acknowledge-problem").Update([]string{}, string(ack)).Do()
+	if response.Err != nil {
+		return response.Err
+	}
+	var icingaresp icinga.APIResponse
+	status, err := response.Into(&icingaresp)
+	if err != nil {
+		return err
+	}
+	if status != 200 {
+		return errors.New(string(icingaresp.ResponseBody))
+	}
+	return nil
+}
+
+// Unacknowledge removes the acknowledgement of the problem of incident in Icinga.
+func (r *REST) Unacknowledge(namespace, name string) error {
+	host, service, err := r.getIcingaObjects(namespace, name)
+	if err != nil {
+		return err
+	}
+
+	mp := make(map[string]interface{})
+	mp["type"] = "Service"
+	mp["filter"] = fmt.Sprintf(`service.name == "%s" && host.name == "%s"`, service, host)
+	ack, err := json.Marshal(mp)
+	if err != nil {
+		return err
 	}
 
 	response := r.ic.Actions("remove-acknowledgement").Update([]string{}, string(ack)).Do()
 	if response.Err != nil {
-		return nil, false, response.Err
+		return response.Err
 	}
 	var icingaResp icinga.APIResponse
 	status, err := response.Into(&icingaResp)
 	if err != nil {
-		return nil, false, err
+		return err
 	}
 	if status != 200 {
-		return nil, false, errors.New(string(icingaResp.ResponseBody))
+		return errors.New(string(icingaResp.ResponseBody))
 	}
+	return nil
+}
 
-	resp := &incidents.Acknowledgement{
+func (r *REST) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	namespace, ok := apirequest.NamespaceFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("namespace missing")
+	}
+
+	incident, err := r.client.MonitoringV1alpha1().Incidents(namespace).Get(name, metav1.GetOptions{})
+	if kerr.IsNotFound(err) {
+		return nil, apierrors.NewNotFound(incidents.Resource(v1alpha1.ResourcePluralAcknowledgement), name)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to determine incident %s/%s", namespace, name)
+	}
+	// Icinga service of a recovered incident may be acknowledged for a later incident
+	if incident.Labels[monitoring.LabelKeyProblemRecovered] == "true" {
+		return nil, apierrors.NewNotFound(incidents.Resource(v1alpha1.ResourcePluralAcknowledgement), name)
+	}
+	host, service, err := icinga.ParseIncident(incident)
+	if err != nil {
+		return nil, err
+	}
+	acks, err := r.ic.GetAcknowledgements(host, service)
+	if err != nil {
+		return nil, err
+	}
+	ack, found := acks[icinga.AcknowledgementKey(host, service)]
+	if !found {
+		return nil, apierrors.NewNotFound(incidents.Resource(v1alpha1.ResourcePluralAcknowledgement), name)
+	}
+	return newAcknowledgement(incident, ack), nil
+}
+
+func (r *REST) List(ctx context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	items, err := r.list(apirequest.NamespaceValue(ctx), options)
+	if err != nil {
+		return nil, err
+	}
+	return &incidents.AcknowledgementList{Items: items}, nil
+}
+
+// list returns the acknowledgements of open incidents in namespace, selected by options.
+// Incidents of all namespaces are listed, if namespace is empty.
+func (r *REST) list(namespace string, options *metainternalversion.ListOptions) ([]incidents.Acknowledgement, error) {
+	opts := metav1.ListOptions{}
+	if options != nil && options.LabelSelector != nil {
+		opts.LabelSelector = options.LabelSelector.String()
+	}
+	list, err := r.client.MonitoringV1alpha1().Incidents(namespace).List(opts)
+	if err != nil {
+		return nil, err
+	}
+	acks, err := r.ic.GetAcknowledgements("", "")
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]incidents.Acknowledgement, 0)
+	for i := range list.Items {
+		incident := &list.Items[i]
+		if incident.Labels[monitoring.LabelKeyProblemRecovered] == "true" {
+			continue
+		}
+		if options != nil && options.FieldSelector != nil && !options.FieldSelector.Matches(fields.Set{
+			"metadata.name":      incident.Name,
+			"metadata.namespace": incident.Namespace,
+		}) {
+			continue
+		}
+		host, service, err := icinga.ParseIncident(incident)
+		if err != nil {
+			log.Errorln(err)
+			continue
+		}
+		if ack, found := acks[icinga.AcknowledgementKey(host, service)]; found {
+			items = append(items, *newAcknowledgement(incident, ack))
+		}
+	}
+	return items, nil
+}
+
+// newAcknowledgement returns the Acknowledgement of incident, with the labels of incident.
+func newAcknowledgement(incident *monitoring.Incident, ack icinga.Acknowledgement) *incidents.Acknowledgement {
+	timestamp := metav1.NewTime(ack.Timestamp)
+	out := &incidents.Acknowledgement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
+			Name:              incident.Name,
+			Namespace:         incident.Namespace,
+			Labels:            incident.Labels,
+			CreationTimestamp: timestamp,
+		},
+		Request: incidents.AcknowledgementRequest{
+			Comment: ack.Comment,
+			Sticky:  ack.Sticky,
 		},
 		Response: incidents.AcknowledgementResponse{
-			Timestamp: metav1.Now(),
+			Timestamp: timestamp,
+			Author:    ack.Author,
 		},
 	}
-
-	return resp, true, nil
+	if !ack.Expiry.IsZero() {
+		expiry := metav1.NewTime(ack.Expiry)
+		out.Request.Expiry = &expiry
+	}
+	return out
 }
 
 func (r *REST) getIcingaObjects(namespace, name string) (host string, service string, err error) {
@@ -166,25 +311,5 @@ func (r *REST) getIcingaObjects(namespace, name string) (host string, service st
 		}
 		return "", "", errors.Wrapf(err, "failed to determine incident %s/%s", namespace, name)
 	}
-
-	icingaHost := &icinga.IcingaHost{AlertNamespace: namespace}
-
-	service, ok := incident.Labels[monitoring.LabelKeyAlert]
-	if !ok {
-		return "", "", errors.Errorf("incident %s/%s is missing label %s", namespace, name, monitoring.LabelKeyAlert)
-	}
-	icingaHost.Type, ok = incident.Labels[monitoring.LabelKeyAlertType]
-	if !ok {
-		return "", "", errors.Errorf("incident %s/%s is missing label %s", namespace, name, monitoring.LabelKeyAlertType)
-	} else if !icinga.IsValidHostType(icingaHost.Type) {
-		return "", "", errors.Errorf("incident %s/%s has invalid value %s for label %s", namespace, name, icingaHost.Type, monitoring.LabelKeyAlertType)
-	}
-	if icingaHost.Type != icinga.TypeCluster {
-		icingaHost.ObjectName, ok = incident.Labels[monitoring.LabelKeyObjectName]
-		if !ok {
-			return "", "", errors.Errorf("incident %s/%s is missing label %s", namespace, name, monitoring.LabelKeyObjectName)
-		}
-	}
-	host, err = icingaHost.Name()
-	return
+	return icinga.ParseIncident(incident)
 }
@@ -0,0 +1,109 @@
+package acknowledgement
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+
+	"github.com/appscode/go/log"
+	"github.com/appscode/searchlight/apis/incidents"
+)
+
+// Comment of acknowledgements made with links, if none is given
+const defaultLinkComment = "Acknowledged from notification"
+
+var linkTemplate = template.Must(template.New("link").Parse(`<html>
+<head><title>Searchlight</title></head>
+<body>
+{{- if .Message }}
+<p>{{ .Message }}</p>
+{{- else }}
+<form method="POST">
+<p>{{ if eq .Token.Action "acknowledge" }}Acknowledge{{ else }}Remove acknowledgement of{{ end }} incident {{ .Token.Namespace }}/{{ .Token.Incident }} as {{ .Token.Author }}?</p>
+<input type="hidden" name="token" value="{{ .Raw }}">
+{{- if eq .Token.Action "acknowledge" }}
+<p><input type="text" name="comment" placeholder="{{ .Comment }}"></p>
+{{- end }}
+<p><input type="submit" value="Confirm"></p>
+</form>
+{{- end }}
+</body>
+</html>
+`))
+
+type linkPage struct {
+	Token   *Token
+	Raw     string
+	Comment string
+	Message string
+}
+
+// LinkHandler serves the acknowledge links of notifications. Links show a confirmation page on GET,
+// so that they are not followed by the link scanners of mail and chat services. The action is
+// performed on POST, using the same code as Acknowledgement objects.
+type LinkHandler struct {
+	rest  *REST
+	links *Links
+}
+
+var _ http.Handler = &LinkHandler{}
+
+func NewLinkHandler(rest *REST, links *Links) *LinkHandler {
+	return &LinkHandler{
+		rest:  rest,
+		links: links,
+	}
+}
+
+func (h *LinkHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		h.render(w, http.StatusMethodNotAllowed, linkPage{Message: "Method is not allowed."})
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		h.render(w, http.StatusBadRequest, linkPage{Message: "Invalid request."})
+		return
+	}
+	raw := r.Form.Get("token")
+	token, err := h.links.Verify(raw, time.Now())
+	if err == ErrExpiredToken {
+		h.render(w, http.StatusForbidden, linkPage{Message: "This link has expired."})
+		return
+	} else if err != nil {
+		h.render(w, http.StatusForbidden, linkPage{Message: "This link is invalid."})
+		return
+	}
+	if r.Method == http.MethodGet {
+		h.render(w, http.StatusOK, linkPage{Token: token, Raw: raw, Comment: defaultLinkComment})
+		return
+	}
+
+	if token.Action == ActionAcknowledge {
+		comment := r.PostForm.Get("comment")
+		if comment == "" {
+			comment = defaultLinkComment
+		}
+		err = h.rest.Acknowledge(token.Namespace, token.Incident, token.Author, incidents.AcknowledgementRequest{Comment: comment})
+	} else {
+		err = h.rest.Unacknowledge(token.Namespace, token.Incident)
+	}
+	if err != nil {
+		log.Errorf("failed to %s incident %s/%s. Reason: %v", token.Action, token.Namespace, token.Incident, err)
+		h.render(w, http.StatusInternalServerError, linkPage{Message: "Failed to " + token.Action + " incident " + token.Namespace + "/" + token.Incident + "."})
+		return
+	}
+	if token.Action == ActionAcknowledge {
+		h.render(w, http.StatusOK, linkPage{Message: "Incident " + token.Namespace + "/" + token.Incident + " is acknowledged."})
+	} else {
+		h.render(w, http.StatusOK, linkPage{Message: "Acknowledgement of incident " + token.Namespace + "/" + token.Incident + " is removed."})
+	}
+}
+
+func (h *LinkHandler) render(w http.ResponseWriter, status int, page linkPage) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	if err := linkTemplate.Execute(w, page); err != nil {
+		log.Errorln(err)
+	}
+}
@@ -0,0 +1,128 @@
+package acknowledgement
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Path of Searchlight server that serves the acknowledge links of notifications
+	LinkPath = "/acknowledge"
+	// Key of Searchlight operator config Secret with the key that acknowledge links are signed with
+	LinkSigningKey = "ACKNOWLEDGE_SIGNING_KEY"
+)
+
+// Actions of acknowledge links
+const (
+	ActionAcknowledge   = "acknowledge"
+	ActionUnacknowledge = "unacknowledge"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid token")
+	ErrExpiredToken = errors.New("token has expired")
+)
+
+// Token is the signed request of an acknowledge link.
+type Token struct {
+	Action    string `json:"action"`
+	Namespace string `json:"namespace"`
+	Incident  string `json:"incident"`
+	// Receiver of notification, recorded as the author of acknowledgement
+	Author string `json:"author"`
+	// Unix time after which the token is rejected
+	Expiry int64 `json:"expiry"`
+}
+
+// Links signs and verifies the acknowledge links sent in notifications. A link carries a Token,
+// encoded as <base64url(json)>.<base64url(HMAC-SHA256 of json)>.
+type Links struct {
+	baseURL string
+	key     []byte
+	ttl     time.Duration
+}
+
+// NewLinks returns Links to the Searchlight server at baseURL, such as https://searchlight.example.com,
+// signed with key and valid for ttl.
+func NewLinks(baseURL string, key []byte, ttl time.Duration) (*Links, error) {
+	if _, err := url.Parse(baseURL); err != nil {
+		return nil, errors.Wrapf(err, "invalid url %s", baseURL)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("signing key of acknowledge links is empty")
+	}
+	return &Links{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		key:     key,
+		ttl:     ttl,
+	}, nil
+}
+
+// URL returns the link to perform action on incident in namespace on behalf of author.
+func (l *Links) URL(action, namespace, incident, author string, now time.Time) (string, error) {
+	token, err := l.Sign(Token{
+		Action:    action,
+		Namespace: namespace,
+		Incident:  incident,
+		Author:    author,
+		Expiry:    now.Add(l.ttl).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{}
+	q.Set("token", token)
+	return l.baseURL + LinkPath + "?" + q.Encode(), nil
+}
+
+func (l *Links) Sign(t Token) (string, error) {
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(l.mac(payload)), nil
+}
+
+// Verify returns the Token of a signed token, if it is not tampered with and has not expired at now.
+func (l *Links) Verify(token string, now time.Time) (*Token, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, ErrInvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if !hmac.Equal(sig, l.mac(payload)) {
+		return nil, ErrInvalidToken
+	}
+
+	var t Token
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return nil, ErrInvalidToken
+	}
+	if t.Action != ActionAcknowledge && t.Action != ActionUnacknowledge {
+		return nil, ErrInvalidToken
+	}
+	if now.Unix() > t.Expiry {
+		return nil, ErrExpiredToken
+	}
+	return &t, nil
+}
+
+func (l *Links) mac(payload []byte) []byte {
+	h := hmac.New(sha256.New, l.key)
+	h.Write(payload)
+	return h.Sum(nil)
+}
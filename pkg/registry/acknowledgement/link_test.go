@@ -0,0 +1,80 @@
+package acknowledgement
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinks(t *testing.T) {
+	links, err := NewLinks("https://searchlight.example.com/", []byte("secret"), time.Hour)
+	assert.Nil(t, err)
+	now := time.Now()
+
+	link, err := links.URL(ActionAcknowledge, "demo", "pod.nginx.pod-exec.20180428-1109", "ops@example.com", now)
+	assert.Nil(t, err)
+	u, err := url.Parse(link)
+	assert.Nil(t, err)
+	assert.Equal(t, "searchlight.example.com", u.Host)
+	assert.Equal(t, LinkPath, u.Path)
+
+	token := u.Query().Get("token")
+	got, err := links.Verify(token, now)
+	assert.Nil(t, err)
+	assert.Equal(t, &Token{
+		Action:    ActionAcknowledge,
+		Namespace: "demo",
+		Incident:  "pod.nginx.pod-exec.20180428-1109",
+		Author:    "ops@example.com",
+		Expiry:    now.Add(time.Hour).Unix(),
+	}, got)
+
+	_, err = links.Verify(token, now.Add(2*time.Hour))
+	assert.Equal(t, ErrExpiredToken, err)
+
+	other, err := NewLinks("https://searchlight.example.com", []byte("other"), time.Hour)
+	assert.Nil(t, err)
+	_, err = other.Verify(token, now)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	// payload of another incident with the signature of token
+	forged, err := links.Sign(Token{Action: ActionAcknowledge, Namespace: "kube-system", Incident: "x", Expiry: got.Expiry})
+	assert.Nil(t, err)
+	_, err = links.Verify(strings.Split(forged, ".")[0]+"."+strings.Split(token, ".")[1], now)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	_, err = links.Verify("not-a-token", now)
+	assert.Equal(t, ErrInvalidToken, err)
+
+	_, err = NewLinks("https://searchlight.example.com", nil, time.Hour)
+	assert.NotNil(t, err)
+}
+
+func TestLinkHandler(t *testing.T) {
+	links, err := NewLinks("https://searchlight.example.com", []byte("secret"), time.Hour)
+	assert.Nil(t, err)
+	h := NewLinkHandler(nil, links)
+
+	link, err := links.URL(ActionUnacknowledge, "demo", "cluster.pod-exists-demo-0.20180428-1109", "ops@example.com", time.Now())
+	assert.Nil(t, err)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, link, nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Remove acknowledgement of incident demo/cluster.pod-exists-demo-0.20180428-1109")
+	assert.Contains(t, w.Body.String(), `method="POST"`)
+
+	req := httptest.NewRequest(http.MethodPost, LinkPath, strings.NewReader(url.Values{"token": {"invalid"}}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, link, nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
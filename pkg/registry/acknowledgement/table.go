@@ -0,0 +1,55 @@
+package acknowledgement
+
+import (
+	"context"
+	"time"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ConvertToTable shows who acknowledged the incidents, and until when, in kubectl get.
+func (r *REST) ConvertToTable(ctx context.Context, object runtime.Object, tableOptions runtime.Object) (*metav1beta1.Table, error) {
+	table := &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: "Name of incident"},
+			{Name: "Author", Type: "string", Description: "User who acknowledged the problem"},
+			{Name: "Sticky", Type: "boolean", Description: "If acknowledgement is kept until the problem recovers"},
+			{Name: "Expiry", Type: "string", Description: "Time at which Icinga removes the acknowledgement"},
+			{Name: "Acknowledged At", Type: "date", Description: "Time at which the problem was acknowledged"},
+			{Name: "Comment", Type: "string", Priority: 1, Description: "Comment of acknowledgement"},
+		},
+	}
+	fn := func(obj runtime.Object) error {
+		ack, ok := obj.(*incidents.Acknowledgement)
+		if !ok {
+			return nil
+		}
+		expiry := "<none>"
+		if ack.Request.Expiry != nil {
+			expiry = ack.Request.Expiry.UTC().Format(time.RFC3339)
+		}
+		table.Rows = append(table.Rows, metav1beta1.TableRow{
+			Cells: []interface{}{
+				ack.Name,
+				ack.Response.Author,
+				ack.Request.Sticky,
+				expiry,
+				ack.Response.Timestamp.UTC().Format(time.RFC3339),
+				ack.Request.Comment,
+			},
+			Object: runtime.RawExtension{Object: obj},
+		})
+		return nil
+	}
+	if meta.IsListType(object) {
+		if err := meta.EachListItem(object, fn); err != nil {
+			return nil, err
+		}
+	} else if err := fn(object); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
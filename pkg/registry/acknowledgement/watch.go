@@ -0,0 +1,106 @@
+package acknowledgement
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/appscode/go/log"
+	"github.com/appscode/searchlight/apis/incidents"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	"k8s.io/apimachinery/pkg/watch"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+)
+
+// Icinga has no API to watch acknowledgements, so they are polled this often for watches.
+const watchPollPeriod = 10 * time.Second
+
+// Watch sends the acknowledgements of open incidents as Added events, followed by the changes of them.
+func (r *REST) Watch(ctx context.Context, options *metainternalversion.ListOptions) (watch.Interface, error) {
+	namespace := apirequest.NamespaceValue(ctx)
+	w := &watcher{
+		result: make(chan watch.Event),
+		stopCh: make(chan struct{}),
+	}
+	go w.run(ctx, func() ([]incidents.Acknowledgement, error) {
+		return r.list(namespace, options)
+	}, watchPollPeriod)
+	return w, nil
+}
+
+type watcher struct {
+	result   chan watch.Event
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+var _ watch.Interface = &watcher{}
+
+func (w *watcher) ResultChan() <-chan watch.Event {
+	return w.result
+}
+
+func (w *watcher) Stop() {
+	w.stopOnce.Do(func() {
+		close(w.stopCh)
+	})
+}
+
+func (w *watcher) run(ctx context.Context, list func() ([]incidents.Acknowledgement, error), period time.Duration) {
+	defer close(w.result)
+
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	known := map[string]incidents.Acknowledgement{}
+	for {
+		// failures to poll Icinga are retried on next tick, instead of ending the watch
+		if items, err := list(); err != nil {
+			log.Errorf("failed to list acknowledgements for watch. Reason: %v", err)
+		} else {
+			for _, e := range diffAcknowledgements(known, items) {
+				select {
+				case w.result <- e:
+				case <-w.stopCh:
+					return
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-w.stopCh:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// diffAcknowledgements returns the events of changes from known to items, and updates known to items.
+func diffAcknowledgements(known map[string]incidents.Acknowledgement, items []incidents.Acknowledgement) []watch.Event {
+	var events []watch.Event
+	current := make(map[string]bool, len(items))
+	for i := range items {
+		item := items[i]
+		key := item.Namespace + "/" + item.Name
+		current[key] = true
+		if old, found := known[key]; !found {
+			events = append(events, watch.Event{Type: watch.Added, Object: &item})
+		} else if !equality.Semantic.DeepEqual(old.Request, item.Request) || !equality.Semantic.DeepEqual(old.Response, item.Response) {
+			events = append(events, watch.Event{Type: watch.Modified, Object: &item})
+		}
+		known[key] = item
+	}
+	for key, old := range known {
+		if !current[key] {
+			obj := old
+			events = append(events, watch.Event{Type: watch.Deleted, Object: &obj})
+			delete(known, key)
+		}
+	}
+	return events
+}
@@ -0,0 +1,85 @@
+package acknowledgement
+
+import (
+	"testing"
+	"time"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func TestNewAcknowledgement(t *testing.T) {
+	incident := &monitoring.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod.nginx-0.pod-exec.20180428-1109",
+			Namespace: "demo",
+			Labels: map[string]string{
+				monitoring.LabelKeyAlertType:  icinga.TypePod,
+				monitoring.LabelKeyAlert:      "pod-exec",
+				monitoring.LabelKeyObjectName: "nginx-0",
+			},
+		},
+	}
+	host, service, err := icinga.ParseIncident(incident)
+	assert.NoError(t, err)
+	assert.Equal(t, "demo@pod@nginx-0", host)
+	assert.Equal(t, "pod-exec", service)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	ack := newAcknowledgement(incident, icinga.Acknowledgement{
+		Host:      host,
+		Service:   service,
+		Sticky:    true,
+		Expiry:    now.Add(time.Hour),
+		Author:    "ops@example.com",
+		Comment:   "working on fix",
+		Timestamp: now,
+	})
+	assert.Equal(t, incident.Name, ack.Name)
+	assert.Equal(t, incident.Labels, ack.Labels)
+	assert.Equal(t, "working on fix", ack.Request.Comment)
+	assert.True(t, ack.Request.Sticky)
+	assert.Equal(t, now.Add(time.Hour), ack.Request.Expiry.Time)
+	assert.Equal(t, "ops@example.com", ack.Response.Author)
+	assert.Equal(t, now, ack.Response.Timestamp.Time)
+
+	ack = newAcknowledgement(incident, icinga.Acknowledgement{Host: host, Service: service})
+	assert.Nil(t, ack.Request.Expiry)
+
+	delete(incident.Labels, monitoring.LabelKeyObjectName)
+	_, _, err = icinga.ParseIncident(incident)
+	assert.Error(t, err)
+}
+
+func TestDiffAcknowledgements(t *testing.T) {
+	ack := func(name, author string) incidents.Acknowledgement {
+		return incidents.Acknowledgement{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "demo"},
+			Response:   incidents.AcknowledgementResponse{Author: author},
+		}
+	}
+	types := func(events []watch.Event) map[string]watch.EventType {
+		out := map[string]watch.EventType{}
+		for _, e := range events {
+			out[e.Object.(*incidents.Acknowledgement).Name] = e.Type
+		}
+		return out
+	}
+
+	known := map[string]incidents.Acknowledgement{}
+	events := diffAcknowledgements(known, []incidents.Acknowledgement{ack("a", "alice"), ack("b", "bob")})
+	assert.Equal(t, map[string]watch.EventType{"a": watch.Added, "b": watch.Added}, types(events))
+
+	events = diffAcknowledgements(known, []incidents.Acknowledgement{ack("a", "alice"), ack("b", "carol"), ack("c", "dave")})
+	assert.Equal(t, map[string]watch.EventType{"b": watch.Modified, "c": watch.Added}, types(events))
+
+	events = diffAcknowledgements(known, []incidents.Acknowledgement{ack("c", "dave")})
+	assert.Equal(t, map[string]watch.EventType{"a": watch.Deleted, "b": watch.Deleted}, types(events))
+	assert.Len(t, known, 1)
+
+	assert.Empty(t, diffAcknowledgements(known, []incidents.Acknowledgement{ack("c", "dave")}))
+}
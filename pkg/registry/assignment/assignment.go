@@ -0,0 +1,92 @@
+package assignment
+
+import (
+	"context"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/plugins/notifier"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+type REST struct {
+	dispatcher *notifier.Dispatcher
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(dispatcher *notifier.Dispatcher) *REST {
+	return &REST{
+		dispatcher: dispatcher,
+	}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) New() runtime.Object {
+	return &incidents.Assignment{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindAssignment)
+}
+
+// Create sets the assignee of incident, and records the assignment in incident with the user as author.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	req := obj.(*incidents.Assignment)
+
+	if errs := validate(req); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: incidents.GroupName, Kind: v1alpha1.ResourceKindAssignment}, req.Name, errs)
+	}
+
+	var author string
+	if user, ok := apirequest.UserFrom(ctx); ok {
+		author = user.GetName()
+	}
+	now := metav1.Now()
+	_, err := r.dispatcher.UpdateIncident(req.Namespace, req.Name, func(in *monitoring.Incident) *monitoring.Incident {
+		in.Status.Assignee = req.Request.Assignee
+		in.Status.Notifications = append(in.Status.Notifications, monitoring.IncidentNotification{
+			Type:           monitoring.NotificationAssignment,
+			Author:         &author,
+			Comment:        &req.Request.Comment,
+			FirstTimestamp: now,
+			LastTimestamp:  now,
+			Assignee:       req.Request.Assignee,
+		})
+		return in
+	})
+	if apierrors.IsNotFound(err) {
+		return nil, apierrors.NewNotFound(monitoring.Resource(monitoring.ResourcePluralIncident), req.Name)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to assign incident %s/%s", req.Namespace, req.Name)
+	}
+
+	req.Response = incidents.AssignmentResponse{
+		Timestamp: now,
+		Author:    author,
+	}
+	return req, nil
+}
+
+func validate(o *incidents.Assignment) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if o.Request.Assignee == "" {
+		errs = append(errs,
+			field.Required(field.NewPath("request", "assignee"), "assignee must not be empty"))
+	}
+	return errs
+}
@@ -0,0 +1,141 @@
+package comment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins/notifier"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	restconfig "k8s.io/client-go/rest"
+)
+
+type REST struct {
+	client     versioned.Interface
+	ic         *icinga.Client
+	dispatcher *notifier.Dispatcher
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(config *restconfig.Config, ic *icinga.Client, dispatcher *notifier.Dispatcher) *REST {
+	return &REST{
+		client:     versioned.NewForConfigOrDie(config),
+		ic:         ic,
+		dispatcher: dispatcher,
+	}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) New() runtime.Object {
+	return &incidents.Comment{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindComment)
+}
+
+// Create adds the comment to the service of incident in Icinga, and sends it to the receivers of incident
+// as a custom notification, which is recorded in incident with the user as author.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	req := obj.(*incidents.Comment)
+
+	if errs := validate(req); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: incidents.GroupName, Kind: v1alpha1.ResourceKindComment}, req.Name, errs)
+	}
+
+	incident, err := r.client.MonitoringV1alpha1().Incidents(req.Namespace).Get(req.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, apierrors.NewNotFound(monitoring.Resource(monitoring.ResourcePluralIncident), req.Name)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to determine incident %s/%s", req.Namespace, req.Name)
+	}
+	// comments are sent to the receivers of the open incident of a target
+	if incident.Labels[monitoring.LabelKeyProblemRecovered] == "true" {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("incident %s/%s is resolved", req.Namespace, req.Name))
+	}
+
+	var author string
+	if user, ok := apirequest.UserFrom(ctx); ok {
+		author = user.GetName()
+	}
+	if err := r.addComment(incident, author, req.Request.Comment); err != nil {
+		return nil, err
+	}
+	notification, err := notifier.NotificationForIncident(incident, string(monitoring.NotificationCustom), author, req.Request.Comment)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.dispatcher.Dispatch(notification); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	req.Response = incidents.CommentResponse{
+		Timestamp: metav1.Now(),
+		Author:    author,
+	}
+	return req, nil
+}
+
+func validate(o *incidents.Comment) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if o.Request.Comment == "" {
+		errs = append(errs,
+			field.Required(field.NewPath("request", "comment"), "comment must not be empty"))
+	}
+	return errs
+}
+
+// addComment adds comment to the service of incident in Icinga on behalf of author.
+func (r *REST) addComment(incident *monitoring.Incident, author, comment string) error {
+	host, service, err := icinga.ParseIncident(incident)
+	if err != nil {
+		return err
+	}
+
+	mp := make(map[string]interface{})
+	mp["type"] = "Service"
+	mp["filter"] = fmt.Sprintf(`service.name == "%s" && host.name == "%s"`, service, host)
+	mp["comment"] = comment
+	// Icinga requires an author of comments
+	mp["author"] = "searchlight"
+	if author != "" {
+		mp["author"] = author
+	}
+
+	body, err := json.Marshal(mp)
+	if err != nil {
+		return err
+	}
+	response := r.ic.Actions("add-comment").Update([]string{}, string(body)).Do()
+	if response.Err != nil {
+		return response.Err
+	}
+	var icingaResp icinga.APIResponse
+	status, err := response.Into(&icingaResp)
+	if err != nil {
+		return err
+	}
+	if status != 200 {
+		return errors.New(string(icingaResp.ResponseBody))
+	}
+	return nil
+}
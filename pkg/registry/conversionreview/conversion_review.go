@@ -0,0 +1,47 @@
+package conversionreview
+
+import (
+	"context"
+
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// Adapted from kmodules.xyz/webhook-runtime/registry/admissionreview/v1beta1
+
+type ConversionHookFunc func(req *apiextensions.ConversionRequest) *apiextensions.ConversionResponse
+
+type REST struct {
+	hookFn ConversionHookFunc
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(hookFn ConversionHookFunc) *REST {
+	return &REST{
+		hookFn: hookFn,
+	}
+}
+
+func (r *REST) New() runtime.Object {
+	return &apiextensions.ConversionReview{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return apiextensions.SchemeGroupVersion.WithKind("ConversionReview")
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	conversionReview := obj.(*apiextensions.ConversionReview)
+	conversionReview.Response = r.hookFn(conversionReview.Request)
+	return conversionReview, nil
+}
@@ -0,0 +1,98 @@
+package escalation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned"
+	"github.com/appscode/searchlight/plugins/notifier"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	restconfig "k8s.io/client-go/rest"
+)
+
+type REST struct {
+	client     versioned.Interface
+	dispatcher *notifier.Dispatcher
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(config *restconfig.Config, dispatcher *notifier.Dispatcher) *REST {
+	return &REST{
+		client:     versioned.NewForConfigOrDie(config),
+		dispatcher: dispatcher,
+	}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) New() runtime.Object {
+	return &incidents.Escalation{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindEscalation)
+}
+
+// Create sends the problem of incident to the receivers of an escalation step, and records the escalation
+// in incident with the user as author.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	req := obj.(*incidents.Escalation)
+
+	if errs := validate(req); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: incidents.GroupName, Kind: v1alpha1.ResourceKindEscalation}, req.Name, errs)
+	}
+
+	incident, err := r.client.MonitoringV1alpha1().Incidents(req.Namespace).Get(req.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, apierrors.NewNotFound(monitoring.Resource(monitoring.ResourcePluralIncident), req.Name)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to determine incident %s/%s", req.Namespace, req.Name)
+	}
+	if incident.Labels[monitoring.LabelKeyProblemRecovered] == "true" {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("incident %s/%s is resolved", req.Namespace, req.Name))
+	}
+
+	var author string
+	if user, ok := apirequest.UserFrom(ctx); ok {
+		author = user.GetName()
+	}
+	step, receivers, err := r.dispatcher.Escalate(incident, author, req.Request.Comment, req.Request.EscalateAfter)
+	if err == notifier.ErrEscalated || err == notifier.ErrNoEscalationStep {
+		return nil, apierrors.NewBadRequest(err.Error())
+	} else if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	req.Response = incidents.EscalationResponse{
+		Timestamp:     metav1.Now(),
+		Author:        author,
+		EscalateAfter: step,
+		Receivers:     receivers,
+	}
+	return req, nil
+}
+
+func validate(o *incidents.Escalation) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if o.Request.EscalateAfter != nil && o.Request.EscalateAfter.Duration <= 0 {
+		errs = append(errs,
+			field.Invalid(field.NewPath("request", "escalateAfter"), o.Request.EscalateAfter.Duration.String(), "escalateAfter must be positive"))
+	}
+	return errs
+}
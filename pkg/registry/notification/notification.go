@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	"github.com/appscode/searchlight/plugins/notifier"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+type REST struct {
+	dispatcher *notifier.Dispatcher
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(dispatcher *notifier.Dispatcher) *REST {
+	return &REST{
+		dispatcher: dispatcher,
+	}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) New() runtime.Object {
+	return &incidents.Notification{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindNotification)
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	req := obj.(*incidents.Notification)
+
+	if errs := validate(req); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: incidents.GroupName, Kind: v1alpha1.ResourceKindNotification}, req.Name, errs)
+	}
+	if err := r.dispatcher.Dispatch(req); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	return req, nil
+}
+
+func validate(o *incidents.Notification) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if o.Request.Alert == "" {
+		errs = append(errs,
+			field.Required(field.NewPath("request", "alert"), "alert must not be empty"))
+	}
+	if o.Request.Host == "" {
+		errs = append(errs,
+			field.Required(field.NewPath("request", "host"), "host must not be empty"))
+	}
+	if o.Request.Type == "" {
+		errs = append(errs,
+			field.Required(field.NewPath("request", "type"), "type must not be empty"))
+	}
+	return errs
+}
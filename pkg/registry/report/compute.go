@@ -0,0 +1,200 @@
+package report
+
+import (
+	"sort"
+	"time"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// alertKinds are the kinds of alerts of the types of Icinga hosts in labels of incidents.
+var alertKinds = map[string]string{
+	icinga.TypePod:      monitoring.ResourceKindPodAlert,
+	icinga.TypeNode:     monitoring.ResourceKindNodeAlert,
+	icinga.TypeService:  monitoring.ResourceKindServiceAlert,
+	icinga.TypeWorkload: monitoring.ResourceKindWorkloadAlert,
+	icinga.TypeCluster:  monitoring.ResourceKindClusterAlert,
+}
+
+// Alert identifies a reported alert.
+type Alert struct {
+	Kind string
+	Name string
+}
+
+// span is the problem of an incident, from its start until it is recovered or resolved. end is zero
+// for open incidents.
+type span struct {
+	start        time.Time
+	end          time.Time
+	acknowledged time.Time
+}
+
+func incidentSpan(incident *monitoring.Incident) span {
+	var s span
+	for _, n := range incident.Status.Notifications {
+		if n.Type == monitoring.NotificationProblem && (s.start.IsZero() || n.FirstTimestamp.Time.Before(s.start)) {
+			s.start = n.FirstTimestamp.Time
+		}
+	}
+	if s.start.IsZero() {
+		s.start = incident.CreationTimestamp.Time
+	}
+	var last time.Time
+	for _, n := range incident.Status.Notifications {
+		if n.FirstTimestamp.Time.Before(s.start) {
+			continue
+		}
+		switch n.Type {
+		case monitoring.NotificationAcknowledgement:
+			if s.acknowledged.IsZero() || n.FirstTimestamp.Time.Before(s.acknowledged) {
+				s.acknowledged = n.FirstTimestamp.Time
+			}
+		case monitoring.NotificationRecovery, monitoring.NotificationResolution:
+			if s.end.IsZero() || n.FirstTimestamp.Time.Before(s.end) {
+				s.end = n.FirstTimestamp.Time
+			}
+		}
+		if n.LastTimestamp.Time.After(last) {
+			last = n.LastTimestamp.Time
+		}
+	}
+	// incidents recovered before recoveries were recorded end with their last notification
+	if s.end.IsZero() && incident.Labels[monitoring.LabelKeyProblemRecovered] == "true" {
+		s.end = last
+	}
+	return s
+}
+
+// accumulator computes the report of the incidents added to it.
+type accumulator struct {
+	report        incidents.AlertReport
+	spans         []span
+	toAcknowledge time.Duration
+	toRecover     time.Duration
+}
+
+func (a *accumulator) add(s span, from, to time.Time) {
+	if !s.start.Before(to) || (!s.end.IsZero() && !s.end.After(from)) {
+		return
+	}
+	a.spans = append(a.spans, s)
+	if s.start.Before(from) {
+		return
+	}
+	a.report.Incidents++
+	if !s.acknowledged.IsZero() && s.acknowledged.Before(to) {
+		a.report.Acknowledged++
+		a.toAcknowledge += s.acknowledged.Sub(s.start)
+	}
+	if !s.end.IsZero() && s.end.Before(to) {
+		a.report.Recovered++
+		a.toRecover += s.end.Sub(s.start)
+	}
+}
+
+func (a *accumulator) complete(from, to time.Time) incidents.AlertReport {
+	r := a.report
+	r.Downtime = metav1.Duration{Duration: downtime(a.spans, from, to)}
+	r.Availability = 100
+	if period := to.Sub(from); period > 0 {
+		r.Availability = 100 * float64(period-r.Downtime.Duration) / float64(period)
+	}
+	if r.Acknowledged > 0 {
+		r.MeanTimeToAcknowledge = &metav1.Duration{Duration: (a.toAcknowledge / time.Duration(r.Acknowledged)).Round(time.Second)}
+	}
+	if r.Recovered > 0 {
+		r.MeanTimeToRecover = &metav1.Duration{Duration: (a.toRecover / time.Duration(r.Recovered)).Round(time.Second)}
+	}
+	return r
+}
+
+// downtime returns the duration of the period from to, during which any of spans is open.
+func downtime(spans []span, from, to time.Time) time.Duration {
+	type interval struct{ start, end time.Time }
+	intervals := make([]interval, 0, len(spans))
+	for _, s := range spans {
+		i := interval{start: s.start, end: s.end}
+		if i.start.Before(from) {
+			i.start = from
+		}
+		if i.end.IsZero() || i.end.After(to) {
+			i.end = to
+		}
+		if i.end.After(i.start) {
+			intervals = append(intervals, i)
+		}
+	}
+	sort.Slice(intervals, func(i, j int) bool {
+		return intervals[i].start.Before(intervals[j].start)
+	})
+
+	var total time.Duration
+	var cur interval
+	for i, in := range intervals {
+		if i > 0 && !in.start.After(cur.end) {
+			if in.end.After(cur.end) {
+				cur.end = in.end
+			}
+			continue
+		}
+		total += cur.end.Sub(cur.start)
+		cur = in
+	}
+	return total + cur.end.Sub(cur.start)
+}
+
+// Compute returns the report of alerts in namespace over the period from to, computed from their incidents.
+// Alerts without incidents in the period are reported as fully available. Alerts of other incidents, such
+// as deleted ones, are reported too. Only alerts with the given names are reported, unless names is empty.
+func Compute(namespace string, alerts []Alert, names []string, items []monitoring.Incident, from, to time.Time) incidents.ReportResponse {
+	selected := sets.NewString(names...)
+	byAlert := map[Alert]*accumulator{}
+	for _, alert := range alerts {
+		if selected.Len() == 0 || selected.Has(alert.Name) {
+			byAlert[alert] = &accumulator{}
+		}
+	}
+	summary := &accumulator{}
+	for i := range items {
+		incident := &items[i]
+		alert := Alert{
+			Kind: alertKinds[incident.Labels[monitoring.LabelKeyAlertType]],
+			Name: incident.Labels[monitoring.LabelKeyAlert],
+		}
+		if alert.Kind == "" || alert.Name == "" || (selected.Len() > 0 && !selected.Has(alert.Name)) {
+			continue
+		}
+		acc, found := byAlert[alert]
+		if !found {
+			acc = &accumulator{}
+			byAlert[alert] = acc
+		}
+		s := incidentSpan(incident)
+		acc.add(s, from, to)
+		summary.add(s, from, to)
+	}
+
+	resp := incidents.ReportResponse{
+		From:    metav1.NewTime(from),
+		To:      metav1.NewTime(to),
+		Summary: summary.complete(from, to),
+	}
+	resp.Summary.Namespace = namespace
+	for alert, acc := range byAlert {
+		r := acc.complete(from, to)
+		r.Namespace, r.Kind, r.Name = namespace, alert.Kind, alert.Name
+		resp.Alerts = append(resp.Alerts, r)
+	}
+	sort.Slice(resp.Alerts, func(i, j int) bool {
+		if resp.Alerts[i].Kind != resp.Alerts[j].Kind {
+			return resp.Alerts[i].Kind < resp.Alerts[j].Kind
+		}
+		return resp.Alerts[i].Name < resp.Alerts[j].Name
+	})
+	return resp
+}
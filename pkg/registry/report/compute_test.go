@@ -0,0 +1,95 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newIncident(hostType, alert string, recovered bool, notifications ...monitoring.IncidentNotification) monitoring.Incident {
+	return monitoring.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "demo",
+			Labels: map[string]string{
+				monitoring.LabelKeyAlertType:        hostType,
+				monitoring.LabelKeyAlert:            alert,
+				monitoring.LabelKeyProblemRecovered: map[bool]string{true: "true", false: "false"}[recovered],
+			},
+		},
+		Status: monitoring.IncidentStatus{Notifications: notifications},
+	}
+}
+
+func notification(t monitoring.IncidentNotificationType, at time.Time) monitoring.IncidentNotification {
+	return monitoring.IncidentNotification{Type: t, FirstTimestamp: metav1.NewTime(at), LastTimestamp: metav1.NewTime(at)}
+}
+
+func TestCompute(t *testing.T) {
+	from := time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(10 * time.Hour)
+	items := []monitoring.Incident{
+		newIncident(icinga.TypePod, "pod-exec", true,
+			notification(monitoring.NotificationProblem, from.Add(time.Hour)),
+			notification(monitoring.NotificationAcknowledgement, from.Add(70*time.Minute)),
+			notification(monitoring.NotificationRecovery, from.Add(2*time.Hour))),
+		newIncident(icinga.TypePod, "pod-exec", true,
+			notification(monitoring.NotificationProblem, from.Add(90*time.Minute)),
+			notification(monitoring.NotificationResolution, from.Add(3*time.Hour))),
+		// started before the period
+		newIncident(icinga.TypePod, "pod-exec", true,
+			notification(monitoring.NotificationProblem, from.Add(-time.Hour)),
+			notification(monitoring.NotificationRecovery, from.Add(30*time.Minute))),
+		// ended before the period
+		newIncident(icinga.TypePod, "pod-exec", true,
+			notification(monitoring.NotificationProblem, from.Add(-2*time.Hour)),
+			notification(monitoring.NotificationRecovery, from.Add(-time.Hour))),
+		// alert is deleted, incident is open
+		newIncident(icinga.TypeCluster, "pod-exists", false,
+			notification(monitoring.NotificationProblem, from.Add(9*time.Hour))),
+	}
+	alerts := []Alert{
+		{Kind: monitoring.ResourceKindPodAlert, Name: "pod-exec"},
+		{Kind: monitoring.ResourceKindNodeAlert, Name: "node-status"},
+	}
+
+	resp := Compute("demo", alerts, nil, items, from, to)
+	assert.Equal(t, from, resp.From.Time)
+	assert.Equal(t, to, resp.To.Time)
+	if assert.Len(t, resp.Alerts, 3) {
+		exists, node, pod := resp.Alerts[0], resp.Alerts[1], resp.Alerts[2]
+
+		assert.Equal(t, "pod-exists", exists.Name)
+		assert.Equal(t, int32(1), exists.Incidents)
+		assert.Equal(t, int32(0), exists.Recovered)
+		assert.Equal(t, time.Hour, exists.Downtime.Duration)
+		assert.Nil(t, exists.MeanTimeToRecover)
+
+		assert.Equal(t, monitoring.ResourceKindNodeAlert, node.Kind)
+		assert.Equal(t, int32(0), node.Incidents)
+		assert.Equal(t, float64(100), node.Availability)
+
+		assert.Equal(t, "demo", pod.Namespace)
+		assert.Equal(t, int32(2), pod.Incidents)
+		assert.Equal(t, int32(1), pod.Acknowledged)
+		assert.Equal(t, int32(2), pod.Recovered)
+		assert.Equal(t, 150*time.Minute, pod.Downtime.Duration)
+		assert.Equal(t, float64(75), pod.Availability)
+		assert.Equal(t, &metav1.Duration{Duration: 10 * time.Minute}, pod.MeanTimeToAcknowledge)
+		assert.Equal(t, &metav1.Duration{Duration: 75 * time.Minute}, pod.MeanTimeToRecover)
+	}
+
+	assert.Equal(t, "demo", resp.Summary.Namespace)
+	assert.Empty(t, resp.Summary.Name)
+	assert.Equal(t, int32(3), resp.Summary.Incidents)
+	assert.Equal(t, 210*time.Minute, resp.Summary.Downtime.Duration)
+	assert.Equal(t, float64(65), resp.Summary.Availability)
+
+	resp = Compute("demo", alerts, []string{"pod-exec"}, items, from, to)
+	assert.Len(t, resp.Alerts, 1)
+	assert.Equal(t, int32(2), resp.Summary.Incidents)
+	assert.Equal(t, 150*time.Minute, resp.Summary.Downtime.Duration)
+}
@@ -0,0 +1,145 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/rest"
+	restconfig "k8s.io/client-go/rest"
+)
+
+type REST struct {
+	client versioned.Interface
+	// Age after which recovered or resolved incidents are garbage collected, kept if 0
+	incidentTTL time.Duration
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(config *restconfig.Config, incidentTTL time.Duration) *REST {
+	return &REST{
+		client:      versioned.NewForConfigOrDie(config),
+		incidentTTL: incidentTTL,
+	}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) New() runtime.Object {
+	return &incidents.Report{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindReport)
+}
+
+// Create computes the report of alerts in namespace over the requested period from their incidents.
+// Periods ending in future are reported until now. Periods starting before incidentTTL are refused, since
+// their incidents may have been garbage collected.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	req := obj.(*incidents.Report)
+
+	now := time.Now()
+	if errs := validate(req, now, r.incidentTTL); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: incidents.GroupName, Kind: v1alpha1.ResourceKindReport}, req.Name, errs)
+	}
+
+	to := now
+	if req.Request.To != nil && req.Request.To.Time.Before(now) {
+		to = req.Request.To.Time
+	}
+
+	alerts, err := r.listAlerts(req.Namespace)
+	if err != nil {
+		return nil, apierrors.NewInternalError(errors.Wrapf(err, "failed to list alerts of namespace %s", req.Namespace))
+	}
+	incidentList, err := r.client.MonitoringV1alpha1().Incidents(req.Namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, apierrors.NewInternalError(errors.Wrapf(err, "failed to list incidents of namespace %s", req.Namespace))
+	}
+
+	req.Response = Compute(req.Namespace, alerts, req.Request.Alerts, incidentList.Items, req.Request.From.Time, to)
+	req.Response.Timestamp = metav1.NewTime(now)
+	return req, nil
+}
+
+// listAlerts returns the alerts of all kinds in namespace.
+func (r *REST) listAlerts(namespace string) ([]Alert, error) {
+	client := r.client.MonitoringV1alpha1()
+	var alerts []Alert
+
+	clusterAlerts, err := client.ClusterAlerts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range clusterAlerts.Items {
+		alerts = append(alerts, Alert{Kind: monitoring.ResourceKindClusterAlert, Name: a.Name})
+	}
+	nodeAlerts, err := client.NodeAlerts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range nodeAlerts.Items {
+		alerts = append(alerts, Alert{Kind: monitoring.ResourceKindNodeAlert, Name: a.Name})
+	}
+	podAlerts, err := client.PodAlerts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range podAlerts.Items {
+		alerts = append(alerts, Alert{Kind: monitoring.ResourceKindPodAlert, Name: a.Name})
+	}
+	serviceAlerts, err := client.ServiceAlerts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range serviceAlerts.Items {
+		alerts = append(alerts, Alert{Kind: monitoring.ResourceKindServiceAlert, Name: a.Name})
+	}
+	workloadAlerts, err := client.WorkloadAlerts(namespace).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range workloadAlerts.Items {
+		alerts = append(alerts, Alert{Kind: monitoring.ResourceKindWorkloadAlert, Name: a.Name})
+	}
+	return alerts, nil
+}
+
+// validate returns the errors of the request of report o at now. Incidents older than incidentTTL are
+// garbage collected, so periods starting before then would be reported as available.
+func validate(o *incidents.Report, now time.Time, incidentTTL time.Duration) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if o.Request.From.IsZero() {
+		errs = append(errs,
+			field.Required(field.NewPath("request", "from"), "from must be set"))
+	} else if o.Request.From.Time.After(now) {
+		errs = append(errs,
+			field.Invalid(field.NewPath("request", "from"), o.Request.From, "from must not be in future"))
+	} else if oldest := now.Add(-incidentTTL); incidentTTL > 0 && o.Request.From.Time.Before(oldest) {
+		errs = append(errs,
+			field.Invalid(field.NewPath("request", "from"), o.Request.From,
+				fmt.Sprintf("from must not be before %s, incidents older than incident-ttl %s are garbage collected", oldest.UTC().Format(time.RFC3339), incidentTTL)))
+	}
+	if o.Request.To != nil && !o.Request.To.Time.After(o.Request.From.Time) {
+		errs = append(errs,
+			field.Invalid(field.NewPath("request", "to"), o.Request.To, "to must be after from"))
+	}
+	return errs
+}
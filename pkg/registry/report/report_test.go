@@ -0,0 +1,39 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestValidate(t *testing.T) {
+	now := time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC)
+	ttl := 30 * 24 * time.Hour
+	at := func(d time.Duration) *metav1.Time {
+		t := metav1.NewTime(now.Add(d))
+		return &t
+	}
+
+	cases := []struct {
+		name    string
+		request incidents.ReportRequest
+		ttl     time.Duration
+		valid   bool
+	}{
+		{"period within incident-ttl", incidents.ReportRequest{From: *at(-ttl), To: at(-time.Hour)}, ttl, true},
+		{"from is not set", incidents.ReportRequest{}, ttl, false},
+		{"from is in future", incidents.ReportRequest{From: *at(time.Hour)}, ttl, false},
+		{"to is before from", incidents.ReportRequest{From: *at(-time.Hour), To: at(-2 * time.Hour)}, ttl, false},
+		{"from is before incident-ttl", incidents.ReportRequest{From: *at(-ttl - time.Second)}, ttl, false},
+		{"incidents are kept", incidents.ReportRequest{From: *at(-ttl - time.Second)}, 0, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			errs := validate(&incidents.Report{Request: c.request}, now, c.ttl)
+			assert.Equal(t, c.valid, len(errs) == 0, errs)
+		})
+	}
+}
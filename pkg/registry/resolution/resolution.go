@@ -0,0 +1,89 @@
+package resolution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	monitoring "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/plugins/notifier"
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	apirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+type REST struct {
+	dispatcher *notifier.Dispatcher
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(dispatcher *notifier.Dispatcher) *REST {
+	return &REST{
+		dispatcher: dispatcher,
+	}
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) New() runtime.Object {
+	return &incidents.Resolution{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind(v1alpha1.ResourceKindResolution)
+}
+
+// Create resolves incident, and records the resolution in incident with the user as author. Later
+// notifications of the target open a new incident, if its problem persists.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	req := obj.(*incidents.Resolution)
+
+	if errs := validate(req); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: incidents.GroupName, Kind: v1alpha1.ResourceKindResolution}, req.Name, errs)
+	}
+
+	var author string
+	if user, ok := apirequest.UserFrom(ctx); ok {
+		author = user.GetName()
+	}
+	now := metav1.Now()
+	alreadyResolved := false
+	_, err := r.dispatcher.UpdateIncident(req.Namespace, req.Name, func(in *monitoring.Incident) *monitoring.Incident {
+		alreadyResolved = !notifier.ResolveIncident(in, author, req.Request.Comment, now)
+		return in
+	})
+	if apierrors.IsNotFound(err) {
+		return nil, apierrors.NewNotFound(monitoring.Resource(monitoring.ResourcePluralIncident), req.Name)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "failed to resolve incident %s/%s", req.Namespace, req.Name)
+	} else if alreadyResolved {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("incident %s/%s is already resolved", req.Namespace, req.Name))
+	}
+
+	req.Response = incidents.ResolutionResponse{
+		Timestamp: now,
+		Author:    author,
+	}
+	return req, nil
+}
+
+func validate(o *incidents.Resolution) field.ErrorList {
+	errs := field.ErrorList{}
+
+	if o.Request.Comment == "" {
+		errs = append(errs,
+			field.Required(field.NewPath("request", "comment"), "comment must not be empty"))
+	}
+	return errs
+}
@@ -0,0 +1,71 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/appscode/searchlight/apis/monitoring/install"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+)
+
+var (
+	monitoringScheme = runtime.NewScheme()
+	monitoringCodecs = serializer.NewCodecFactory(monitoringScheme)
+)
+
+func init() {
+	install.Install(monitoringScheme)
+}
+
+// ConvertAlerts converts ClusterAlert, NodeAlert and PodAlert objects sent by kube-apiserver
+// to the desired version of monitoring.appscode.com group.
+func ConvertAlerts(req *apiextensions.ConversionRequest) *apiextensions.ConversionResponse {
+	resp := &apiextensions.ConversionResponse{
+		UID: req.UID,
+	}
+
+	gv, err := schema.ParseGroupVersion(req.DesiredAPIVersion)
+	if err != nil {
+		resp.Result = conversionFailure(err)
+		return resp
+	}
+
+	if !monitoringScheme.IsVersionRegistered(gv) {
+		resp.Result = conversionFailure(fmt.Errorf("unsupported version %s", gv))
+		return resp
+	}
+
+	resp.ConvertedObjects = make([]runtime.RawExtension, 0, len(req.Objects))
+	for _, in := range req.Objects {
+		data, err := convertAlert(in.Raw, gv)
+		if err != nil {
+			resp.ConvertedObjects = nil
+			resp.Result = conversionFailure(err)
+			return resp
+		}
+		resp.ConvertedObjects = append(resp.ConvertedObjects, runtime.RawExtension{Raw: data})
+	}
+	resp.Result = metav1.Status{
+		Status: metav1.StatusSuccess,
+	}
+	return resp
+}
+
+func convertAlert(data []byte, gv schema.GroupVersion) ([]byte, error) {
+	// external versions are only converted via internal version
+	obj, err := runtime.Decode(monitoringCodecs.UniversalDecoder(), data)
+	if err != nil {
+		return nil, err
+	}
+	return runtime.Encode(monitoringCodecs.LegacyCodec(gv), obj)
+}
+
+func conversionFailure(err error) metav1.Status {
+	return metav1.Status{
+		Status:  metav1.StatusFailure,
+		Message: err.Error(),
+	}
+}
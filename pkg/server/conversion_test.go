@@ -0,0 +1,169 @@
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/apis/monitoring/v1beta1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func convert(t *testing.T, in interface{}, desiredAPIVersion string, out interface{}) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := ConvertAlerts(&apiextensions.ConversionRequest{
+		UID:               "test",
+		DesiredAPIVersion: desiredAPIVersion,
+		Objects:           []runtime.RawExtension{{Raw: data}},
+	})
+	if resp.UID != "test" {
+		t.Fatalf("expected uid test, found %s", resp.UID)
+	}
+	if resp.Result.Status != metav1.StatusSuccess {
+		t.Fatalf("conversion to %s failed: %s", desiredAPIVersion, resp.Result.Message)
+	}
+	if len(resp.ConvertedObjects) != 1 {
+		t.Fatalf("expected 1 converted object, found %d", len(resp.ConvertedObjects))
+	}
+	if err := json.Unmarshal(resp.ConvertedObjects[0].Raw, out); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConvertNodeAlertSelector(t *testing.T) {
+	selector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "node-role.kubernetes.io/master", Operator: metav1.LabelSelectorOpDoesNotExist},
+		},
+	}
+	in := &v1beta1.NodeAlert{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1beta1.SchemeGroupVersion.String(), Kind: v1beta1.ResourceKindNodeAlert},
+		ObjectMeta: metav1.ObjectMeta{Name: "node-volume", Namespace: "demo", Annotations: map[string]string{"team": "ops"}},
+		Spec: v1beta1.NodeAlertSpec{
+			Selector:          selector,
+			Check:             v1alpha1.CheckNodeVolume,
+			CheckInterval:     metav1.Duration{Duration: 30 * time.Second},
+			MaxCheckAttempts:  3,
+			RetryInterval:     metav1.Duration{Duration: 10 * time.Second},
+			EnableFlapping:    true,
+			NotificationDelay: metav1.Duration{Duration: 5 * time.Minute},
+			Receivers: []v1beta1.Receiver{
+				{State: v1beta1.StateCritical, To: []string{"ops@example.com"}, Notifier: "Mailgun"},
+			},
+			DependsOn: []v1beta1.AlertDependency{
+				{Kind: v1beta1.ResourceKindClusterAlert, Name: "api-server"},
+			},
+		},
+	}
+
+	alpha := &v1alpha1.NodeAlert{}
+	convert(t, in, v1alpha1.SchemeGroupVersion.String(), alpha)
+	if alpha.APIVersion != v1alpha1.SchemeGroupVersion.String() {
+		t.Errorf("expected apiVersion %s, found %s", v1alpha1.SchemeGroupVersion, alpha.APIVersion)
+	}
+	if _, found := alpha.Annotations[v1alpha1.AnnotationKeyNodeSelector]; !found {
+		t.Errorf("expected selector to be preserved in %s annotation", v1alpha1.AnnotationKeyNodeSelector)
+	}
+	if len(alpha.Spec.Selector) != 0 {
+		t.Errorf("expected empty v1alpha1 selector, found %v", alpha.Spec.Selector)
+	}
+	if alpha.Spec.Receivers[0].State != string(v1beta1.StateCritical) {
+		t.Errorf("expected receiver state %s, found %s", v1beta1.StateCritical, alpha.Spec.Receivers[0].State)
+	}
+
+	out := &v1beta1.NodeAlert{}
+	convert(t, alpha, v1beta1.SchemeGroupVersion.String(), out)
+	if !reflect.DeepEqual(in.Spec, out.Spec) {
+		t.Errorf("spec changed in round trip:\nexpected %+v\nfound    %+v", in.Spec, out.Spec)
+	}
+	if !reflect.DeepEqual(in.Annotations, out.Annotations) {
+		t.Errorf("annotations changed in round trip: expected %v, found %v", in.Annotations, out.Annotations)
+	}
+}
+
+func TestConvertLegacyNodeAlert(t *testing.T) {
+	in := &v1alpha1.NodeAlert{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.ResourceKindNodeAlert},
+		ObjectMeta: metav1.ObjectMeta{Name: "node-volume", Namespace: "demo"},
+		Spec: v1alpha1.NodeAlertSpec{
+			Selector: map[string]string{"beta.kubernetes.io/os": "linux"},
+			Check:    "node_volume",
+			Vars:     map[string]string{"mountpoint": "/mnt/sda1"},
+		},
+	}
+
+	out := &v1beta1.NodeAlert{}
+	convert(t, in, v1beta1.SchemeGroupVersion.String(), out)
+	if out.Spec.Check != v1alpha1.CheckNodeVolume {
+		t.Errorf("expected check %s, found %s", v1alpha1.CheckNodeVolume, out.Spec.Check)
+	}
+	if expected := map[string]string{"mountPoint": "/mnt/sda1"}; !reflect.DeepEqual(out.Spec.Vars, expected) {
+		t.Errorf("expected vars %v, found %v", expected, out.Spec.Vars)
+	}
+	if out.Spec.Selector == nil || !reflect.DeepEqual(out.Spec.Selector.MatchLabels, in.Spec.Selector) {
+		t.Errorf("expected selector with labels %v, found %v", in.Spec.Selector, out.Spec.Selector)
+	}
+}
+
+func TestConvertLegacyPodAlert(t *testing.T) {
+	in := &v1alpha1.PodAlert{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.ResourceKindPodAlert},
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-status", Namespace: "demo"},
+		Spec: v1alpha1.PodAlertSpec{
+			Check: "pod_status",
+			Receivers: []v1alpha1.Receiver{
+				{State: "critical", To: []string{"ops@example.com"}, Notifier: "Mailgun"},
+			},
+		},
+	}
+
+	out := &v1alpha1.PodAlert{}
+	convert(t, in, v1alpha1.SchemeGroupVersion.String(), out)
+	if out.Spec.Check != v1alpha1.CheckPodStatus {
+		t.Errorf("expected check %s, found %s", v1alpha1.CheckPodStatus, out.Spec.Check)
+	}
+	if out.Spec.Receivers[0].State != string(v1beta1.StateCritical) {
+		t.Errorf("expected receiver state %s, found %s", v1beta1.StateCritical, out.Spec.Receivers[0].State)
+	}
+}
+
+func TestConvertPodAlertNamespaceSelector(t *testing.T) {
+	in := &v1alpha1.PodAlert{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: v1alpha1.ResourceKindPodAlert},
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-status", Namespace: "monitoring"},
+		Spec: v1alpha1.PodAlertSpec{
+			Check:             v1alpha1.CheckPodStatus,
+			Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"app": "nginx"}},
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}},
+		},
+	}
+
+	beta := &v1beta1.PodAlert{}
+	convert(t, in, v1beta1.SchemeGroupVersion.String(), beta)
+	if !reflect.DeepEqual(beta.Spec.NamespaceSelector, in.Spec.NamespaceSelector) {
+		t.Errorf("expected namespace selector %v, found %v", in.Spec.NamespaceSelector, beta.Spec.NamespaceSelector)
+	}
+
+	out := &v1alpha1.PodAlert{}
+	convert(t, beta, v1alpha1.SchemeGroupVersion.String(), out)
+	if !reflect.DeepEqual(out.Spec.NamespaceSelector, in.Spec.NamespaceSelector) {
+		t.Errorf("expected namespace selector %v, found %v", in.Spec.NamespaceSelector, out.Spec.NamespaceSelector)
+	}
+}
+
+func TestConvertUnsupportedVersion(t *testing.T) {
+	resp := ConvertAlerts(&apiextensions.ConversionRequest{
+		UID:               "test",
+		DesiredAPIVersion: "monitoring.appscode.com/v2",
+	})
+	if resp.Result.Status != metav1.StatusFailure {
+		t.Errorf("expected conversion to fail, found %s", resp.Result.Status)
+	}
+}
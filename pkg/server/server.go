@@ -7,9 +7,18 @@ import (
 	"github.com/appscode/searchlight/apis/incidents"
 	"github.com/appscode/searchlight/apis/incidents/install"
 	"github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	monitoringv1alpha1 "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
 	"github.com/appscode/searchlight/pkg/operator"
 	ackregistry "github.com/appscode/searchlight/pkg/registry/acknowledgement"
+	assignmentregistry "github.com/appscode/searchlight/pkg/registry/assignment"
+	commentregistry "github.com/appscode/searchlight/pkg/registry/comment"
+	"github.com/appscode/searchlight/pkg/registry/conversionreview"
+	escalationregistry "github.com/appscode/searchlight/pkg/registry/escalation"
+	notificationregistry "github.com/appscode/searchlight/pkg/registry/notification"
+	reportregistry "github.com/appscode/searchlight/pkg/registry/report"
+	resolutionregistry "github.com/appscode/searchlight/pkg/registry/resolution"
 	admission "k8s.io/api/admission/v1beta1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -29,6 +38,7 @@ var (
 func init() {
 	install.Install(Scheme)
 	admission.AddToScheme(Scheme)
+	apiextensions.AddToScheme(Scheme)
 
 	// we need to add the options to empty v1
 	// TODO fix the server code to avoid this
@@ -154,12 +164,43 @@ func (c completedConfig) New() (*SearchlightServer, error) {
 	{
 		apiGroupInfo := genericapiserver.NewDefaultAPIGroupInfo(incidents.GroupName, Scheme, metav1.ParameterCodec, Codecs)
 		v1alpha1storage := map[string]rest.Storage{}
-		v1alpha1storage[v1alpha1.ResourcePluralAcknowledgement] = ackregistry.NewREST(c.OperatorConfig.ClientConfig, c.OperatorConfig.IcingaClient)
+		ackStorage := ackregistry.NewREST(c.OperatorConfig.ClientConfig, c.OperatorConfig.IcingaClient)
+		v1alpha1storage[v1alpha1.ResourcePluralAcknowledgement] = ackStorage
+		v1alpha1storage[v1alpha1.ResourcePluralNotification] = notificationregistry.NewREST(ctrl.Dispatcher())
+		v1alpha1storage[v1alpha1.ResourcePluralComment] = commentregistry.NewREST(c.OperatorConfig.ClientConfig, c.OperatorConfig.IcingaClient, ctrl.Dispatcher())
+		v1alpha1storage[v1alpha1.ResourcePluralAssignment] = assignmentregistry.NewREST(ctrl.Dispatcher())
+		v1alpha1storage[v1alpha1.ResourcePluralResolution] = resolutionregistry.NewREST(ctrl.Dispatcher())
+		v1alpha1storage[v1alpha1.ResourcePluralEscalation] = escalationregistry.NewREST(c.OperatorConfig.ClientConfig, ctrl.Dispatcher())
+		v1alpha1storage[v1alpha1.ResourcePluralReport] = reportregistry.NewREST(c.OperatorConfig.ClientConfig, c.OperatorConfig.IncidentTTL)
 		apiGroupInfo.VersionedResourcesStorageMap["v1alpha1"] = v1alpha1storage
 
 		if err := s.GenericAPIServer.InstallAPIGroup(&apiGroupInfo); err != nil {
 			return nil, err
 		}
+
+		if links := c.OperatorConfig.AcknowledgeLinks; links != nil {
+			s.GenericAPIServer.Handler.NonGoRestfulMux.Handle(ackregistry.LinkPath, ackregistry.NewLinkHandler(ackStorage, links))
+		}
+	}
+
+	{
+		conversionVersion := schema.GroupVersion{Group: monitoringv1alpha1.ConversionWebhookGroup, Version: "v1alpha1"}
+		apiGroupInfo := genericapiserver.APIGroupInfo{
+			PrioritizedVersions: []schema.GroupVersion{conversionVersion},
+			VersionedResourcesStorageMap: map[string]map[string]rest.Storage{
+				conversionVersion.Version: {
+					"conversionreviews": conversionreview.NewREST(ConvertAlerts),
+				},
+			},
+			OptionsExternalVersion: &schema.GroupVersion{Version: "v1"},
+			Scheme:                 Scheme,
+			ParameterCodec:         metav1.ParameterCodec,
+			NegotiatedSerializer:   Codecs,
+		}
+
+		if err := s.GenericAPIServer.InstallAPIGroup(&apiGroupInfo); err != nil {
+			return nil, err
+		}
 	}
 
 	return s, nil
@@ -53,7 +53,7 @@ func (o *options) complete(cmd *cobra.Command) error {
 		return errors.New("invalid icinga host.name")
 	}
 	o.podName = o.host.ObjectName
-	o.namespace = o.host.AlertNamespace
+	o.namespace = o.host.ObjectNamespace
 
 	o.kubeconfigPath, err = cmd.Flags().GetString(plugins.FlagKubeConfig)
 	if err != nil {
@@ -146,6 +146,17 @@ var _ = Describe("check_pod_status", func() {
 				err = opts.validate()
 				Expect(err).ShouldNot(HaveOccurred())
 			})
+			It("with pod in other namespace", func() {
+				opts := options{}
+				cmd.Flags().Set(plugins.FlagHost, "monitoring@pod@name@demo")
+				err := opts.complete(cmd)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(opts.podName).Should(BeIdenticalTo("name"))
+				Expect(opts.namespace).Should(BeIdenticalTo("demo"))
+				Expect(opts.host.AlertNamespace).Should(BeIdenticalTo("monitoring"))
+				err = opts.validate()
+				Expect(err).ShouldNot(HaveOccurred())
+			})
 		})
 	})
 })
@@ -0,0 +1,138 @@
+package check_service_endpoints
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/appscode/go/flags"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"kmodules.xyz/client-go/tools/clientcmd"
+)
+
+type plugin struct {
+	client  corev1.EndpointsInterface
+	options options
+}
+
+var _ plugins.PluginInterface = &plugin{}
+
+func newPlugin(client corev1.EndpointsInterface, opts options) *plugin {
+	return &plugin{client, opts}
+}
+
+func newPluginFromConfig(opts options) (*plugin, error) {
+	client, err := clientcmd.ClientFromContext(opts.kubeconfigPath, opts.contextName)
+	if err != nil {
+		return nil, err
+	}
+	return newPlugin(client.CoreV1().Endpoints(opts.namespace), opts), nil
+}
+
+type options struct {
+	kubeconfigPath string
+	contextName    string
+	// options
+	serviceName string
+	namespace   string
+	minReady    int
+	// IcingaHost
+	host *icinga.IcingaHost
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	hostname, err := cmd.Flags().GetString(plugins.FlagHost)
+	if err != nil {
+		return err
+	}
+	o.host, err = icinga.ParseHost(hostname)
+	if err != nil {
+		return errors.New("invalid icinga host.name")
+	}
+	o.serviceName = o.host.ObjectName
+	o.namespace = o.host.AlertNamespace
+
+	o.kubeconfigPath, err = cmd.Flags().GetString(plugins.FlagKubeConfig)
+	if err != nil {
+		return err
+	}
+	o.contextName, err = cmd.Flags().GetString(plugins.FlagKubeConfigContext)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.host.Type != icinga.TypeService {
+		return errors.New("invalid icinga host type")
+	}
+	if o.minReady < 1 {
+		return errors.New("minReady must be at least 1")
+	}
+	return nil
+}
+
+type message struct {
+	Ready    int `json:"ready"`
+	NotReady int `json:"notReady"`
+}
+
+// Check is Critical when less than minReady addresses are ready and Warning when
+// some addresses are not ready.
+func (p *plugin) Check() (icinga.State, interface{}) {
+	ep, err := p.client.Get(p.options.serviceName, metav1.GetOptions{})
+	if err != nil {
+		return icinga.Unknown, err
+	}
+
+	msg := message{}
+	for _, subset := range ep.Subsets {
+		msg.Ready += len(subset.Addresses)
+		msg.NotReady += len(subset.NotReadyAddresses)
+	}
+
+	state := icinga.OK
+	if msg.Ready < p.options.minReady {
+		state = icinga.Critical
+	} else if msg.NotReady > 0 {
+		state = icinga.Warning
+	}
+
+	output, err := json.MarshalIndent(msg, "", " ")
+	if err != nil {
+		return icinga.Unknown, err
+	}
+	return state, string(output)
+}
+
+func NewCmd() *cobra.Command {
+	var opts options
+	c := &cobra.Command{
+		Use:   "check_service_endpoints",
+		Short: "Check ready endpoints of Kubernetes Service",
+
+		Run: func(cmd *cobra.Command, args []string) {
+			flags.EnsureRequiredFlags(cmd, plugins.FlagHost)
+
+			if err := opts.complete(cmd); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			if err := opts.validate(); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			plugin, err := newPluginFromConfig(opts)
+			if err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			icinga.Output(plugin.Check())
+		},
+	}
+
+	c.Flags().StringP(plugins.FlagHost, "H", "", "Icinga host name")
+	c.Flags().IntVar(&opts.minReady, "minReady", 1, "Minimum number of ready endpoints")
+	return c
+}
@@ -0,0 +1,135 @@
+package check_service_endpoints
+
+import (
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+var _ = Describe("check_service_endpoints", func() {
+	var ep *core.Endpoints
+	var client corev1.EndpointsInterface
+	var opts options
+
+	BeforeEach(func() {
+		client = cs.CoreV1().Endpoints("demo")
+		ep = &core.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "nginx",
+			},
+		}
+		opts = options{
+			serviceName: ep.Name,
+			minReady:    1,
+		}
+	})
+
+	AfterEach(func() {
+		if client != nil {
+			client.Delete(ep.Name, &metav1.DeleteOptions{})
+		}
+	})
+
+	Describe("there are ready endpoints", func() {
+		Context("with no other problems", func() {
+			It("should be OK", func() {
+				ep.Subsets = []core.EndpointSubset{
+					{Addresses: []core.EndpointAddress{{IP: "10.0.0.1"}}},
+				}
+				_, err := client.Create(ep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				state, _ := newPlugin(client, opts).Check()
+				Expect(state).Should(BeIdenticalTo(icinga.OK))
+			})
+		})
+		Context("with not ready endpoints", func() {
+			It("should be Warning", func() {
+				ep.Subsets = []core.EndpointSubset{
+					{
+						Addresses:         []core.EndpointAddress{{IP: "10.0.0.1"}},
+						NotReadyAddresses: []core.EndpointAddress{{IP: "10.0.0.2"}},
+					},
+				}
+				_, err := client.Create(ep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				state, _ := newPlugin(client, opts).Check()
+				Expect(state).Should(BeIdenticalTo(icinga.Warning))
+			})
+		})
+	})
+
+	Describe("there are not enough ready endpoints", func() {
+		Context("with no endpoints", func() {
+			It("should be Critical", func() {
+				_, err := client.Create(ep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				state, _ := newPlugin(client, opts).Check()
+				Expect(state).Should(BeIdenticalTo(icinga.Critical))
+			})
+		})
+		Context("with less than minReady", func() {
+			It("should be Critical", func() {
+				ep.Subsets = []core.EndpointSubset{
+					{Addresses: []core.EndpointAddress{{IP: "10.0.0.1"}}},
+				}
+				_, err := client.Create(ep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				opts.minReady = 2
+				state, _ := newPlugin(client, opts).Check()
+				Expect(state).Should(BeIdenticalTo(icinga.Critical))
+			})
+		})
+	})
+
+	Describe("Check validation", func() {
+		var (
+			cmd *cobra.Command
+		)
+
+		JustBeforeEach(func() {
+			cmd = new(cobra.Command)
+			cmd.Flags().String(plugins.FlagHost, "", "")
+			cmd.Flags().String(plugins.FlagKubeConfig, "", "")
+			cmd.Flags().String(plugins.FlagKubeConfigContext, "", "")
+		})
+
+		Context("for invalid", func() {
+			It("with invalid part", func() {
+				opts := options{}
+				cmd.Flags().Set(plugins.FlagHost, "demo@service")
+				err := opts.complete(cmd)
+				Expect(err).Should(HaveOccurred())
+			})
+			It("with invalid type", func() {
+				opts := options{minReady: 1}
+				cmd.Flags().Set(plugins.FlagHost, "demo@cluster")
+				err := opts.complete(cmd)
+				Expect(err).ShouldNot(HaveOccurred())
+				err = opts.validate()
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+		Context("for valid", func() {
+			It("with valid name", func() {
+				opts := options{minReady: 1}
+				cmd.Flags().Set(plugins.FlagHost, "demo@service@nginx")
+				err := opts.complete(cmd)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(opts.serviceName).Should(BeIdenticalTo("nginx"))
+				Expect(opts.namespace).Should(BeIdenticalTo("demo"))
+				Expect(opts.host.Type).Should(BeIdenticalTo("service"))
+				err = opts.validate()
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+	})
+})
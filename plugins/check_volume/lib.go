@@ -309,7 +309,7 @@ func (p *plugin) checkPodVolume() (icinga.State, interface{}) {
 	opts := p.options
 	host := opts.host
 
-	pod, err := p.client.CoreV1().Pods(host.AlertNamespace).Get(host.ObjectName, metav1.GetOptions{})
+	pod, err := p.client.CoreV1().Pods(host.ObjectNamespace).Get(host.ObjectName, metav1.GetOptions{})
 	if err != nil {
 		return icinga.Unknown, err
 	}
@@ -317,7 +317,7 @@ func (p *plugin) checkPodVolume() (icinga.State, interface{}) {
 	for _, volume := range pod.Spec.Volumes {
 		if volume.Name == opts.volumeName {
 			if volume.PersistentVolumeClaim != nil {
-				claim, err := p.client.CoreV1().PersistentVolumeClaims(host.AlertNamespace).Get(volume.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
+				claim, err := p.client.CoreV1().PersistentVolumeClaims(host.ObjectNamespace).Get(volume.PersistentVolumeClaim.ClaimName, metav1.GetOptions{})
 				if err != nil {
 					return icinga.Unknown, err
 				}
@@ -0,0 +1,144 @@
+package check_workload_replicas
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/appscode/go/flags"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"kmodules.xyz/client-go/tools/clientcmd"
+)
+
+type plugin struct {
+	client  kubernetes.Interface
+	options options
+}
+
+var _ plugins.PluginInterface = &plugin{}
+
+func newPlugin(client kubernetes.Interface, opts options) *plugin {
+	return &plugin{client, opts}
+}
+
+func newPluginFromConfig(opts options) (*plugin, error) {
+	client, err := clientcmd.ClientFromContext(opts.kubeconfigPath, opts.contextName)
+	if err != nil {
+		return nil, err
+	}
+	return newPlugin(client, opts), nil
+}
+
+type options struct {
+	kubeconfigPath string
+	contextName    string
+	// options
+	workloadKind string
+	workloadName string
+	namespace    string
+	minAvailable int
+	// IcingaHost
+	host *icinga.IcingaHost
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	hostname, err := cmd.Flags().GetString(plugins.FlagHost)
+	if err != nil {
+		return err
+	}
+	o.host, err = icinga.ParseHost(hostname)
+	if err != nil {
+		return errors.New("invalid icinga host.name")
+	}
+	o.namespace = o.host.AlertNamespace
+
+	o.kubeconfigPath, err = cmd.Flags().GetString(plugins.FlagKubeConfig)
+	if err != nil {
+		return err
+	}
+	o.contextName, err = cmd.Flags().GetString(plugins.FlagKubeConfigContext)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.host.Type != icinga.TypeWorkload {
+		return errors.New("invalid icinga host type")
+	}
+	var err error
+	o.workloadKind, o.workloadName, err = icinga.ParseWorkloadObjectName(o.host.ObjectName)
+	if err != nil {
+		return err
+	}
+	if o.minAvailable < 0 {
+		return errors.New("minAvailable can't be negative")
+	}
+	return nil
+}
+
+type message struct {
+	Desired   int32 `json:"desired"`
+	Available int32 `json:"available"`
+}
+
+// Check is Critical when less than minAvailable replicas are available and Warning when
+// less than desired replicas are available. If minAvailable is not set, Check is Critical
+// when no replica is available.
+func (p *plugin) Check() (icinga.State, interface{}) {
+	opts := p.options
+
+	w, err := plugins.GetWorkload(p.client, opts.workloadKind, opts.namespace, opts.workloadName)
+	if err != nil {
+		return icinga.Unknown, err
+	}
+
+	minAvailable := int32(opts.minAvailable)
+	if minAvailable == 0 && w.Desired > 0 {
+		minAvailable = 1
+	}
+
+	state := icinga.OK
+	if w.Available < minAvailable {
+		state = icinga.Critical
+	} else if w.Available < w.Desired {
+		state = icinga.Warning
+	}
+
+	output, err := json.MarshalIndent(message{Desired: w.Desired, Available: w.Available}, "", " ")
+	if err != nil {
+		return icinga.Unknown, err
+	}
+	return state, string(output)
+}
+
+func NewCmd() *cobra.Command {
+	var opts options
+	c := &cobra.Command{
+		Use:   "check_workload_replicas",
+		Short: "Check available replicas of Deployment, StatefulSet or DaemonSet",
+
+		Run: func(cmd *cobra.Command, args []string) {
+			flags.EnsureRequiredFlags(cmd, plugins.FlagHost)
+
+			if err := opts.complete(cmd); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			if err := opts.validate(); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			plugin, err := newPluginFromConfig(opts)
+			if err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			icinga.Output(plugin.Check())
+		},
+	}
+
+	c.Flags().StringP(plugins.FlagHost, "H", "", "Icinga host name")
+	c.Flags().IntVar(&opts.minAvailable, "minAvailable", 0, "Minimum number of available replicas")
+	return c
+}
@@ -0,0 +1,151 @@
+package check_workload_replicas
+
+import (
+	"github.com/appscode/go/types"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	apps "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("check_workload_replicas", func() {
+	var deploy *apps.Deployment
+	var opts options
+
+	BeforeEach(func() {
+		deploy = &apps.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "nginx",
+				Namespace: "demo",
+			},
+			Spec: apps.DeploymentSpec{
+				Replicas: types.Int32P(3),
+			},
+		}
+		opts = options{
+			workloadKind: "Deployment",
+			workloadName: deploy.Name,
+			namespace:    deploy.Namespace,
+		}
+	})
+
+	AfterEach(func() {
+		cs.AppsV1().Deployments(deploy.Namespace).Delete(deploy.Name, &metav1.DeleteOptions{})
+	})
+
+	Describe("all replicas are available", func() {
+		It("should be OK", func() {
+			deploy.Status.AvailableReplicas = 3
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.OK))
+		})
+	})
+
+	Describe("some replicas are not available", func() {
+		It("should be Warning", func() {
+			deploy.Status.AvailableReplicas = 2
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Warning))
+		})
+		It("should be Critical with less than minAvailable", func() {
+			deploy.Status.AvailableReplicas = 1
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			opts.minAvailable = 2
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Critical))
+		})
+	})
+
+	Describe("no replica is available", func() {
+		It("should be Critical", func() {
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Critical))
+		})
+	})
+
+	Describe("daemonset", func() {
+		It("should use desired number scheduled", func() {
+			ds := &apps.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "agent",
+					Namespace: "demo",
+				},
+				Status: apps.DaemonSetStatus{
+					DesiredNumberScheduled: 3,
+					NumberAvailable:        2,
+				},
+			}
+			_, err := cs.AppsV1().DaemonSets(ds.Namespace).Create(ds)
+			Expect(err).ShouldNot(HaveOccurred())
+			defer cs.AppsV1().DaemonSets(ds.Namespace).Delete(ds.Name, &metav1.DeleteOptions{})
+
+			state, _ := newPlugin(cs, options{workloadKind: "DaemonSet", workloadName: ds.Name, namespace: ds.Namespace}).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Warning))
+		})
+	})
+
+	Describe("Check validation", func() {
+		var (
+			cmd *cobra.Command
+		)
+
+		JustBeforeEach(func() {
+			cmd = new(cobra.Command)
+			cmd.Flags().String(plugins.FlagHost, "", "")
+			cmd.Flags().String(plugins.FlagKubeConfig, "", "")
+			cmd.Flags().String(plugins.FlagKubeConfigContext, "", "")
+		})
+
+		Context("for invalid", func() {
+			It("with invalid part", func() {
+				opts := options{}
+				cmd.Flags().Set(plugins.FlagHost, "demo@workload")
+				err := opts.complete(cmd)
+				Expect(err).Should(HaveOccurred())
+			})
+			It("with invalid type", func() {
+				opts := options{}
+				cmd.Flags().Set(plugins.FlagHost, "demo@pod@nginx")
+				err := opts.complete(cmd)
+				Expect(err).ShouldNot(HaveOccurred())
+				err = opts.validate()
+				Expect(err).Should(HaveOccurred())
+			})
+			It("with invalid kind", func() {
+				opts := options{}
+				cmd.Flags().Set(plugins.FlagHost, "demo@workload@job.nginx")
+				err := opts.complete(cmd)
+				Expect(err).ShouldNot(HaveOccurred())
+				err = opts.validate()
+				Expect(err).Should(HaveOccurred())
+			})
+		})
+		Context("for valid", func() {
+			It("with valid name", func() {
+				opts := options{}
+				cmd.Flags().Set(plugins.FlagHost, "demo@workload@statefulset.web.db")
+				err := opts.complete(cmd)
+				Expect(err).ShouldNot(HaveOccurred())
+				err = opts.validate()
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(opts.workloadKind).Should(BeIdenticalTo("StatefulSet"))
+				Expect(opts.workloadName).Should(BeIdenticalTo("web.db"))
+				Expect(opts.namespace).Should(BeIdenticalTo("demo"))
+			})
+		})
+	})
+})
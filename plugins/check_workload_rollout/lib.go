@@ -0,0 +1,147 @@
+package check_workload_rollout
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/appscode/go/flags"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins"
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+	"kmodules.xyz/client-go/tools/clientcmd"
+)
+
+type plugin struct {
+	client  kubernetes.Interface
+	options options
+}
+
+var _ plugins.PluginInterface = &plugin{}
+
+func newPlugin(client kubernetes.Interface, opts options) *plugin {
+	return &plugin{client, opts}
+}
+
+func newPluginFromConfig(opts options) (*plugin, error) {
+	client, err := clientcmd.ClientFromContext(opts.kubeconfigPath, opts.contextName)
+	if err != nil {
+		return nil, err
+	}
+	return newPlugin(client, opts), nil
+}
+
+type options struct {
+	kubeconfigPath string
+	contextName    string
+	// options
+	workloadKind string
+	workloadName string
+	namespace    string
+	// IcingaHost
+	host *icinga.IcingaHost
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	hostname, err := cmd.Flags().GetString(plugins.FlagHost)
+	if err != nil {
+		return err
+	}
+	o.host, err = icinga.ParseHost(hostname)
+	if err != nil {
+		return errors.New("invalid icinga host.name")
+	}
+	o.namespace = o.host.AlertNamespace
+
+	o.kubeconfigPath, err = cmd.Flags().GetString(plugins.FlagKubeConfig)
+	if err != nil {
+		return err
+	}
+	o.contextName, err = cmd.Flags().GetString(plugins.FlagKubeConfigContext)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.host.Type != icinga.TypeWorkload {
+		return errors.New("invalid icinga host type")
+	}
+	var err error
+	o.workloadKind, o.workloadName, err = icinga.ParseWorkloadObjectName(o.host.ObjectName)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type message struct {
+	Desired            int32  `json:"desired"`
+	Updated            int32  `json:"updated"`
+	Available          int32  `json:"available"`
+	Generation         int64  `json:"generation"`
+	ObservedGeneration int64  `json:"observedGeneration"`
+	Reason             string `json:"reason,omitempty"`
+}
+
+// Check is Warning while a rollout is in progress and Critical when a Deployment has
+// exceeded its progress deadline. StatefulSets and DaemonSets have no progress deadline.
+func (p *plugin) Check() (icinga.State, interface{}) {
+	opts := p.options
+
+	w, err := plugins.GetWorkload(p.client, opts.workloadKind, opts.namespace, opts.workloadName)
+	if err != nil {
+		return icinga.Unknown, err
+	}
+
+	msg := message{
+		Desired:            w.Desired,
+		Updated:            w.Updated,
+		Available:          w.Available,
+		Generation:         w.Generation,
+		ObservedGeneration: w.ObservedGeneration,
+	}
+
+	state := icinga.OK
+	if w.ProgressDeadlineExceeded {
+		state = icinga.Critical
+		msg.Reason = "ProgressDeadlineExceeded"
+	} else if w.ObservedGeneration < w.Generation || w.Updated < w.Desired {
+		state = icinga.Warning
+		msg.Reason = "RolloutInProgress"
+	}
+
+	output, err := json.MarshalIndent(msg, "", " ")
+	if err != nil {
+		return icinga.Unknown, err
+	}
+	return state, string(output)
+}
+
+func NewCmd() *cobra.Command {
+	var opts options
+	c := &cobra.Command{
+		Use:   "check_workload_rollout",
+		Short: "Check rollout of Deployment, StatefulSet or DaemonSet",
+
+		Run: func(cmd *cobra.Command, args []string) {
+			flags.EnsureRequiredFlags(cmd, plugins.FlagHost)
+
+			if err := opts.complete(cmd); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			if err := opts.validate(); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			plugin, err := newPluginFromConfig(opts)
+			if err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			icinga.Output(plugin.Check())
+		},
+	}
+
+	c.Flags().StringP(plugins.FlagHost, "H", "", "Icinga host name")
+	return c
+}
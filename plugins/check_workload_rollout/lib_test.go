@@ -0,0 +1,90 @@
+package check_workload_rollout
+
+import (
+	"github.com/appscode/go/types"
+	"github.com/appscode/searchlight/pkg/icinga"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("check_workload_rollout", func() {
+	var deploy *apps.Deployment
+	var opts options
+
+	BeforeEach(func() {
+		deploy = &apps.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "nginx",
+				Namespace:  "demo",
+				Generation: 2,
+			},
+			Spec: apps.DeploymentSpec{
+				Replicas: types.Int32P(2),
+			},
+			Status: apps.DeploymentStatus{
+				ObservedGeneration: 2,
+				UpdatedReplicas:    2,
+				AvailableReplicas:  2,
+			},
+		}
+		opts = options{
+			workloadKind: "Deployment",
+			workloadName: deploy.Name,
+			namespace:    deploy.Namespace,
+		}
+	})
+
+	AfterEach(func() {
+		cs.AppsV1().Deployments(deploy.Namespace).Delete(deploy.Name, &metav1.DeleteOptions{})
+	})
+
+	Describe("rollout is complete", func() {
+		It("should be OK", func() {
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.OK))
+		})
+	})
+
+	Describe("rollout is in progress", func() {
+		It("should be Warning with new generation", func() {
+			deploy.Generation = 3
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Warning))
+		})
+		It("should be Warning with outdated replicas", func() {
+			deploy.Status.UpdatedReplicas = 1
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Warning))
+		})
+	})
+
+	Describe("rollout is stuck", func() {
+		It("should be Critical", func() {
+			deploy.Status.UpdatedReplicas = 1
+			deploy.Status.Conditions = []apps.DeploymentCondition{
+				{
+					Type:   apps.DeploymentProgressing,
+					Status: core.ConditionFalse,
+					Reason: "ProgressDeadlineExceeded",
+				},
+			}
+			_, err := cs.AppsV1().Deployments(deploy.Namespace).Create(deploy)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Critical))
+		})
+	})
+})
@@ -0,0 +1,173 @@
+package check_workload_unavailable
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/appscode/go/flags"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins"
+	"github.com/spf13/cobra"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"kmodules.xyz/client-go/tools/clientcmd"
+)
+
+type plugin struct {
+	client  kubernetes.Interface
+	options options
+}
+
+var _ plugins.PluginInterface = &plugin{}
+
+func newPlugin(client kubernetes.Interface, opts options) *plugin {
+	return &plugin{client, opts}
+}
+
+func newPluginFromConfig(opts options) (*plugin, error) {
+	client, err := clientcmd.ClientFromContext(opts.kubeconfigPath, opts.contextName)
+	if err != nil {
+		return nil, err
+	}
+	return newPlugin(client, opts), nil
+}
+
+type options struct {
+	kubeconfigPath string
+	contextName    string
+	// options
+	workloadKind string
+	workloadName string
+	namespace    string
+	duration     time.Duration
+	// IcingaHost
+	host *icinga.IcingaHost
+}
+
+func (o *options) complete(cmd *cobra.Command) error {
+	hostname, err := cmd.Flags().GetString(plugins.FlagHost)
+	if err != nil {
+		return err
+	}
+	o.host, err = icinga.ParseHost(hostname)
+	if err != nil {
+		return errors.New("invalid icinga host.name")
+	}
+	o.namespace = o.host.AlertNamespace
+
+	o.kubeconfigPath, err = cmd.Flags().GetString(plugins.FlagKubeConfig)
+	if err != nil {
+		return err
+	}
+	o.contextName, err = cmd.Flags().GetString(plugins.FlagKubeConfigContext)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *options) validate() error {
+	if o.host.Type != icinga.TypeWorkload {
+		return errors.New("invalid icinga host type")
+	}
+	var err error
+	o.workloadKind, o.workloadName, err = icinga.ParseWorkloadObjectName(o.host.ObjectName)
+	if err != nil {
+		return err
+	}
+	if o.duration <= 0 {
+		return errors.New("duration must be positive")
+	}
+	return nil
+}
+
+type unavailablePod struct {
+	Name  string      `json:"name"`
+	Since metav1.Time `json:"since"`
+}
+
+type message struct {
+	Pods        int              `json:"pods"`
+	Unavailable []unavailablePod `json:"unavailable,omitempty"`
+}
+
+// unavailableSince returns the time since when a pod is not ready. Pods without Ready
+// condition are unavailable since they were created.
+func unavailableSince(pod core.Pod) (metav1.Time, bool) {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == core.PodReady {
+			return c.LastTransitionTime, c.Status != core.ConditionTrue
+		}
+	}
+	return pod.CreationTimestamp, true
+}
+
+// Check is Critical when some pods of the workload are not ready for longer than duration
+// and Warning when some pods are not ready for a shorter time.
+func (p *plugin) Check() (icinga.State, interface{}) {
+	opts := p.options
+
+	w, err := plugins.GetWorkload(p.client, opts.workloadKind, opts.namespace, opts.workloadName)
+	if err != nil {
+		return icinga.Unknown, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(w.Selector)
+	if err != nil {
+		return icinga.Unknown, err
+	}
+	pods, err := p.client.CoreV1().Pods(opts.namespace).List(metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return icinga.Unknown, err
+	}
+
+	msg := message{Pods: len(pods.Items)}
+	state := icinga.OK
+	for _, pod := range pods.Items {
+		since, unavailable := unavailableSince(pod)
+		if !unavailable {
+			continue
+		}
+		msg.Unavailable = append(msg.Unavailable, unavailablePod{Name: pod.Name, Since: since})
+		if time.Since(since.Time) > opts.duration {
+			state = icinga.Critical
+		} else if state == icinga.OK {
+			state = icinga.Warning
+		}
+	}
+
+	output, err := json.MarshalIndent(msg, "", " ")
+	if err != nil {
+		return icinga.Unknown, err
+	}
+	return state, string(output)
+}
+
+func NewCmd() *cobra.Command {
+	var opts options
+	c := &cobra.Command{
+		Use:   "check_workload_unavailable",
+		Short: "Check pods of Deployment, StatefulSet or DaemonSet unavailable for a duration",
+
+		Run: func(cmd *cobra.Command, args []string) {
+			flags.EnsureRequiredFlags(cmd, plugins.FlagHost)
+
+			if err := opts.complete(cmd); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			if err := opts.validate(); err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			plugin, err := newPluginFromConfig(opts)
+			if err != nil {
+				icinga.Output(icinga.Unknown, err)
+			}
+			icinga.Output(plugin.Check())
+		},
+	}
+
+	c.Flags().StringP(plugins.FlagHost, "H", "", "Icinga host name")
+	c.Flags().DurationVar(&opts.duration, "duration", 5*time.Minute, "Duration for which a pod can be unavailable before Critical")
+	return c
+}
@@ -0,0 +1,105 @@
+package check_workload_unavailable
+
+import (
+	"time"
+
+	"github.com/appscode/searchlight/pkg/icinga"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("check_workload_unavailable", func() {
+	var sts *apps.StatefulSet
+	var pod *core.Pod
+	var opts options
+
+	BeforeEach(func() {
+		sts = &apps.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web",
+				Namespace: "demo",
+			},
+			Spec: apps.StatefulSetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "web"},
+				},
+			},
+		}
+		_, err := cs.AppsV1().StatefulSets(sts.Namespace).Create(sts)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		pod = &core.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "web-0",
+				Namespace: "demo",
+				Labels:    map[string]string{"app": "web"},
+			},
+		}
+		opts = options{
+			workloadKind: "StatefulSet",
+			workloadName: sts.Name,
+			namespace:    sts.Namespace,
+			duration:     5 * time.Minute,
+		}
+	})
+
+	AfterEach(func() {
+		cs.AppsV1().StatefulSets(sts.Namespace).Delete(sts.Name, &metav1.DeleteOptions{})
+		cs.CoreV1().Pods(pod.Namespace).Delete(pod.Name, &metav1.DeleteOptions{})
+	})
+
+	setReady := func(status core.ConditionStatus, since time.Duration) {
+		pod.Status.Conditions = []core.PodCondition{
+			{
+				Type:               core.PodReady,
+				Status:             status,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-since)),
+			},
+		}
+	}
+
+	Describe("pods are ready", func() {
+		It("should be OK", func() {
+			setReady(core.ConditionTrue, time.Hour)
+			_, err := cs.CoreV1().Pods(pod.Namespace).Create(pod)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.OK))
+		})
+	})
+
+	Describe("pods are not ready", func() {
+		It("should be Warning for a short time", func() {
+			setReady(core.ConditionFalse, time.Minute)
+			_, err := cs.CoreV1().Pods(pod.Namespace).Create(pod)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Warning))
+		})
+		It("should be Critical for longer than duration", func() {
+			setReady(core.ConditionFalse, 10*time.Minute)
+			_, err := cs.CoreV1().Pods(pod.Namespace).Create(pod)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.Critical))
+		})
+	})
+
+	Describe("pods of other workloads", func() {
+		It("should be ignored", func() {
+			pod.Labels = map[string]string{"app": "db"}
+			setReady(core.ConditionFalse, 10*time.Minute)
+			_, err := cs.CoreV1().Pods(pod.Namespace).Create(pod)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			state, _ := newPlugin(cs, opts).Check()
+			Expect(state).Should(BeIdenticalTo(icinga.OK))
+		})
+	})
+})
@@ -18,8 +18,12 @@ import (
 	"github.com/appscode/searchlight/plugins/check_pod_exec"
 	"github.com/appscode/searchlight/plugins/check_pod_exists"
 	"github.com/appscode/searchlight/plugins/check_pod_status"
+	"github.com/appscode/searchlight/plugins/check_service_endpoints"
 	"github.com/appscode/searchlight/plugins/check_volume"
 	"github.com/appscode/searchlight/plugins/check_webhook"
+	"github.com/appscode/searchlight/plugins/check_workload_replicas"
+	"github.com/appscode/searchlight/plugins/check_workload_rollout"
+	"github.com/appscode/searchlight/plugins/check_workload_unavailable"
 	"github.com/appscode/searchlight/plugins/notifier"
 	"github.com/spf13/cobra"
 	clientsetscheme "k8s.io/client-go/kubernetes/scheme"
@@ -61,6 +65,14 @@ func NewCmd() *cobra.Command {
 	cmd.AddCommand(check_pod_status.NewCmd())
 	cmd.AddCommand(check_pod_exec.NewCmd())
 
+	// CheckService
+	cmd.AddCommand(check_service_endpoints.NewCmd())
+
+	// CheckWorkload
+	cmd.AddCommand(check_workload_rollout.NewCmd())
+	cmd.AddCommand(check_workload_replicas.NewCmd())
+	cmd.AddCommand(check_workload_unavailable.NewCmd())
+
 	// Combined
 	cmd.AddCommand(check_volume.NewCmd())
 
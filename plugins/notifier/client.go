@@ -0,0 +1,63 @@
+package notifier
+
+import (
+	"fmt"
+	"strings"
+
+	incidentapi "github.com/appscode/searchlight/apis/incidents/v1alpha1"
+	cs "github.com/appscode/searchlight/client/clientset/versioned/typed/incidents/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"kmodules.xyz/client-go/tools/clientcmd"
+)
+
+func newClientFromConfig(opts options) (cs.NotificationsGetter, error) {
+	config, err := clientcmd.BuildConfigFromContext(opts.kubeconfigPath, opts.contextName)
+	if err != nil {
+		return nil, err
+	}
+	return cs.NewForConfig(config)
+}
+
+// postNotification posts the notification of Icinga to Searchlight operator, which sends it to receivers.
+func postNotification(client cs.NotificationsGetter, opts options) error {
+	notification, err := client.Notifications(opts.host.AlertNamespace).Create(&incidentapi.Notification{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      opts.alertName,
+			Namespace: opts.host.AlertNamespace,
+		},
+		Request: incidentapi.NotificationRequest{
+			Alert:   opts.alertName,
+			Host:    opts.hostname,
+			Type:    opts.notificationType,
+			State:   opts.serviceState,
+			Output:  opts.serviceOutput,
+			Time:    metav1.NewTime(opts.time),
+			Author:  opts.author,
+			Comment: opts.comment,
+			DryRun:  opts.dryRun,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to post notification to Searchlight operator. Reason: %v", err)
+	}
+	if opts.dryRun {
+		printResponse(opts, notification.Response)
+	}
+	return nil
+}
+
+// printResponse prints the receivers of a notification in dry-run mode.
+func printResponse(opts options, resp incidentapi.NotificationResponse) {
+	for _, receiver := range resp.Receivers {
+		source := "alert " + opts.host.AlertNamespace + "/" + opts.alertName
+		if receiver.Route != "" {
+			source = "route " + receiver.Route
+		}
+		fmt.Printf("%s: %s to %s\n", source, receiver.Notifier, strings.Join(receiver.To, ", "))
+	}
+	if resp.SuppressedBy != "" {
+		fmt.Printf("Notification is suppressed by %s\n", resp.SuppressedBy)
+	} else if resp.DigestTargets > 0 {
+		fmt.Printf("Notification is sent as a digest of %d targets\n", resp.DigestTargets)
+	}
+}
@@ -0,0 +1,312 @@
+package notifier
+
+import (
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	mon_listers "github.com/appscode/searchlight/client/listers/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apps_listers "k8s.io/client-go/listers/apps/v1"
+	core_listers "k8s.io/client-go/listers/core/v1"
+)
+
+// Correlation configures how incidents are correlated under parent incidents.
+type Correlation struct {
+	// Correlate incidents of pods under the incident of their node
+	ByNode bool
+	// Correlate incidents of pods under the incident of their workload
+	ByWorkload bool
+	// Correlate incidents whose problems start within this duration after the problem of an open
+	// incident under it. Disabled, if 0.
+	Window time.Duration
+}
+
+func (c Correlation) Enabled() bool {
+	return c.ByNode || c.ByWorkload || c.Window > 0
+}
+
+// correlator finds the open incidents that an incident correlates with.
+type correlator struct {
+	Correlation
+	incidents mon_listers.IncidentLister
+	// read the node and workload of pods, nil if incidents are not correlated by them
+	pods        core_listers.PodLister
+	replicaSets apps_listers.ReplicaSetLister
+}
+
+// correlationTarget is the target of an incident and the start of its problem.
+type correlationTarget struct {
+	// namespace and name of incident, name is empty for incidents that are not created yet
+	namespace string
+	name      string
+
+	hostType        string
+	objectName      string
+	objectNamespace string
+	start           time.Time
+	placement       placement
+}
+
+func incidentTarget(incident *api.Incident) correlationTarget {
+	t := correlationTarget{
+		namespace:       incident.Namespace,
+		name:            incident.Name,
+		hostType:        incident.Labels[api.LabelKeyAlertType],
+		objectName:      incident.Labels[api.LabelKeyObjectName],
+		objectNamespace: incident.Labels[api.LabelKeyObjectNamespace],
+		start:           problemStart(incident).Time,
+		placement: placement{
+			node:     incident.Labels[api.LabelKeyNodeName],
+			workload: incident.Labels[api.LabelKeyWorkload],
+		},
+	}
+	if t.objectNamespace == "" {
+		t.objectNamespace = incident.Namespace
+	}
+	return t
+}
+
+// placement is the node and workload of the pod of a target. workload is the object name of its Icinga host.
+// It is recorded in the labels of the incidents of pods, so that they are correlated even after their pod
+// is evicted or deleted.
+type placement struct {
+	node     string
+	workload string
+}
+
+// placement returns the placement of the pod in namespace with name. Pods that are deleted before their
+// first problem have no placement.
+func (c *correlator) placement(namespace, name string) placement {
+	if c.pods == nil {
+		return placement{}
+	}
+	pod, err := c.pods.Pods(namespace).Get(name)
+	if err != nil {
+		return placement{}
+	}
+	p := placement{node: pod.Spec.NodeName}
+
+	owner := metav1.GetControllerOf(pod)
+	if owner != nil && owner.Kind == "ReplicaSet" {
+		if c.replicaSets == nil {
+			return p
+		}
+		rs, err := c.replicaSets.ReplicaSets(pod.Namespace).Get(owner.Name)
+		if err != nil {
+			return p
+		}
+		owner = metav1.GetControllerOf(rs)
+	}
+	if owner != nil && c.ByWorkload {
+		switch owner.Kind {
+		case api.WorkloadKindDeployment, api.WorkloadKindStatefulSet, api.WorkloadKindDaemonSet:
+			p.workload = icinga.WorkloadObjectName(owner.Kind, owner.Name)
+		}
+	}
+	return p
+}
+
+// correlates returns how target is correlated under parent, if it is. Targets are only correlated by time
+// window under incidents in their namespace or of ClusterAlerts, as nothing else relates them.
+func (c *correlator) correlates(parent *api.Incident, t correlationTarget) (api.IncidentCorrelationType, bool) {
+	p := t.placement
+	pt := incidentTarget(parent)
+	if pt.namespace == t.namespace && pt.name == t.name {
+		return "", false
+	}
+	if c.ByNode && p.node != "" && pt.hostType == icinga.TypeNode && pt.objectName == p.node {
+		return api.CorrelationNode, true
+	}
+	if c.ByWorkload && p.workload != "" && pt.hostType == icinga.TypeWorkload &&
+		pt.namespace == t.objectNamespace && pt.objectName == p.workload {
+		return api.CorrelationWorkload, true
+	}
+	// incidents of nodes and workloads stay parents of the incidents of their pods
+	if c.Window > 0 && t.hostType != icinga.TypeNode && t.hostType != icinga.TypeWorkload &&
+		(pt.namespace == t.namespace || pt.hostType == icinga.TypeCluster) &&
+		startsBefore(pt, t) && t.start.Sub(pt.start) <= c.Window {
+		return api.CorrelationTimeWindow, true
+	}
+	return "", false
+}
+
+// startsBefore returns true, if the problem of a starts before the one of b. Ties are broken by
+// names of incidents, so that two incidents are never correlated under each other.
+func startsBefore(a, b correlationTarget) bool {
+	if !a.start.Equal(b.start) {
+		return a.start.Before(b.start)
+	}
+	return a.namespace+"/"+a.name < b.namespace+"/"+b.name
+}
+
+// openIncidents returns the open incidents in all namespaces.
+func (c *correlator) openIncidents() ([]*api.Incident, error) {
+	return c.incidents.List(labels.SelectorFromSet(labels.Set{api.LabelKeyProblemRecovered: "false"}))
+}
+
+// findParent returns the open incident that target is correlated under, if any. Incidents of the node
+// of a pod are preferred to the ones of its workload, which are preferred to the ones correlated by
+// time window. Among these, the incident whose problem started first is chosen.
+func (c *correlator) findParent(t correlationTarget) (*api.CorrelatedIncidentReference, error) {
+	incidents, err := c.openIncidents()
+	if err != nil {
+		return nil, err
+	}
+
+	rank := map[api.IncidentCorrelationType]int{
+		api.CorrelationNode:       0,
+		api.CorrelationWorkload:   1,
+		api.CorrelationTimeWindow: 2,
+	}
+	var parent *api.Incident
+	var correlatedBy api.IncidentCorrelationType
+	for _, incident := range incidents {
+		if incident.DeletionTimestamp != nil || incident.Status.ParentRef != nil {
+			continue
+		}
+		by, ok := c.correlates(incident, t)
+		if !ok {
+			continue
+		}
+		if parent == nil || rank[by] < rank[correlatedBy] ||
+			(rank[by] == rank[correlatedBy] && startsBefore(incidentTarget(incident), incidentTarget(parent))) {
+			parent, correlatedBy = incident, by
+		}
+	}
+	if parent == nil {
+		return nil, nil
+	}
+	return &api.CorrelatedIncidentReference{
+		Namespace:    parent.Namespace,
+		Name:         parent.Name,
+		CorrelatedBy: correlatedBy,
+	}, nil
+}
+
+// findChildren returns the open incidents of pods without parent that are correlated under the incident
+// of their node or workload, by the placement recorded in their labels.
+func (c *correlator) findChildren(parent *api.Incident) ([]api.CorrelatedIncidentReference, error) {
+	switch parent.Labels[api.LabelKeyAlertType] {
+	case icinga.TypeNode:
+		if !c.ByNode {
+			return nil, nil
+		}
+	case icinga.TypeWorkload:
+		if !c.ByWorkload {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+	incidents, err := c.openIncidents()
+	if err != nil {
+		return nil, err
+	}
+
+	var children []api.CorrelatedIncidentReference
+	for _, incident := range incidents {
+		if incident.DeletionTimestamp != nil || incident.Status.ParentRef != nil || len(incident.Status.Children) > 0 {
+			continue
+		}
+		t := incidentTarget(incident)
+		if t.hostType != icinga.TypePod {
+			continue
+		}
+		if by, ok := c.correlates(parent, t); ok && by != api.CorrelationTimeWindow {
+			children = append(children, api.CorrelatedIncidentReference{
+				Namespace:    incident.Namespace,
+				Name:         incident.Name,
+				CorrelatedBy: by,
+			})
+		}
+	}
+	return children, nil
+}
+
+// isOpen returns true, if the problem of incident is neither recovered nor resolved.
+func isOpen(incident *api.Incident) bool {
+	return incident.DeletionTimestamp == nil && incident.Labels[api.LabelKeyProblemRecovered] != "true"
+}
+
+// problemNotified returns true, if a problem of incident was sent to receivers.
+func problemNotified(incident *api.Incident) bool {
+	for _, item := range incident.Status.Notifications {
+		if item.Type == api.NotificationProblem && item.SuppressedBy == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// correlate finds the open parent incident that this notification is grouped under, if any. Problems of
+// targets without incident get the parent they correlate under. Recoveries of incidents whose problem
+// was sent are not grouped, so that receivers learn about them.
+func (n *notifier) correlate(incident *api.Incident) error {
+	c := n.correlator
+	if c == nil || !c.Enabled() {
+		return nil
+	}
+	if incident == nil {
+		if api.AlertType(n.options.notificationType) != api.NotificationProblem {
+			return nil
+		}
+		host := n.options.host
+		t := correlationTarget{
+			namespace:       host.AlertNamespace,
+			hostType:        host.Type,
+			objectName:      host.ObjectName,
+			objectNamespace: host.ObjectNamespace,
+			start:           n.options.time,
+		}
+		if t.objectNamespace == "" {
+			t.objectNamespace = host.AlertNamespace
+		}
+		if t.hostType == icinga.TypePod {
+			t.placement = c.placement(t.objectNamespace, t.objectName)
+		}
+		parent, err := c.findParent(t)
+		n.parent = parent
+		return err
+	}
+
+	ref := incident.Status.ParentRef
+	if ref == nil {
+		return nil
+	}
+	parent, err := c.incidents.Incidents(ref.Namespace).Get(ref.Name)
+	if kerr.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !isOpen(parent) {
+		return nil
+	}
+	if api.AlertType(n.options.notificationType) == api.NotificationRecovery && problemNotified(incident) {
+		return nil
+	}
+	n.parent = ref
+	return nil
+}
+
+// placementLabels returns the labels of the node and workload of the pod of this notification, that are
+// recorded in its new incident.
+func (n *notifier) placementLabels() map[string]string {
+	c := n.correlator
+	host := n.options.host
+	if c == nil || !(c.ByNode || c.ByWorkload) || host.Type != icinga.TypePod {
+		return nil
+	}
+	p := c.placement(host.ObjectNamespace, host.ObjectName)
+	out := map[string]string{}
+	if p.node != "" {
+		out[api.LabelKeyNodeName] = p.node
+	}
+	if p.workload != "" {
+		out[api.LabelKeyWorkload] = p.workload
+	}
+	return out
+}
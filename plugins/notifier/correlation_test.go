@@ -0,0 +1,173 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	mon_listers "github.com/appscode/searchlight/client/listers/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apps_listers "k8s.io/client-go/listers/apps/v1"
+	core_listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func newCorrelationIncident(namespace, name, hostType, objectName string, start time.Time) *api.Incident {
+	return &api.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				api.LabelKeyAlertType:        hostType,
+				api.LabelKeyObjectName:       objectName,
+				api.LabelKeyProblemRecovered: "false",
+			},
+		},
+		Status: api.IncidentStatus{
+			Notifications: []api.IncidentNotification{
+				{Type: api.NotificationProblem, FirstTimestamp: metav1.NewTime(start), LastTimestamp: metav1.NewTime(start)},
+			},
+		},
+	}
+}
+
+func TestPlacement(t *testing.T) {
+	controller := true
+	rs := &apps.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "nginx-5d4b8",
+			Namespace:       "demo",
+			OwnerReferences: []metav1.OwnerReference{{Kind: api.WorkloadKindDeployment, Name: "nginx", Controller: &controller}},
+		},
+	}
+	pod := &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "nginx-5d4b8-x7k2p",
+			Namespace:       "demo",
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: rs.Name, Controller: &controller}},
+		},
+		Spec: core.PodSpec{NodeName: "node-1"},
+	}
+	pods := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, pods.Add(pod))
+	replicaSets := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.Nil(t, replicaSets.Add(rs))
+
+	c := &correlator{
+		Correlation: Correlation{ByNode: true, ByWorkload: true},
+		pods:        core_listers.NewPodLister(pods),
+		replicaSets: apps_listers.NewReplicaSetLister(replicaSets),
+	}
+	assert.Equal(t, placement{node: "node-1", workload: "deployment.nginx"}, c.placement("demo", pod.Name))
+	// deleted pods have no placement
+	assert.Equal(t, placement{}, c.placement("demo", "nginx-5d4b8-a1b2c"))
+
+	host, err := icinga.ParseHost("demo@pod@" + pod.Name)
+	assert.Nil(t, err)
+	n := newPlugin(nil, nil, options{host: host})
+	n.correlator = c
+	assert.Equal(t, map[string]string{
+		api.LabelKeyNodeName: "node-1",
+		api.LabelKeyWorkload: "deployment.nginx",
+	}, n.placementLabels())
+
+	// placement is not recorded, unless incidents are correlated by node or workload
+	n.correlator = &correlator{Correlation: Correlation{Window: 5 * time.Minute}}
+	assert.Nil(t, n.placementLabels())
+}
+
+func TestCorrelator(t *testing.T) {
+	now := time.Now()
+	node := newCorrelationIncident("demo", "node.node-1.node-status.20180428-1109", icinga.TypeNode, "node-1", now.Add(time.Minute))
+	workload := newCorrelationIncident("demo", "workload.deployment.nginx.deploy-status.20180428-1108", icinga.TypeWorkload, "deployment.nginx", now)
+	cluster := newCorrelationIncident("demo", "cluster.pod-exists.20180428-1107", icinga.TypeCluster, "", now.Add(-time.Minute))
+	// pod is evicted from the failed node, its incident keeps the placement
+	podIncident := newCorrelationIncident("demo", "pod.nginx-5d4b8-x7k2p.pod-exec.20180428-1110", icinga.TypePod, "nginx-5d4b8-x7k2p", now.Add(2*time.Minute))
+	podIncident.Labels[api.LabelKeyNodeName] = "node-1"
+	podIncident.Labels[api.LabelKeyWorkload] = "deployment.nginx"
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, incident := range []*api.Incident{node, workload, cluster, podIncident} {
+		assert.Nil(t, indexer.Add(incident))
+	}
+	c := &correlator{
+		Correlation: Correlation{ByNode: true, ByWorkload: true, Window: 5 * time.Minute},
+		incidents:   mon_listers.NewIncidentLister(indexer),
+	}
+
+	// incidents of node are preferred to the ones of workload and time window
+	parent, err := c.findParent(incidentTarget(podIncident))
+	assert.Nil(t, err)
+	assert.Equal(t, &api.CorrelatedIncidentReference{Namespace: "demo", Name: node.Name, CorrelatedBy: api.CorrelationNode}, parent)
+
+	c.ByNode = false
+	parent, err = c.findParent(incidentTarget(podIncident))
+	assert.Nil(t, err)
+	assert.Equal(t, &api.CorrelatedIncidentReference{Namespace: "demo", Name: workload.Name, CorrelatedBy: api.CorrelationWorkload}, parent)
+
+	c.ByWorkload = false
+	parent, err = c.findParent(incidentTarget(podIncident))
+	assert.Nil(t, err)
+	assert.Equal(t, &api.CorrelatedIncidentReference{Namespace: "demo", Name: cluster.Name, CorrelatedBy: api.CorrelationTimeWindow}, parent)
+
+	// incidents of nodes and workloads are not correlated by time window
+	parent, err = c.findParent(incidentTarget(node))
+	assert.Nil(t, err)
+	assert.Nil(t, parent)
+
+	// problems starting after the window are not correlated
+	c.Window = 30 * time.Second
+	parent, err = c.findParent(incidentTarget(podIncident))
+	assert.Nil(t, err)
+	assert.Nil(t, parent)
+
+	c.ByNode = true
+	children, err := c.findChildren(node)
+	assert.Nil(t, err)
+	assert.Equal(t, []api.CorrelatedIncidentReference{{Namespace: "demo", Name: podIncident.Name, CorrelatedBy: api.CorrelationNode}}, children)
+
+	children, err = c.findChildren(workload)
+	assert.Nil(t, err)
+	assert.Empty(t, children)
+}
+
+func TestCorrelateByTimeWindow(t *testing.T) {
+	now := time.Now()
+	cr := &correlator{Correlation: Correlation{Window: 5 * time.Minute}}
+	target := incidentTarget(newCorrelationIncident("demo", "pod.payments-0.pod-exec.20180428-1110", icinga.TypePod, "payments-0", now))
+
+	cases := []struct {
+		name       string
+		parent     *api.Incident
+		correlates bool
+	}{
+		{"namespace of target", newCorrelationIncident("demo", "pod.payments-1.pod-exec.20180428-1109", icinga.TypePod, "payments-1", now.Add(-time.Minute)), true},
+		{"other namespace", newCorrelationIncident("shop", "pod.cart-0.pod-exec.20180428-1109", icinga.TypePod, "cart-0", now.Add(-time.Minute)), false},
+		{"ClusterAlert in other namespace", newCorrelationIncident("kube-system", "cluster.pod-exists.20180428-1109", icinga.TypeCluster, "", now.Add(-time.Minute)), true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			by, ok := cr.correlates(c.parent, target)
+			assert.Equal(t, c.correlates, ok)
+			if ok {
+				assert.Equal(t, api.CorrelationTimeWindow, by)
+			}
+		})
+	}
+}
+
+func TestStartsBefore(t *testing.T) {
+	now := time.Now()
+	a := correlationTarget{namespace: "demo", name: "a", start: now}
+	b := correlationTarget{namespace: "demo", name: "b", start: now}
+	assert.True(t, startsBefore(a, b))
+	assert.False(t, startsBefore(b, a))
+
+	b.start = now.Add(-time.Second)
+	assert.False(t, startsBefore(a, b))
+	assert.True(t, startsBefore(b, a))
+}
@@ -0,0 +1,94 @@
+package notifier
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"gomodules.xyz/envconfig"
+	notify "gomodules.xyz/notify"
+	"gomodules.xyz/notify/unified"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Wait before the first retry of Searchlight operator, doubled for every further retry
+	retryBackoff = 30 * time.Second
+	// Maximum wait between retries of Searchlight operator
+	maxRetryBackoff = 30 * time.Minute
+)
+
+// Deliver sends msg to contacts via notifier, using the credentials loaded by loader.
+func Deliver(notifier string, to []string, msg api.NotificationMessage, loader envconfig.LoaderFunc) error {
+	if len(to) == 0 {
+		return fmt.Errorf("no contact to send notification to")
+	}
+	notifyVia, err := unified.LoadVia(notifier, loader)
+	if err != nil {
+		return err
+	}
+
+	switch nv := notifyVia.(type) {
+	case notify.ByEmail:
+		mail := nv.To(to[0], to[1:]...).
+			WithSubject(msg.Subject).
+			WithBody(msg.Body).
+			WithNoTracking()
+		if msg.HTML {
+			return mail.SendHtml()
+		}
+		return mail.Send()
+	case notify.BySMS:
+		return nv.To(to[0], to[1:]...).
+			WithBody(msg.Body).
+			Send()
+	case notify.ByChat:
+		return nv.To(to[0], to[1:]...).
+			WithBody(msg.Body).
+			Send()
+	case notify.ByPush:
+		return nv.To(to[0:]...).
+			WithBody(msg.Body).
+			Send()
+	default:
+		return fmt.Errorf(`invalid notifier "%s"`, notifier)
+	}
+}
+
+// RetryBackoff returns how long Searchlight operator waits before it retries a delivery that failed
+// in the given number of attempts.
+func RetryBackoff(attempts int32) time.Duration {
+	backoff := retryBackoff
+	for i := int32(1); i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+// deliver makes the first attempt to send msg to receiver. Notifications are sent while the target of
+// notification is locked, so failed ones are queued in the outbox of incident right away and retried
+// by Searchlight operator in background.
+func (n *notifier) deliver(receiver routedReceiver, msg api.NotificationMessage) api.NotificationDelivery {
+	d := api.NotificationDelivery{
+		Type:           api.AlertType(n.options.notificationType),
+		Notifier:       receiver.Notifier,
+		To:             receiver.To,
+		Phase:          api.DeliveryPhaseSent,
+		Attempts:       1,
+		NotifierSecret: receiver.secret,
+	}
+	err := Deliver(receiver.Notifier, receiver.To, msg, receiver.loader)
+	d.LastAttemptTimestamp = metav1.Now()
+	if err != nil {
+		d.Phase = api.DeliveryPhasePending
+		d.LastError = err.Error()
+		d.Message = &msg
+		next := metav1.NewTime(d.LastAttemptTimestamp.Add(RetryBackoff(d.Attempts)))
+		d.NextAttemptTimestamp = &next
+	}
+	n.deliveries = append(n.deliveries, d)
+	return d
+}
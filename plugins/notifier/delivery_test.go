@@ -0,0 +1,33 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, RetryBackoff(1))
+	assert.Equal(t, time.Minute, RetryBackoff(2))
+	assert.Equal(t, 4*time.Minute, RetryBackoff(4))
+	assert.Equal(t, maxRetryBackoff, RetryBackoff(20))
+}
+
+func TestDeliverQueuesFailedNotification(t *testing.T) {
+	n := newPlugin(nil, nil, options{notificationType: "PROBLEM"})
+	receiver := routedReceiver{Receiver: api.Receiver{State: stateCritical, To: []string{"ops@example.com"}, Notifier: "Unknown"}}
+	msg := api.NotificationMessage{Body: "pod-exec is in Critical state"}
+
+	// failed notifications are left to the retries of Searchlight operator
+	d := n.deliver(receiver, msg)
+	assert.Equal(t, api.DeliveryPhasePending, d.Phase)
+	assert.Equal(t, int32(1), d.Attempts)
+	assert.NotEmpty(t, d.LastError)
+	assert.Equal(t, &msg, d.Message)
+	if assert.NotNil(t, d.NextAttemptTimestamp) {
+		assert.Equal(t, retryBackoff, d.NextAttemptTimestamp.Sub(d.LastAttemptTimestamp.Time))
+	}
+	assert.Equal(t, []api.NotificationDelivery{d}, n.deliveries)
+}
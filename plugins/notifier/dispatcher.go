@@ -0,0 +1,392 @@
+package notifier
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	cs "github.com/appscode/searchlight/client/clientset/versioned"
+	"github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/util"
+	mon_informers "github.com/appscode/searchlight/client/informers/externalversions"
+	mon_listers "github.com/appscode/searchlight/client/listers/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/pkg/registry/acknowledgement"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+)
+
+// listers read the objects of notifications from the informer caches of Searchlight operator.
+type listers struct {
+	clusterAlerts        mon_listers.ClusterAlertLister
+	nodeAlerts           mon_listers.NodeAlertLister
+	podAlerts            mon_listers.PodAlertLister
+	serviceAlerts        mon_listers.ServiceAlertLister
+	workloadAlerts       mon_listers.WorkloadAlertLister
+	alertTemplates       mon_listers.AlertTemplateLister
+	notificationPolicies mon_listers.NotificationPolicyLister
+	downtimes            mon_listers.DowntimeLister
+	silences             mon_listers.SilenceLister
+	onCallSchedules      mon_listers.OnCallScheduleLister
+	incidents            mon_listers.IncidentLister
+}
+
+// newListers registers the informers of listers in factory. They are started with the factory.
+func newListers(factory mon_informers.SharedInformerFactory) listers {
+	i := factory.Monitoring().V1alpha1()
+	return listers{
+		clusterAlerts:        i.ClusterAlerts().Lister(),
+		nodeAlerts:           i.NodeAlerts().Lister(),
+		podAlerts:            i.PodAlerts().Lister(),
+		serviceAlerts:        i.ServiceAlerts().Lister(),
+		workloadAlerts:       i.WorkloadAlerts().Lister(),
+		alertTemplates:       i.AlertTemplates().Lister(),
+		notificationPolicies: i.NotificationPolicies().Lister(),
+		downtimes:            i.Downtimes().Lister(),
+		silences:             i.Silences().Lister(),
+		onCallSchedules:      i.OnCallSchedules().Lister(),
+		incidents:            i.Incidents().Lister(),
+	}
+}
+
+// Dispatcher sends the notifications of Icinga posted by hyperalert. Notifications of a target are
+// dispatched one at a time, so that they don't race on its Incident.
+type Dispatcher struct {
+	kubeClient kubernetes.Interface
+	extClient  cs.Interface
+	listers    listers
+	// signs acknowledge links of notifications, nil if they are not sent
+	links *acknowledgement.Links
+	// finds the parent incidents of incidents
+	correlator *correlator
+
+	mu    sync.Mutex
+	locks map[string]*targetLock
+}
+
+type targetLock struct {
+	sync.Mutex
+	refs int
+}
+
+// NewDispatcher returns a Dispatcher, whose listers are registered in the informer factories. Pods and
+// ReplicaSets are only watched, if incidents are correlated by node or workload.
+func NewDispatcher(kubeClient kubernetes.Interface, extClient cs.Interface, kubeFactory informers.SharedInformerFactory, factory mon_informers.SharedInformerFactory, links *acknowledgement.Links, correlation Correlation) *Dispatcher {
+	l := newListers(factory)
+	c := &correlator{
+		Correlation: correlation,
+		incidents:   l.incidents,
+	}
+	if correlation.ByNode || correlation.ByWorkload {
+		c.pods = kubeFactory.Core().V1().Pods().Lister()
+	}
+	if correlation.ByWorkload {
+		c.replicaSets = kubeFactory.Apps().V1().ReplicaSets().Lister()
+	}
+	return &Dispatcher{
+		kubeClient: kubeClient,
+		extClient:  extClient,
+		listers:    l,
+		links:      links,
+		correlator: c,
+		locks:      map[string]*targetLock{},
+	}
+}
+
+// Dispatch sends notification to the receivers of its alert and NotificationPolicies, and records it
+// in the Incident of its target. Receivers of notification are set in its response.
+func (d *Dispatcher) Dispatch(notification *incidents.Notification) error {
+	n, unlock, err := d.newNotifier(notification)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := n.sendNotification(); err != nil {
+		return err
+	}
+
+	notification.Response = incidents.NotificationResponse{
+		Timestamp:    metav1.Now(),
+		Receivers:    n.receivers,
+		SuppressedBy: n.suppressedBy,
+	}
+	if n.digest != nil {
+		notification.Response.DigestTargets = int32(len(n.digest.Targets))
+	}
+	return nil
+}
+
+// Escalate sends the problem of incident to the receivers of an escalation step on behalf of author,
+// regardless of how long the problem stays unacknowledged, and records the escalation in incident.
+// The step is escalateAfter, if set, or the earliest step that is not reached yet.
+func (d *Dispatcher) Escalate(incident *api.Incident, author, comment string, escalateAfter *metav1.Duration) (metav1.Duration, []incidents.NotificationReceiver, error) {
+	notification, err := NotificationForIncident(incident, string(api.NotificationProblem), author, comment)
+	if err != nil {
+		return metav1.Duration{}, nil, err
+	}
+	n, unlock, err := d.newNotifier(notification)
+	if err != nil {
+		return metav1.Duration{}, nil, err
+	}
+	defer unlock()
+
+	n.escalate = true
+	n.escalateAfter = escalateAfter
+	if err := n.sendNotification(); err != nil {
+		return metav1.Duration{}, nil, err
+	}
+	return n.escalations[0], n.receivers, nil
+}
+
+// NotifyWaitingRoutes sends the problem of incident to the receivers of its waiting routes, whose groupWait
+// has expired at now, and removes the routes from the waiting routes of incident.
+func (d *Dispatcher) NotifyWaitingRoutes(incident *api.Incident, now metav1.Time) ([]incidents.NotificationReceiver, error) {
+	n, err := d.sendDelayed(incident, now, func(n *notifier) {
+		n.notifyWaiting = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n.receivers, nil
+}
+
+// FlushGroup sends the first digest of the NotificationGroup of the target of incident, once the groupWait of
+// its alert has expired at now. It returns the number of targets in the digest, 0 if it is not sent.
+func (d *Dispatcher) FlushGroup(incident *api.Incident, now metav1.Time) (int, error) {
+	n, err := d.sendDelayed(incident, now, func(n *notifier) {
+		n.flushGroup = true
+	})
+	if err != nil || n.digest == nil {
+		return 0, err
+	}
+	return len(n.digest.Targets), nil
+}
+
+// EscalateDue sends the problem of incident to the receivers of its escalation steps, that are due at now as
+// the problem stays unacknowledged, and records the escalations in incident.
+func (d *Dispatcher) EscalateDue(incident *api.Incident, now metav1.Time) ([]incidents.NotificationReceiver, error) {
+	n, err := d.sendDelayed(incident, now, func(n *notifier) {
+		n.escalateDue = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n.receivers, nil
+}
+
+// sendDelayed sends the latest problem of incident at now, with the notifier set up by fn.
+func (d *Dispatcher) sendDelayed(incident *api.Incident, now metav1.Time, fn func(n *notifier)) (*notifier, error) {
+	notification, err := NotificationForIncident(incident, string(api.NotificationProblem), "", "")
+	if err != nil {
+		return nil, err
+	}
+	notification.Request.Time = now
+	n, unlock, err := d.newNotifier(notification)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	fn(n)
+	return n, n.sendNotification()
+}
+
+// UpdateIncident applies transform to the latest version of incident and updates its labels and status.
+// Updates are serialized with the notifications of the target of incident, which update it too. A NotFound
+// error is returned, if incident is deleted.
+func (d *Dispatcher) UpdateIncident(namespace, name string, transform func(in *api.Incident) *api.Incident) (*api.Incident, error) {
+	client := d.extClient.MonitoringV1alpha1()
+	incident, err := client.Incidents(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	host, service, err := icinga.ParseIncident(incident)
+	if err != nil {
+		return nil, err
+	}
+
+	unlock := d.lock(namespace + "/" + service + "/" + host)
+	defer unlock()
+
+	if incident, err = client.Incidents(namespace).Get(name, metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	mod := transform(incident.DeepCopy())
+	if !reflect.DeepEqual(incident.Labels, mod.Labels) {
+		incident, _, err = util.PatchIncident(client, incident, func(in *api.Incident) *api.Incident {
+			in.Labels = mod.Labels
+			return in
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	out, err := util.UpdateIncidentStatus(client, incident, func(in *api.IncidentStatus) *api.IncidentStatus {
+		return &mod.Status
+	}, api.EnableStatusSubresource)
+	if err != nil {
+		// failed status updates are reported after retries, even if incident is deleted meanwhile
+		if _, e2 := client.Incidents(namespace).Get(name, metav1.GetOptions{}); kerr.IsNotFound(e2) {
+			return nil, e2
+		}
+	}
+	return out, err
+}
+
+// Correlate links incident with the incidents it is correlated with. An open incident without parent is
+// linked under its parent, if it has any, or else the open incidents of pods on its node or of its
+// workload are linked under it. Incidents linked under a parent are added to the children of parent.
+func (d *Dispatcher) Correlate(incident *api.Incident) error {
+	c := d.correlator
+	if !c.Enabled() || incident.DeletionTimestamp != nil {
+		return nil
+	}
+	if ref := incident.Status.ParentRef; ref != nil {
+		parent, err := c.incidents.Incidents(ref.Namespace).Get(ref.Name)
+		if kerr.IsNotFound(err) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if parent.Status.HasChild(incident.Namespace, incident.Name) {
+			return nil
+		}
+		child := api.CorrelatedIncidentReference{Namespace: incident.Namespace, Name: incident.Name, CorrelatedBy: ref.CorrelatedBy}
+		_, err = d.UpdateIncident(ref.Namespace, ref.Name, func(in *api.Incident) *api.Incident {
+			if !in.Status.HasChild(child.Namespace, child.Name) {
+				in.Status.Children = append(in.Status.Children, child)
+			}
+			return in
+		})
+		if kerr.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !isOpen(incident) {
+		return nil
+	}
+
+	if len(incident.Status.Children) == 0 {
+		parent, err := c.findParent(incidentTarget(incident))
+		if err != nil {
+			return err
+		}
+		if parent != nil {
+			// parent records the child, once the incident is updated with its parentRef
+			return d.setParent(incident.Namespace, incident.Name, *parent)
+		}
+	}
+	children, err := c.findChildren(incident)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, child := range children {
+		parent := api.CorrelatedIncidentReference{Namespace: incident.Namespace, Name: incident.Name, CorrelatedBy: child.CorrelatedBy}
+		if err := d.setParent(child.Namespace, child.Name, parent); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// setParent links the incident in namespace with name under parent, unless it is linked with other
+// incidents meanwhile.
+func (d *Dispatcher) setParent(namespace, name string, parent api.CorrelatedIncidentReference) error {
+	_, err := d.UpdateIncident(namespace, name, func(in *api.Incident) *api.Incident {
+		if in.Status.ParentRef == nil && len(in.Status.Children) == 0 && isOpen(in) {
+			in.Status.ParentRef = &parent
+		}
+		return in
+	})
+	if kerr.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// NotificationForIncident returns a notification of the given type for the target of incident, with the
+// state and check output of its latest problem, to be dispatched on behalf of author.
+func NotificationForIncident(incident *api.Incident, notificationType, author, comment string) (*incidents.Notification, error) {
+	host, service, err := icinga.ParseIncident(incident)
+	if err != nil {
+		return nil, err
+	}
+	state, output := lastProblem(incident)
+	return &incidents.Notification{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      service,
+			Namespace: incident.Namespace,
+		},
+		Request: incidents.NotificationRequest{
+			Alert:   service,
+			Host:    host,
+			Type:    notificationType,
+			State:   state,
+			Output:  output,
+			Time:    metav1.Now(),
+			Author:  author,
+			Comment: comment,
+		},
+	}, nil
+}
+
+// newNotifier returns the notifier of notification, with the target of notification locked until
+// the returned func is called.
+func (d *Dispatcher) newNotifier(notification *incidents.Notification) (*notifier, func(), error) {
+	req := notification.Request
+	host, err := icinga.ParseHost(req.Host)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid icinga host %s", req.Host)
+	}
+	if host.AlertNamespace != notification.Namespace {
+		return nil, nil, fmt.Errorf("icinga host %s is not in namespace %s", req.Host, notification.Namespace)
+	}
+	opts := options{
+		alertName:        req.Alert,
+		notificationType: req.Type,
+		serviceState:     sanitizeState(req.State),
+		serviceOutput:    req.Output,
+		time:             req.Time.Time,
+		author:           req.Author,
+		comment:          req.Comment,
+		hostname:         req.Host,
+		host:             host,
+		dryRun:           req.DryRun,
+	}
+
+	n := newPlugin(d.kubeClient.CoreV1().Secrets(host.AlertNamespace), d.extClient.MonitoringV1alpha1(), opts)
+	n.kubeClient = d.kubeClient
+	n.listers = d.listers
+	n.links = d.links
+	n.correlator = d.correlator
+	return n, d.lock(notification.Namespace + "/" + req.Alert + "/" + req.Host), nil
+}
+
+// lock locks the target of key, until the returned func is called.
+func (d *Dispatcher) lock(key string) func() {
+	d.mu.Lock()
+	l, ok := d.locks[key]
+	if !ok {
+		l = &targetLock{}
+		d.locks[key] = l
+	}
+	l.refs++
+	d.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		d.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(d.locks, key)
+		}
+		d.mu.Unlock()
+	}
+}
@@ -0,0 +1,71 @@
+package notifier
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	"github.com/appscode/searchlight/client/clientset/versioned/fake"
+	mon_informers "github.com/appscode/searchlight/client/informers/externalversions"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestListers returns listers of objects, with synced caches. Informers run until stopCh is closed.
+func newTestListers(t *testing.T, stopCh <-chan struct{}, objects ...runtime.Object) listers {
+	factory := mon_informers.NewSharedInformerFactory(fake.NewSimpleClientset(objects...), 0)
+	l := newListers(factory)
+	factory.Start(stopCh)
+	for typ, synced := range factory.WaitForCacheSync(stopCh) {
+		assert.True(t, synced, "cache of %v is not synced", typ)
+	}
+	return l
+}
+
+func TestDispatchInvalidHost(t *testing.T) {
+	d := NewDispatcher(kfake.NewSimpleClientset(), fake.NewSimpleClientset(), informers.NewSharedInformerFactory(kfake.NewSimpleClientset(), 0), mon_informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0), nil, Correlation{})
+	notification := &incidents.Notification{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+		Request: incidents.NotificationRequest{
+			Alert: "pod-exec",
+			Host:  "prod@pod@payments-0",
+			Type:  "PROBLEM",
+			State: "CRITICAL",
+			Time:  metav1.Now(),
+		},
+	}
+	assert.EqualError(t, d.Dispatch(notification), "icinga host prod@pod@payments-0 is not in namespace demo")
+}
+
+func TestDispatcherLock(t *testing.T) {
+	d := NewDispatcher(kfake.NewSimpleClientset(), fake.NewSimpleClientset(), informers.NewSharedInformerFactory(kfake.NewSimpleClientset(), 0), mon_informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0), nil, Correlation{})
+
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := d.lock("demo/pod-exec/demo@pod@payments-0")
+			defer unlock()
+
+			mu.Lock()
+			if running++; running > maxRunning {
+				maxRunning = running
+			}
+			mu.Unlock()
+			time.Sleep(10 * time.Millisecond)
+			mu.Lock()
+			running--
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, 1, maxRunning)
+	assert.Empty(t, d.locks)
+}
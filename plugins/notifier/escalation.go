@@ -0,0 +1,185 @@
+package notifier
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var (
+	// ErrEscalated is returned for escalations of incidents that reached all escalation steps of their receivers
+	ErrEscalated = errors.New("incident is escalated to all receivers")
+	// ErrNoEscalationStep is returned for escalations to a step that no receiver of incident has
+	ErrNoEscalationStep = errors.New("incident has no receivers with the requested escalateAfter")
+)
+
+// isEscalated returns true, if notification should be sent to receiver. Receivers with escalateAfter
+// are notified once the problem of incident stays unacknowledged for that long. Afterwards, they get
+// every notification of the incident, like the receivers without escalateAfter.
+// Escalation steps reached by this notification are recorded in n.escalations, and the earliest one
+// that is not reached yet in n.nextEscalation.
+func (n *notifier) isEscalated(receiver api.Receiver, incident *api.Incident) bool {
+	if receiver.EscalateAfter == nil || receiver.EscalateAfter.Duration <= 0 {
+		return true
+	}
+	if incident == nil {
+		// problem starts with this notification
+		n.waitEscalation(n.options.time, receiver.EscalateAfter.Duration)
+		return false
+	}
+
+	acknowledged := false
+	for _, item := range incident.Status.Notifications {
+		switch item.Type {
+		case api.NotificationEscalation:
+			if item.EscalateAfter != nil && item.EscalateAfter.Duration == receiver.EscalateAfter.Duration {
+				return true
+			}
+		case api.NotificationAcknowledgement:
+			acknowledged = true
+		}
+	}
+
+	if acknowledged || api.AlertType(n.options.notificationType) != api.NotificationProblem {
+		return false
+	}
+	if start := problemStart(incident).Time; n.options.time.Sub(start) < receiver.EscalateAfter.Duration {
+		n.waitEscalation(start, receiver.EscalateAfter.Duration)
+		return false
+	}
+
+	for _, d := range n.escalations {
+		if d.Duration == receiver.EscalateAfter.Duration {
+			return true
+		}
+	}
+	n.escalations = append(n.escalations, *receiver.EscalateAfter)
+	return true
+}
+
+// waitEscalation records the time at which receivers of the escalation step after are notified of the problem
+// of this notification that started at start, if it is the earliest step not reached yet. Searchlight operator
+// escalates incident then, unless the problem is acknowledged or recovers before.
+func (n *notifier) waitEscalation(start time.Time, after time.Duration) {
+	if api.AlertType(n.options.notificationType) != api.NotificationProblem {
+		return
+	}
+	at := metav1.NewTime(start.Add(after))
+	if n.nextEscalation == nil || at.Before(n.nextEscalation) {
+		n.nextEscalation = &at
+	}
+}
+
+// newEscalation returns true, if receiver has an escalation step that incident has not reached yet.
+func newEscalation(receiver api.Receiver, incident *api.Incident) bool {
+	if receiver.EscalateAfter == nil || receiver.EscalateAfter.Duration <= 0 {
+		return false
+	}
+	for _, item := range incident.Status.Notifications {
+		if item.Type == api.NotificationEscalation && item.EscalateAfter != nil && item.EscalateAfter.Duration == receiver.EscalateAfter.Duration {
+			return false
+		}
+	}
+	return true
+}
+
+// updateNextEscalation records the time at which receivers of the next escalation step are notified of the
+// problem of incident. Escalations requested by user leave it to the next notification of the problem.
+func (n *notifier) updateNextEscalation(status *api.IncidentStatus) {
+	switch {
+	case n.escalate:
+	case n.notifyWaiting, n.flushGroup:
+		// receivers notified after their groupWait may have earlier escalation steps
+		if next := n.nextEscalation; next != nil && (status.NextEscalationTimestamp == nil || next.Before(status.NextEscalationTimestamp)) {
+			status.NextEscalationTimestamp = next
+		}
+	case api.AlertType(n.options.notificationType) == api.NotificationProblem:
+		status.NextEscalationTimestamp = n.nextEscalation
+	case api.AlertType(n.options.notificationType) == api.NotificationAcknowledgement,
+		api.AlertType(n.options.notificationType) == api.NotificationRecovery:
+		// acknowledged or recovered problems are not escalated
+		status.NextEscalationTimestamp = nil
+	}
+}
+
+// escalationReceivers returns the receivers of the escalation step requested by user, which is n.escalateAfter
+// or the earliest step of receivers of state that incident has not reached yet. The step is recorded in n.escalations.
+func (n *notifier) escalationReceivers(receivers []routedReceiver, state string, incident *api.Incident) ([]routedReceiver, error) {
+	if incident == nil {
+		return nil, errors.New("incident of notification is not found")
+	}
+	reached := map[time.Duration]bool{}
+	for _, item := range incident.Status.Notifications {
+		if item.Type == api.NotificationEscalation && item.EscalateAfter != nil {
+			reached[item.EscalateAfter.Duration] = true
+		}
+	}
+
+	var step *metav1.Duration
+	for _, receiver := range receivers {
+		after := receiver.EscalateAfter
+		if after == nil || after.Duration <= 0 || !strings.EqualFold(receiver.State, state) {
+			continue
+		}
+		if n.escalateAfter != nil {
+			if after.Duration == n.escalateAfter.Duration {
+				step = after
+				break
+			}
+		} else if !reached[after.Duration] && (step == nil || after.Duration < step.Duration) {
+			step = after
+		}
+	}
+	if step == nil && n.escalateAfter != nil {
+		return nil, ErrNoEscalationStep
+	} else if step == nil {
+		return nil, ErrEscalated
+	}
+
+	var out []routedReceiver
+	for _, receiver := range receivers {
+		if receiver.EscalateAfter != nil && receiver.EscalateAfter.Duration == step.Duration {
+			out = append(out, receiver)
+		}
+	}
+	n.escalations = []metav1.Duration{*step}
+	return out, nil
+}
+
+// appendEscalations records the escalation steps reached by this notification in the notifications of incident.
+// Escalations requested by user are recorded with their author and comment.
+func (n *notifier) appendEscalations(notifications []api.IncidentNotification) []api.IncidentNotification {
+	opts := n.options
+	for i := range n.escalations {
+		notification := api.IncidentNotification{
+			Type:           api.NotificationEscalation,
+			CheckOutput:    opts.serviceOutput,
+			FirstTimestamp: metav1.NewTime(opts.time),
+			LastTimestamp:  metav1.NewTime(opts.time),
+			LastState:      opts.serviceState,
+			EscalateAfter:  &n.escalations[i],
+		}
+		if n.escalate {
+			notification.Author = &opts.author
+			notification.Comment = &opts.comment
+		}
+		notifications = append(notifications, notification)
+	}
+	return notifications
+}
+
+// problemStart returns the time at which the first problem of incident was notified.
+func problemStart(incident *api.Incident) metav1.Time {
+	start := incident.CreationTimestamp
+	found := false
+	for _, item := range incident.Status.Notifications {
+		if item.Type == api.NotificationProblem && (!found || item.FirstTimestamp.Before(&start)) {
+			start = item.FirstTimestamp
+			found = true
+		}
+	}
+	return start
+}
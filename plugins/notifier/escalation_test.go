@@ -0,0 +1,244 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/fake"
+	mon_informers "github.com/appscode/searchlight/client/informers/externalversions"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestIsEscalated(t *testing.T) {
+	start := time.Now()
+	incident := &api.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "pod.payments-0.pod-exec.20190107-0200",
+			Namespace:         "demo",
+			CreationTimestamp: metav1.NewTime(start),
+		},
+		Status: api.IncidentStatus{
+			LastNotificationType: api.NotificationProblem,
+			Notifications: []api.IncidentNotification{
+				{
+					Type:           api.NotificationProblem,
+					FirstTimestamp: metav1.NewTime(start),
+					LastTimestamp:  metav1.NewTime(start.Add(10 * time.Minute)),
+					LastState:      stateCritical,
+				},
+			},
+		},
+	}
+	level1 := api.Receiver{State: stateCritical, To: []string{"oncall@example.com"}, Notifier: "Mailgun"}
+	level2 := api.Receiver{State: stateCritical, To: []string{"lead@example.com"}, Notifier: "Mailgun", EscalateAfter: &metav1.Duration{Duration: 15 * time.Minute}}
+	level3 := api.Receiver{State: stateCritical, To: []string{"cto@example.com"}, Notifier: "Mailgun", EscalateAfter: &metav1.Duration{Duration: time.Hour}}
+
+	n := newPlugin(nil, nil, options{notificationType: "PROBLEM", serviceState: stateCritical, time: start})
+	assert.True(t, n.isEscalated(level1, nil))
+	assert.False(t, n.isEscalated(level2, nil))
+	assert.False(t, n.isEscalated(level3, nil))
+	// the earliest step that is not reached yet is escalated by Searchlight operator
+	if assert.NotNil(t, n.nextEscalation) {
+		assert.Equal(t, start.Add(15*time.Minute), n.nextEscalation.Time)
+	}
+
+	n.options.time = start.Add(10 * time.Minute)
+	assert.True(t, n.isEscalated(level1, incident))
+	assert.False(t, n.isEscalated(level2, incident))
+	assert.Empty(t, n.escalations)
+
+	n = newPlugin(nil, nil, options{notificationType: "PROBLEM", serviceState: stateCritical, time: start.Add(20 * time.Minute)})
+	assert.True(t, n.isEscalated(level2, incident))
+	assert.False(t, n.isEscalated(level3, incident))
+	assert.Equal(t, []metav1.Duration{{Duration: 15 * time.Minute}}, n.escalations)
+	if assert.NotNil(t, n.nextEscalation) {
+		assert.Equal(t, start.Add(time.Hour), n.nextEscalation.Time)
+	}
+
+	incident.Status.Notifications = n.appendEscalations(incident.Status.Notifications)
+	assert.Len(t, incident.Status.Notifications, 2)
+	assert.Equal(t, api.NotificationEscalation, incident.Status.Notifications[1].Type)
+
+	// escalated receivers get the recovery of incident
+	n = newPlugin(nil, nil, options{notificationType: "RECOVERY", serviceState: stateOK, time: start.Add(2 * time.Hour)})
+	assert.True(t, n.isEscalated(level2, incident))
+	assert.False(t, n.isEscalated(level3, incident))
+	assert.Empty(t, n.escalations)
+	assert.Nil(t, n.nextEscalation)
+
+	// acknowledged problems are not escalated any further
+	incident.Status.Notifications = append(incident.Status.Notifications, api.IncidentNotification{
+		Type:           api.NotificationAcknowledgement,
+		FirstTimestamp: metav1.NewTime(start.Add(30 * time.Minute)),
+		LastTimestamp:  metav1.NewTime(start.Add(30 * time.Minute)),
+		LastState:      stateCritical,
+	})
+	n = newPlugin(nil, nil, options{notificationType: "PROBLEM", serviceState: stateCritical, time: start.Add(2 * time.Hour)})
+	assert.True(t, n.isEscalated(level2, incident))
+	assert.False(t, n.isEscalated(level3, incident))
+}
+
+func TestEscalationReceivers(t *testing.T) {
+	start := time.Now()
+	incident := &api.Incident{
+		Status: api.IncidentStatus{
+			LastNotificationType: api.NotificationAcknowledgement,
+			Notifications: []api.IncidentNotification{
+				{Type: api.NotificationProblem, LastState: stateCritical, CheckOutput: "Found 2 pod(s) instead of 3", LastTimestamp: metav1.NewTime(start)},
+				{Type: api.NotificationAcknowledgement, LastState: stateCritical, LastTimestamp: metav1.NewTime(start)},
+			},
+		},
+	}
+	receivers := []routedReceiver{
+		{Receiver: api.Receiver{State: stateCritical, To: []string{"oncall@example.com"}, Notifier: "Mailgun"}},
+		{Receiver: api.Receiver{State: stateCritical, To: []string{"cto@example.com"}, Notifier: "Mailgun", EscalateAfter: &metav1.Duration{Duration: time.Hour}}},
+		{Receiver: api.Receiver{State: stateCritical, To: []string{"lead@example.com"}, Notifier: "Mailgun", EscalateAfter: &metav1.Duration{Duration: 15 * time.Minute}}},
+		{Receiver: api.Receiver{State: stateWarning, To: []string{"dev@example.com"}, Notifier: "Mailgun", EscalateAfter: &metav1.Duration{Duration: 5 * time.Minute}}},
+	}
+	state, output := lastProblem(incident)
+	assert.Equal(t, stateCritical, state)
+	assert.Equal(t, "Found 2 pod(s) instead of 3", output)
+
+	// acknowledged incidents are escalated to the earliest step of their state
+	n := newPlugin(nil, nil, options{notificationType: "PROBLEM", serviceState: stateCritical, author: "admin", comment: "needs a lead", time: start})
+	n.escalate = true
+	_, err := n.escalationReceivers(receivers, stateCritical, nil)
+	assert.NotNil(t, err)
+	out, err := n.escalationReceivers(receivers, stateCritical, incident)
+	assert.Nil(t, err)
+	assert.Equal(t, []routedReceiver{receivers[2]}, out)
+	assert.Equal(t, []metav1.Duration{{Duration: 15 * time.Minute}}, n.escalations)
+
+	incident.Status.Notifications = n.appendEscalations(incident.Status.Notifications)
+	assert.Equal(t, "admin", *incident.Status.Notifications[2].Author)
+	assert.Equal(t, "needs a lead", *incident.Status.Notifications[2].Comment)
+
+	out, err = n.escalationReceivers(receivers, stateCritical, incident)
+	assert.Nil(t, err)
+	assert.Equal(t, []routedReceiver{receivers[1]}, out)
+	incident.Status.Notifications = n.appendEscalations(incident.Status.Notifications)
+
+	_, err = n.escalationReceivers(receivers, stateCritical, incident)
+	assert.Equal(t, ErrEscalated, err)
+
+	// requested steps are escalated to again
+	n.escalateAfter = &metav1.Duration{Duration: 15 * time.Minute}
+	out, err = n.escalationReceivers(receivers, stateCritical, incident)
+	assert.Nil(t, err)
+	assert.Equal(t, []routedReceiver{receivers[2]}, out)
+
+	n.escalateAfter = &metav1.Duration{Duration: 5 * time.Minute}
+	_, err = n.escalationReceivers(receivers, stateCritical, incident)
+	assert.Equal(t, ErrNoEscalationStep, err)
+}
+
+func TestEscalateDue(t *testing.T) {
+	start := time.Now().Add(-2 * time.Hour)
+	next := metav1.NewTime(start.Add(15 * time.Minute))
+	// Icinga notifies problems of alerts without alertInterval only once
+	alert := &api.PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+		Spec: api.PodAlertSpec{
+			Receivers: []api.Receiver{
+				{State: stateCritical, To: []string{"oncall@example.com"}, Notifier: "Mailgun"},
+				{State: stateCritical, To: []string{"lead@example.com"}, Notifier: "Mailgun", EscalateAfter: &metav1.Duration{Duration: 15 * time.Minute}},
+				{State: stateCritical, To: []string{"cto@example.com"}, Notifier: "Mailgun", EscalateAfter: &metav1.Duration{Duration: time.Hour}},
+			},
+		},
+	}
+	incident := &api.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod.payments-0.pod-exec.20190107-0900",
+			Namespace: "demo",
+			Labels: map[string]string{
+				api.LabelKeyAlertType:        icinga.TypePod,
+				api.LabelKeyAlert:            alert.Name,
+				api.LabelKeyObjectName:       "payments-0",
+				api.LabelKeyProblemRecovered: "false",
+			},
+			CreationTimestamp: metav1.NewTime(start),
+		},
+		Status: api.IncidentStatus{
+			LastNotificationType: api.NotificationProblem,
+			Notifications: []api.IncidentNotification{
+				{Type: api.NotificationProblem, LastState: stateCritical, CheckOutput: "exit status 1", FirstTimestamp: metav1.NewTime(start), LastTimestamp: metav1.NewTime(start)},
+			},
+			NextEscalationTimestamp: &next,
+		},
+	}
+	pod := &core.Pod{ObjectMeta: metav1.ObjectMeta{Name: "payments-0", Namespace: "demo"}}
+
+	extClient := fake.NewSimpleClientset(alert, incident)
+	factory := mon_informers.NewSharedInformerFactory(extClient, 0)
+	kubeClient := kfake.NewSimpleClientset(pod)
+	d := NewDispatcher(kubeClient, extClient, informers.NewSharedInformerFactory(kubeClient, 0), factory, nil, Correlation{})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	get := func() *api.Incident {
+		cur, err := extClient.MonitoringV1alpha1().Incidents("demo").Get(incident.Name, metav1.GetOptions{})
+		assert.NoError(t, err)
+		return cur
+	}
+
+	// first step is escalated at its escalateAfter, without another notification of Icinga
+	_, err := d.EscalateDue(incident, metav1.NewTime(start.Add(15*time.Minute)))
+	assert.NoError(t, err)
+	cur := get()
+	if assert.Len(t, cur.Status.Notifications, 2) {
+		assert.Equal(t, api.NotificationEscalation, cur.Status.Notifications[1].Type)
+		assert.Equal(t, 15*time.Minute, cur.Status.Notifications[1].EscalateAfter.Duration)
+	}
+	assert.Equal(t, api.NotificationProblem, cur.Status.LastNotificationType)
+	if assert.NotNil(t, cur.Status.NextEscalationTimestamp) {
+		assert.Equal(t, start.Add(time.Hour).Unix(), cur.Status.NextEscalationTimestamp.Unix())
+	}
+
+	// acknowledged problems are not escalated any further
+	cur.Status.Notifications = append(cur.Status.Notifications, api.IncidentNotification{
+		Type:           api.NotificationAcknowledgement,
+		FirstTimestamp: metav1.NewTime(start.Add(30 * time.Minute)),
+		LastTimestamp:  metav1.NewTime(start.Add(30 * time.Minute)),
+	})
+	cur, err = extClient.MonitoringV1alpha1().Incidents("demo").Update(cur)
+	assert.NoError(t, err)
+	_, err = d.EscalateDue(cur, metav1.NewTime(start.Add(time.Hour)))
+	assert.NoError(t, err)
+	assert.Len(t, get().Status.Notifications, 3)
+}
+
+func TestUpdateNextEscalation(t *testing.T) {
+	now := metav1.Now()
+	later := metav1.NewTime(now.Add(time.Hour))
+
+	cases := []struct {
+		name     string
+		n        *notifier
+		current  *metav1.Time
+		expected *metav1.Time
+	}{
+		{"problem", &notifier{options: options{notificationType: "PROBLEM"}, nextEscalation: &now}, &later, &now},
+		{"problem without further steps", &notifier{options: options{notificationType: "PROBLEM"}}, &later, nil},
+		{"due escalation", &notifier{options: options{notificationType: "PROBLEM"}, escalateDue: true, nextEscalation: &later}, &now, &later},
+		{"escalation requested by user", &notifier{options: options{notificationType: "PROBLEM"}, escalate: true}, &now, &now},
+		{"waiting routes with earlier step", &notifier{options: options{notificationType: "PROBLEM"}, notifyWaiting: true, nextEscalation: &now}, &later, &now},
+		{"waiting routes with later step", &notifier{options: options{notificationType: "PROBLEM"}, notifyWaiting: true, nextEscalation: &later}, &now, &now},
+		{"acknowledgement", &notifier{options: options{notificationType: "ACKNOWLEDGEMENT"}}, &now, nil},
+		{"recovery", &notifier{options: options{notificationType: "RECOVERY"}}, &now, nil},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			status := api.IncidentStatus{NextEscalationTimestamp: c.current}
+			c.n.updateNextEscalation(&status)
+			assert.Equal(t, c.expected, status.NextEscalationTimestamp)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package notifier
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Digest is the combined notification of a group of targets.
+type Digest struct {
+	// Values of grouping labels of targets
+	Labels map[string]string
+	// Targets of the group with their states and check outputs
+	Targets []api.GroupedTarget
+	// States of receivers that get this digest
+	States []string
+}
+
+func (d *Digest) hasState(state string) bool {
+	for _, s := range d.States {
+		if strings.EqualFold(s, state) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupNotification records this notification in the NotificationGroup of its target, persisted in the
+// status of alert. grouped is false, if the notification is not grouped and should be sent for the
+// target alone. Otherwise, digest is the notification to send for the group, if any.
+func (n *notifier) groupNotification(alert api.Alert) (digest *Digest, grouped bool, err error) {
+	a, ok := alert.(api.GroupedAlert)
+	if !ok || a.GetGrouping() == nil {
+		return nil, false, nil
+	}
+	switch api.AlertType(n.options.notificationType) {
+	case api.NotificationProblem, api.NotificationRecovery:
+	default:
+		return nil, false, nil
+	}
+	targetLabels, err := n.getTargetLabels()
+	if err != nil {
+		return nil, false, err
+	}
+	labels := groupLabels(a.GetGrouping(), targetLabels)
+
+	// decision is made on the latest status, as other targets are notified concurrently
+	err = n.updateAlertStatus(alert, func(in *api.AlertStatus) {
+		digest, grouped = n.groupTarget(in, a.GetGrouping(), alert, labels)
+	})
+	return digest, grouped, err
+}
+
+// groupTarget records the state of target of this notification in its group in status.
+func (n *notifier) groupTarget(status *api.AlertStatus, grouping *api.NotificationGrouping, alert api.Alert, labels map[string]string) (*Digest, bool) {
+	opts := n.options
+	now := metav1.NewTime(opts.time)
+	target := api.GroupedTarget{
+		Name:          opts.host.ObjectName,
+		State:         opts.serviceState,
+		CheckOutput:   opts.serviceOutput,
+		LastTimestamp: now,
+	}
+
+	i := findGroup(status.NotificationGroups, labels)
+	if api.AlertType(opts.notificationType) == api.NotificationRecovery {
+		if i < 0 {
+			// target had no problem since grouping was enabled
+			return nil, false
+		}
+		setGroupedTarget(&status.NotificationGroups[i], target)
+		g := status.NotificationGroups[i]
+		for _, t := range g.Targets {
+			if t.State != stateOK {
+				return nil, true
+			}
+		}
+		// consolidated recovery is sent, once all targets of group recover
+		status.NotificationGroups = append(status.NotificationGroups[:i], status.NotificationGroups[i+1:]...)
+		if g.NotifiedTimestamp == nil {
+			return nil, true
+		}
+		return &Digest{Labels: g.Labels, Targets: g.Targets, States: g.NotifiedStates}, true
+	}
+
+	if i < 0 {
+		status.NotificationGroups = append(status.NotificationGroups, api.NotificationGroup{
+			Labels:         labels,
+			FirstTimestamp: now,
+		})
+		i = len(status.NotificationGroups) - 1
+	}
+	g := &status.NotificationGroups[i]
+	setGroupedTarget(g, target)
+	if g.NotifiedTimestamp == nil {
+		if opts.time.Sub(g.FirstTimestamp.Time) < grouping.GroupWait.Duration {
+			return nil, true
+		}
+	} else {
+		pending := false
+		for _, t := range g.Targets {
+			if !t.Notified && t.State != stateOK {
+				pending = true
+				break
+			}
+		}
+		// digest is repeated every alertInterval, like the notifications of a single target
+		if !pending && opts.time.Sub(g.NotifiedTimestamp.Time) < alert.GetAlertInterval() {
+			return nil, true
+		}
+	}
+
+	d := &Digest{Labels: g.Labels}
+	states := sets.NewString(g.NotifiedStates...)
+	for j := range g.Targets {
+		if g.Targets[j].State == stateOK {
+			continue
+		}
+		g.Targets[j].Notified = true
+		d.Targets = append(d.Targets, g.Targets[j])
+		states.Insert(g.Targets[j].State)
+	}
+	g.NotifiedTimestamp = &now
+	g.NotifiedStates = states.List()
+	d.States = g.NotifiedStates
+	return d, true
+}
+
+// setGroupedTarget adds or updates a target of group. Targets whose state changes are included in the next digest.
+func setGroupedTarget(g *api.NotificationGroup, target api.GroupedTarget) {
+	for i := range g.Targets {
+		if g.Targets[i].Name == target.Name {
+			target.Notified = g.Targets[i].Notified && g.Targets[i].State == target.State
+			g.Targets[i] = target
+			return
+		}
+	}
+	g.Targets = append(g.Targets, target)
+}
+
+// groupLabels returns the values of grouping labels of target.
+func groupLabels(grouping *api.NotificationGrouping, targetLabels map[string]string) map[string]string {
+	if len(grouping.By) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(grouping.By))
+	for _, key := range grouping.By {
+		out[key] = targetLabels[key]
+	}
+	return out
+}
+
+func findGroup(groups []api.NotificationGroup, labels map[string]string) int {
+	for i, g := range groups {
+		if len(g.Labels) != len(labels) {
+			continue
+		}
+		found := true
+		for k, v := range labels {
+			if cur, ok := g.Labels[k]; !ok || cur != v {
+				found = false
+				break
+			}
+		}
+		if found {
+			return i
+		}
+	}
+	return -1
+}
+
+// updateAlertStatus applies transform to the latest status of alert. Status is not updated in dry-run mode.
+func (n *notifier) updateAlertStatus(alert api.Alert, transform func(*api.AlertStatus)) error {
+	apply := func(in *api.AlertStatus) *api.AlertStatus {
+		transform(in)
+		return in
+	}
+
+	var err error
+	switch a := alert.(type) {
+	case *api.PodAlert:
+		if n.options.dryRun {
+			apply(a.Status.DeepCopy())
+		} else if api.EnableStatusSubresource {
+			_, err = util.UpdatePodAlertStatus(n.extClient, a, apply, true)
+		} else {
+			_, err = util.TryUpdatePodAlert(n.extClient, a.ObjectMeta, func(in *api.PodAlert) *api.PodAlert {
+				apply(&in.Status)
+				return in
+			})
+		}
+	case *api.NodeAlert:
+		if n.options.dryRun {
+			apply(a.Status.DeepCopy())
+		} else if api.EnableStatusSubresource {
+			_, err = util.UpdateNodeAlertStatus(n.extClient, a, apply, true)
+		} else {
+			_, err = util.TryUpdateNodeAlert(n.extClient, a.ObjectMeta, func(in *api.NodeAlert) *api.NodeAlert {
+				apply(&in.Status)
+				return in
+			})
+		}
+	case *api.ServiceAlert:
+		if n.options.dryRun {
+			apply(a.Status.DeepCopy())
+		} else if api.EnableStatusSubresource {
+			_, err = util.UpdateServiceAlertStatus(n.extClient, a, apply, true)
+		} else {
+			_, err = util.TryUpdateServiceAlert(n.extClient, a.ObjectMeta, func(in *api.ServiceAlert) *api.ServiceAlert {
+				apply(&in.Status)
+				return in
+			})
+		}
+	case *api.WorkloadAlert:
+		if n.options.dryRun {
+			apply(a.Status.DeepCopy())
+		} else if api.EnableStatusSubresource {
+			_, err = util.UpdateWorkloadAlertStatus(n.extClient, a, apply, true)
+		} else {
+			_, err = util.TryUpdateWorkloadAlert(n.extClient, a.ObjectMeta, func(in *api.WorkloadAlert) *api.WorkloadAlert {
+				apply(&in.Status)
+				return in
+			})
+		}
+	default:
+		return fmt.Errorf("status of %s can't be updated", alert.ObjectReference().Kind)
+	}
+	return err
+}
+
+// RenderDigestSubject renders the subject of mails for digest.
+func (n *notifier) RenderDigestSubject(d *Digest) string {
+	if api.AlertType(n.options.notificationType) == api.NotificationRecovery {
+		return fmt.Sprintf("Problem Recovered: %d targets of alert [%s] have recovered.", len(d.Targets), n.options.alertName)
+	}
+	return fmt.Sprintf("Problem Detected: %d targets of alert [%s] are in problem state.", len(d.Targets), n.options.alertName)
+}
+
+// RenderDigest renders the message of SMS, chat and push notifications for digest.
+func (n *notifier) RenderDigest(d *Digest) string {
+	var buf strings.Builder
+	buf.WriteString(n.RenderDigestSubject(d))
+	if len(d.Labels) > 0 {
+		keys := make([]string, 0, len(d.Labels))
+		for k := range d.Labels {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		pairs := make([]string, 0, len(keys))
+		for _, k := range keys {
+			pairs = append(pairs, k+"="+d.Labels[k])
+		}
+		buf.WriteString(" Group: " + strings.Join(pairs, ", ") + ".")
+	}
+	for _, t := range d.Targets {
+		fmt.Fprintf(&buf, "\n- %s is in \"%s\" state", t.Name, t.State)
+		if t.CheckOutput != "" {
+			buf.WriteString(": " + t.CheckOutput)
+		}
+	}
+	return buf.String()
+}
+
+// RenderDigestMail renders the body of mails for digest.
+func (n *notifier) RenderDigestMail(d *Digest) string {
+	return "<html><body><pre>" + html.EscapeString(n.RenderDigest(d)) + "</pre></body></html>"
+}
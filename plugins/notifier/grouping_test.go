@@ -0,0 +1,170 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/fake"
+	mon_informers "github.com/appscode/searchlight/client/informers/externalversions"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGroupTarget(t *testing.T) {
+	alert := &api.PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+		Spec: api.PodAlertSpec{
+			AlertInterval: metav1.Duration{Duration: 5 * time.Minute},
+			Grouping:      &api.NotificationGrouping{By: []string{"app"}, GroupWait: metav1.Duration{Duration: 2 * time.Minute}},
+		},
+	}
+	start := time.Now()
+	status := &api.AlertStatus{}
+	labels := groupLabels(alert.Spec.Grouping, map[string]string{"app": "nginx", "pod-template-hash": "1234"})
+	assert.Equal(t, map[string]string{"app": "nginx"}, labels)
+
+	notify := func(pod, notificationType, state string, after time.Duration) (*Digest, bool) {
+		host, err := icinga.ParseHost("demo@pod@" + pod)
+		assert.NoError(t, err)
+		n := newPlugin(nil, nil, options{
+			alertName:        alert.Name,
+			notificationType: notificationType,
+			serviceState:     state,
+			serviceOutput:    pod + " is " + state,
+			time:             start.Add(after),
+			host:             host,
+		})
+		return n.groupTarget(status, alert.Spec.Grouping, alert, labels)
+	}
+
+	// problems are held until groupWait
+	d, grouped := notify("nginx-0", "PROBLEM", stateCritical, 0)
+	assert.True(t, grouped)
+	assert.Nil(t, d)
+	d, grouped = notify("nginx-1", "PROBLEM", stateWarning, time.Minute)
+	assert.True(t, grouped)
+	assert.Nil(t, d)
+	assert.Len(t, status.NotificationGroups, 1)
+
+	d, grouped = notify("nginx-0", "PROBLEM", stateCritical, 3*time.Minute)
+	assert.True(t, grouped)
+	if assert.NotNil(t, d) {
+		assert.Len(t, d.Targets, 2)
+		assert.Equal(t, []string{stateCritical, stateWarning}, d.States)
+		assert.True(t, d.hasState("critical"))
+	}
+
+	// nothing new until alertInterval
+	d, _ = notify("nginx-1", "PROBLEM", stateWarning, 4*time.Minute)
+	assert.Nil(t, d)
+
+	// a new target is sent in the next digest right away
+	d, _ = notify("nginx-2", "PROBLEM", stateCritical, 5*time.Minute)
+	if assert.NotNil(t, d) {
+		assert.Len(t, d.Targets, 3)
+	}
+
+	// consolidated recovery, once all targets recover
+	d, grouped = notify("nginx-0", "RECOVERY", stateOK, 6*time.Minute)
+	assert.True(t, grouped)
+	assert.Nil(t, d)
+	d, _ = notify("nginx-1", "RECOVERY", stateOK, 6*time.Minute)
+	assert.Nil(t, d)
+	d, _ = notify("nginx-2", "RECOVERY", stateOK, 7*time.Minute)
+	if assert.NotNil(t, d) {
+		assert.Len(t, d.Targets, 3)
+		assert.True(t, d.hasState(stateWarning))
+	}
+	assert.Empty(t, status.NotificationGroups)
+
+	// problems that recover within groupWait are not notified
+	d, _ = notify("nginx-0", "PROBLEM", stateCritical, 10*time.Minute)
+	assert.Nil(t, d)
+	d, grouped = notify("nginx-0", "RECOVERY", stateOK, 11*time.Minute)
+	assert.True(t, grouped)
+	assert.Nil(t, d)
+	assert.Empty(t, status.NotificationGroups)
+
+	// recovery of a target without group is sent alone
+	_, grouped = notify("nginx-0", "RECOVERY", stateOK, 12*time.Minute)
+	assert.False(t, grouped)
+}
+
+func TestFlushGroup(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	alert := &api.PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+		Spec: api.PodAlertSpec{
+			AlertInterval: metav1.Duration{Duration: 5 * time.Minute},
+			Grouping:      &api.NotificationGrouping{GroupWait: metav1.Duration{Duration: 2 * time.Minute}},
+			Receivers:     []api.Receiver{{State: stateCritical, To: []string{"ops@example.com"}, Notifier: "Mailgun"}},
+		},
+		Status: api.AlertStatus{
+			NotificationGroups: []api.NotificationGroup{
+				{
+					FirstTimestamp: metav1.NewTime(start),
+					Targets: []api.GroupedTarget{
+						{Name: "payments-0", State: stateCritical, CheckOutput: "exit status 1", LastTimestamp: metav1.NewTime(start)},
+						{Name: "payments-1", State: stateWarning, CheckOutput: "exit status 2", LastTimestamp: metav1.NewTime(start)},
+					},
+				},
+			},
+		},
+	}
+	incident := &api.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pod.payments-0.pod-exec.20190107-0900",
+			Namespace: "demo",
+			Labels: map[string]string{
+				api.LabelKeyAlertType:        icinga.TypePod,
+				api.LabelKeyAlert:            alert.Name,
+				api.LabelKeyObjectName:       "payments-0",
+				api.LabelKeyProblemRecovered: "false",
+			},
+			CreationTimestamp: metav1.NewTime(start),
+		},
+		Status: api.IncidentStatus{
+			LastNotificationType: api.NotificationProblem,
+			Notifications: []api.IncidentNotification{
+				{Type: api.NotificationProblem, LastState: stateCritical, CheckOutput: "exit status 1", FirstTimestamp: metav1.NewTime(start), LastTimestamp: metav1.NewTime(start)},
+			},
+		},
+	}
+	pod := &core.Pod{ObjectMeta: metav1.ObjectMeta{Name: "payments-0", Namespace: "demo"}}
+
+	extClient := fake.NewSimpleClientset(alert, incident)
+	factory := mon_informers.NewSharedInformerFactory(extClient, 0)
+	kubeClient := kfake.NewSimpleClientset(pod)
+	d := NewDispatcher(kubeClient, extClient, informers.NewSharedInformerFactory(kubeClient, 0), factory, nil, Correlation{})
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	factory.WaitForCacheSync(stopCh)
+
+	// digest is not due before groupWait
+	targets, err := d.FlushGroup(incident, metav1.NewTime(start.Add(time.Minute)))
+	assert.NoError(t, err)
+	assert.Zero(t, targets)
+
+	// digest is sent at groupWait, without another notification of Icinga
+	targets, err = d.FlushGroup(incident, metav1.NewTime(start.Add(2*time.Minute)))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, targets)
+
+	cur, err := extClient.MonitoringV1alpha1().PodAlerts("demo").Get(alert.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	if assert.Len(t, cur.Status.NotificationGroups, 1) {
+		g := cur.Status.NotificationGroups[0]
+		assert.NotNil(t, g.NotifiedTimestamp)
+		assert.Equal(t, []string{stateCritical, stateWarning}, g.NotifiedStates)
+	}
+	// flush only records deliveries in incident
+	cur2, err := extClient.MonitoringV1alpha1().Incidents("demo").Get(incident.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, incident.Status.Notifications, cur2.Status.Notifications)
+}
@@ -21,6 +21,7 @@ func (n *notifier) appendIncidentNotification(notifications []api.IncidentNotifi
 		FirstTimestamp: metav1.NewTime(opts.time),
 		LastTimestamp:  metav1.NewTime(opts.time),
 		LastState:      opts.serviceState,
+		SuppressedBy:   n.suppressedBy,
 	}
 	notifications = append(notifications, notification)
 	return notifications
@@ -43,16 +44,32 @@ func (n *notifier) getLabel() map[string]string {
 		api.LabelKeyObjectName:       n.options.host.ObjectName,
 		api.LabelKeyProblemRecovered: "false",
 	}
+	if ns := n.objectNamespace(); ns != "" {
+		labelMap[api.LabelKeyObjectNamespace] = ns
+	}
 
 	return labelMap
 }
 
+// objectNamespace returns the namespace of pod, if it is not in the namespace of alert.
+// Incidents are always created in the namespace of alert.
+func (n *notifier) objectNamespace() string {
+	host := n.options.host
+	if host.ObjectNamespace != host.AlertNamespace {
+		return host.ObjectNamespace
+	}
+	return ""
+}
+
 func (n *notifier) generateIncidentName() (string, error) {
 	host := n.options.host
 	t := n.options.time.Format("20060102-1504")
 
 	switch host.Type {
-	case icinga.TypePod, icinga.TypeNode:
+	case icinga.TypePod, icinga.TypeService, icinga.TypeWorkload, icinga.TypeNode:
+		if ns := n.objectNamespace(); ns != "" {
+			return host.Type + "." + ns + "." + host.ObjectName + "." + n.options.alertName + "." + t, nil
+		}
 		return host.Type + "." + host.ObjectName + "." + n.options.alertName + "." + t, nil
 	case icinga.TypeCluster:
 		return host.Type + "." + n.options.alertName + "." + t, nil
@@ -61,7 +78,7 @@ func (n *notifier) generateIncidentName() (string, error) {
 	return "", fmt.Errorf("unknown host type %s", host.Type)
 }
 
-func (n *notifier) reconcileIncident() error {
+func (n *notifier) reconcileIncident(alert api.Alert) error {
 	opts := n.options
 
 	incident, err := n.getIncident()
@@ -70,8 +87,15 @@ func (n *notifier) reconcileIncident() error {
 	}
 
 	if incident != nil {
+		// incident is patched from its current version, as its status is modified below
+		cur := incident.DeepCopy()
 		notifications := incident.Status.Notifications
-		if api.AlertType(opts.notificationType) == api.NotificationCustom {
+		lastNotificationType := api.AlertType(opts.notificationType)
+		if n.escalate || n.delayed() {
+			// escalations requested by user only record the escalation step, and delayed
+			// notifications only their deliveries
+			lastNotificationType = incident.Status.LastNotificationType
+		} else if api.AlertType(opts.notificationType) == api.NotificationCustom {
 			notifications = n.appendIncidentNotification(notifications)
 		} else {
 			updated := false
@@ -80,7 +104,8 @@ func (n *notifier) reconcileIncident() error {
 				if notification.Type == api.NotificationAcknowledgement {
 					continue
 				}
-				if api.AlertType(opts.notificationType) == notification.Type {
+				// suppressed notifications are recorded separately from the ones sent to receivers
+				if api.AlertType(opts.notificationType) == notification.Type && notification.SuppressedBy == n.suppressedBy {
 					notifications[i] = n.updateIncidentNotification(notification)
 					updated = true
 					break
@@ -90,16 +115,29 @@ func (n *notifier) reconcileIncident() error {
 				notifications = n.appendIncidentNotification(notifications)
 			}
 		}
+		notifications = n.appendEscalations(notifications)
 
-		incident.Status.LastNotificationType = api.AlertType(opts.notificationType)
+		incident.Status.LastNotificationType = lastNotificationType
 		incident.Status.Notifications = notifications
 
-		if api.AlertType(opts.notificationType) == api.NotificationRecovery {
-			_, _, err = util.PatchIncident(n.extClient, incident, func(in *api.Incident) *api.Incident {
+		for _, d := range n.deliveries {
+			incident.Status.SetDelivery(d)
+		}
+		n.updateWaitingRoutes(&incident.Status)
+		n.updateNextEscalation(&incident.Status)
+		pending := incident.Status.HasPendingDeliveries()
+
+		if api.AlertType(opts.notificationType) == api.NotificationRecovery || pending {
+			_, _, err = util.PatchIncident(n.extClient, cur, func(in *api.Incident) *api.Incident {
 				if in.Labels == nil {
 					in.Labels = map[string]string{}
 				}
-				in.Labels[api.LabelKeyProblemRecovered] = "true"
+				if api.AlertType(opts.notificationType) == api.NotificationRecovery {
+					in.Labels[api.LabelKeyProblemRecovered] = "true"
+				}
+				if pending {
+					in.Labels[api.LabelKeyDeliveryPending] = "true"
+				}
 				return in
 			})
 			if err != nil {
@@ -107,9 +145,14 @@ func (n *notifier) reconcileIncident() error {
 			}
 		}
 
-		_, err = util.UpdateIncidentStatus(n.extClient, incident, func(in *api.IncidentStatus) *api.IncidentStatus {
-			in.LastNotificationType = api.AlertType(opts.notificationType)
+		_, err = util.UpdateIncidentStatus(n.extClient, cur, func(in *api.IncidentStatus) *api.IncidentStatus {
+			in.LastNotificationType = lastNotificationType
 			in.Notifications = notifications
+			for _, d := range n.deliveries {
+				in.SetDelivery(d)
+			}
+			n.updateWaitingRoutes(in)
+			n.updateNextEscalation(in)
 			return in
 		}, api.EnableStatusSubresource)
 		if err != nil {
@@ -121,17 +164,29 @@ func (n *notifier) reconcileIncident() error {
 			return err
 		}
 
+		labelMap := n.getLabel()
+		for k, v := range n.placementLabels() {
+			labelMap[k] = v
+		}
 		incident := &api.Incident{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: opts.host.AlertNamespace,
-				Labels:    n.getLabel(),
+				Name:            name,
+				Namespace:       opts.host.AlertNamespace,
+				Labels:          labelMap,
+				OwnerReferences: []metav1.OwnerReference{AlertOwnerReference(alert)},
 			},
 			Status: api.IncidentStatus{
-				LastNotificationType: api.AlertType(opts.notificationType),
-				Notifications:        n.appendIncidentNotification(make([]api.IncidentNotification, 0)),
+				LastNotificationType:    api.AlertType(opts.notificationType),
+				Notifications:           n.appendIncidentNotification(make([]api.IncidentNotification, 0)),
+				Deliveries:              n.deliveries,
+				ParentRef:               n.parent,
+				WaitingRoutes:           n.waitingRoutes,
+				NextEscalationTimestamp: n.nextEscalation,
 			},
 		}
+		if incident.Status.HasPendingDeliveries() {
+			incident.Labels[api.LabelKeyDeliveryPending] = "true"
+		}
 
 		if _, err = n.extClient.Incidents(incident.Namespace).Create(incident); err != nil {
 			return err
@@ -141,6 +196,61 @@ func (n *notifier) reconcileIncident() error {
 	return nil
 }
 
+// updateWaitingRoutes records the routes of this notification whose receivers wait for the problem to last
+// for their groupWait. Routes whose receivers got the problem meanwhile are removed.
+func (n *notifier) updateWaitingRoutes(status *api.IncidentStatus) {
+	switch {
+	case n.escalate, n.flushGroup, n.escalateDue:
+	case n.notifyWaiting:
+		for _, route := range n.dueRoutes {
+			status.RemoveWaitingRoute(route)
+		}
+	case api.AlertType(n.options.notificationType) == api.NotificationProblem:
+		status.WaitingRoutes = nil
+		for _, r := range n.waitingRoutes {
+			status.SetWaitingRoute(r)
+		}
+	case api.AlertType(n.options.notificationType) == api.NotificationRecovery:
+		// receivers of waiting routes are not notified of problems that recover sooner
+		status.WaitingRoutes = nil
+	}
+}
+
+// AlertOwnerReference returns the owner reference of incidents to their alert, so that they are
+// garbage collected with it.
+func AlertOwnerReference(alert api.Alert) metav1.OwnerReference {
+	ref := alert.ObjectReference()
+	controller := true
+	return metav1.OwnerReference{
+		APIVersion: ref.APIVersion,
+		Kind:       ref.Kind,
+		Name:       ref.Name,
+		UID:        ref.UID,
+		Controller: &controller,
+	}
+}
+
+// ResolveIncident resolves the problem of incident on behalf of author, like its recovery does, and
+// records the resolution in its notifications. It returns false, if incident is already resolved.
+func ResolveIncident(in *api.Incident, author, comment string, now metav1.Time) bool {
+	if in.Labels[api.LabelKeyProblemRecovered] == "true" {
+		return false
+	}
+	if in.Labels == nil {
+		in.Labels = map[string]string{}
+	}
+	in.Labels[api.LabelKeyProblemRecovered] = "true"
+	in.Status.LastNotificationType = api.NotificationResolution
+	in.Status.Notifications = append(in.Status.Notifications, api.IncidentNotification{
+		Type:           api.NotificationResolution,
+		Author:         &author,
+		Comment:        &comment,
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+	})
+	return true
+}
+
 func (n *notifier) getIncident() (*api.Incident, error) {
 	incidentList, err := n.extClient.Incidents(n.options.host.AlertNamespace).List(metav1.ListOptions{
 		LabelSelector: labels.SelectorFromSet(n.getLabel()).String(),
@@ -152,10 +262,15 @@ func (n *notifier) getIncident() (*api.Incident, error) {
 	var lastCreationTimestamp time.Time
 	var incident *api.Incident
 
-	for _, item := range incidentList.Items {
+	for i := range incidentList.Items {
+		item := &incidentList.Items[i]
+		// label selector can't exclude incidents of a pod with same name in other namespace
+		if item.Labels[api.LabelKeyObjectNamespace] != n.objectNamespace() {
+			continue
+		}
 		if item.CreationTimestamp.After(lastCreationTimestamp) {
 			lastCreationTimestamp = item.CreationTimestamp.Time
-			incident = &item
+			incident = item
 		}
 	}
 	return incident, nil
@@ -175,3 +290,51 @@ func (n *notifier) getLastNonOKState(incident *api.Incident) string {
 	}
 	return lastNonOKState
 }
+
+// lastProblem returns the state and check output of the latest problem notified for incident.
+func lastProblem(incident *api.Incident) (state, output string) {
+	var lastTimestamp time.Time
+	for _, item := range incident.Status.Notifications {
+		if item.Type == api.NotificationProblem && item.LastTimestamp.After(lastTimestamp) {
+			lastTimestamp = item.LastTimestamp.Time
+			state, output = item.LastState, item.CheckOutput
+		}
+	}
+	return
+}
+
+// updateAlertTarget records the state reported by Icinga in the status of the alert's target. The state
+// is written in the same form as Searchlight operator does, such as Critical.
+func (n *notifier) updateAlertTarget(alert api.Alert) error {
+	opts := n.options
+	if t := api.AlertType(opts.notificationType); t != api.NotificationProblem && t != api.NotificationRecovery {
+		return nil
+	}
+
+	// pods are named in the same way as Searchlight operator does, so that they have one target
+	name := opts.host.ObjectName
+	if opts.host.Type == icinga.TypePod {
+		name = api.PodTargetName(opts.host.AlertNamespace, opts.host.ObjectNamespace, opts.host.ObjectName)
+	}
+	lastCheckTime := metav1.NewTime(opts.time)
+	transform := func(in *api.AlertStatus) *api.AlertStatus {
+		in.SetTarget(api.TargetStatus{
+			Name:            name,
+			State:           sanitizeState(opts.serviceState),
+			LastCheckOutput: opts.serviceOutput,
+			LastCheckTime:   &lastCheckTime,
+		})
+		return in
+	}
+
+	var err error
+	switch a := alert.(type) {
+	case *api.PodAlert:
+		_, err = util.UpdatePodAlertStatus(n.extClient, a, transform, api.EnableStatusSubresource)
+	case *api.NodeAlert:
+		_, err = util.UpdateNodeAlertStatus(n.extClient, a, transform, api.EnableStatusSubresource)
+	case *api.ClusterAlert:
+		_, err = util.UpdateClusterAlertStatus(n.extClient, a, transform, api.EnableStatusSubresource)
+	}
+	return err
+}
@@ -0,0 +1,129 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/fake"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestUpdateAlertTarget(t *testing.T) {
+	cases := []struct {
+		name     string
+		host     string
+		expected string
+	}{
+		{"pod in namespace of alert", "demo@pod@payments-0", "payments-0"},
+		// pods selected via namespaceSelector are named as namespace/name, as Searchlight operator does
+		{"pod in other namespace", "demo@pod@payments-0@shop", "shop/payments-0"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			alert := &api.PodAlert{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+				Status: api.AlertStatus{
+					MatchedTargets: 1,
+					Targets:        []api.TargetStatus{{Name: c.expected, State: "OK"}},
+				},
+			}
+			extClient := fake.NewSimpleClientset(alert).MonitoringV1alpha1()
+
+			host, err := icinga.ParseHost(c.host)
+			assert.Nil(t, err)
+			n := newPlugin(nil, extClient, options{
+				notificationType: "PROBLEM",
+				serviceState:     "CRITICAL",
+				serviceOutput:    "exit status 1",
+				time:             time.Now(),
+				host:             host,
+			})
+			assert.Nil(t, n.updateAlertTarget(alert))
+
+			cur, err := extClient.PodAlerts("demo").Get("pod-exec", metav1.GetOptions{})
+			assert.Nil(t, err)
+			assert.Equal(t, int32(1), cur.Status.MatchedTargets)
+			if assert.Len(t, cur.Status.Targets, 1) {
+				// state of Icinga is written in the same form as Searchlight operator does
+				assert.Equal(t, c.expected, cur.Status.Targets[0].Name)
+				assert.Equal(t, stateCritical, cur.Status.Targets[0].State)
+				assert.Equal(t, "exit status 1", cur.Status.Targets[0].LastCheckOutput)
+			}
+		})
+	}
+}
+
+func TestGetIncident(t *testing.T) {
+	now := time.Now()
+	newIncident := func(name string, created time.Time) *api.Incident {
+		return &api.Incident{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "demo",
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					api.LabelKeyAlertType:        icinga.TypePod,
+					api.LabelKeyAlert:            "pod-exec",
+					api.LabelKeyObjectName:       "payments-0",
+					api.LabelKeyProblemRecovered: "false",
+				},
+			},
+		}
+	}
+	latest := newIncident("pod.payments-0.pod-exec.20180428-1110", now)
+	extClient := fake.NewSimpleClientset(
+		latest,
+		newIncident("pod.payments-0.pod-exec.20180428-1010", now.Add(-time.Hour)),
+	).MonitoringV1alpha1()
+
+	host, err := icinga.ParseHost("demo@pod@payments-0")
+	assert.Nil(t, err)
+	n := newPlugin(nil, extClient, options{alertName: "pod-exec", host: host})
+	incident, err := n.getIncident()
+	assert.Nil(t, err)
+	if assert.NotNil(t, incident) {
+		assert.Equal(t, latest.Name, incident.Name)
+	}
+}
+
+func TestUpdateWaitingRoutes(t *testing.T) {
+	now := metav1.Now()
+	pager := api.WaitingRoute{Route: "default/pager", NotifyTimestamp: now}
+	chat := api.WaitingRoute{Route: "default/chat", NotifyTimestamp: now}
+
+	cases := []struct {
+		name     string
+		notifier *notifier
+		expected []api.WaitingRoute
+	}{
+		{
+			name:     "problem replaces waiting routes",
+			notifier: &notifier{options: options{notificationType: "PROBLEM"}, waitingRoutes: []api.WaitingRoute{chat}},
+			expected: []api.WaitingRoute{chat},
+		},
+		{
+			name:     "recovery clears waiting routes",
+			notifier: &notifier{options: options{notificationType: "RECOVERY"}},
+		},
+		{
+			name:     "acknowledgement keeps waiting routes",
+			notifier: &notifier{options: options{notificationType: "ACKNOWLEDGEMENT"}},
+			expected: []api.WaitingRoute{pager},
+		},
+		{
+			name:     "notified routes are removed",
+			notifier: &notifier{options: options{notificationType: "PROBLEM"}, notifyWaiting: true, dueRoutes: []string{"default/pager"}},
+			expected: []api.WaitingRoute{},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			status := api.IncidentStatus{WaitingRoutes: []api.WaitingRoute{pager}}
+			c.notifier.updateWaitingRoutes(&status)
+			assert.Equal(t, c.expected, status.WaitingRoutes)
+		})
+	}
+}
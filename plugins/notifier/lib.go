@@ -9,48 +9,67 @@ import (
 
 	"github.com/appscode/go/flags"
 	"github.com/appscode/go/log"
+	"github.com/appscode/searchlight/apis/incidents"
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
 	cs "github.com/appscode/searchlight/client/clientset/versioned/typed/monitoring/v1alpha1"
 	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/pkg/registry/acknowledgement"
 	"github.com/appscode/searchlight/plugins"
 	"github.com/spf13/cobra"
 	"gomodules.xyz/envconfig"
 	notify "gomodules.xyz/notify"
 	"gomodules.xyz/notify/unified"
+	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"kmodules.xyz/client-go/logs"
-	"kmodules.xyz/client-go/tools/clientcmd"
 )
 
 type notifier struct {
 	client    corev1.SecretInterface
 	extClient cs.MonitoringV1alpha1Interface
 	options   options
+	// used to read labels of targets for Silences
+	kubeClient kubernetes.Interface
+	// Kind/name of the object that suppressed this notification
+	suppressedBy string
+	// escalation steps of receivers reached by this notification
+	escalations []metav1.Duration
+	// digest of the group of target, sent instead of this notification
+	digest *Digest
+	// deliveries of this notification to receivers, recorded in incident
+	deliveries []api.NotificationDelivery
+	// receivers this notification is sent to, returned to hyperalert
+	receivers []incidents.NotificationReceiver
+	// read objects from the informer caches of Searchlight operator
+	listers listers
+	// signs acknowledge links of notifications, nil if they are not sent
+	links *acknowledgement.Links
+	// send the problem to an escalation step of incident, requested by user
+	escalate bool
+	// escalation step requested by user, the earliest step not reached yet if nil
+	escalateAfter *metav1.Duration
+	// finds the parent incidents of incidents, nil if incidents are not correlated
+	correlator *correlator
+	// open parent incident this notification is grouped under
+	parent *api.CorrelatedIncidentReference
+	// routes whose receivers are notified of this problem after their groupWait, recorded in incident
+	waitingRoutes []api.WaitingRoute
+	// send the problem of incident only to the receivers of its waiting routes that are due
+	notifyWaiting bool
+	// waiting routes of incident whose receivers are notified by this notification
+	dueRoutes []string
+	// send only the first digest of the group of target, once its groupWait expires
+	flushGroup bool
+	// send the problem of incident only to the receivers of its escalation steps that are due
+	escalateDue bool
+	// time at which receivers of the next escalation step of this problem are notified, recorded in incident
+	nextEscalation *metav1.Time
 }
 
 func newPlugin(client corev1.SecretInterface, extClient cs.MonitoringV1alpha1Interface, opts options) *notifier {
-	return &notifier{client, extClient, opts}
-}
-
-func newPluginFromConfig(opts options) (*notifier, error) {
-	config, err := clientcmd.BuildConfigFromContext(opts.kubeconfigPath, opts.contextName)
-	if err != nil {
-		return nil, err
-	}
-
-	client, err := kubernetes.NewForConfig(config)
-	if err != nil {
-		return nil, err
-	}
-
-	extClient, err := cs.NewForConfig(config)
-	if err != nil {
-		return nil, err
-	}
-
-	return newPlugin(client.CoreV1().Secrets(opts.host.AlertNamespace), extClient, opts), nil
+	return &notifier{client: client, extClient: extClient, options: opts}
 }
 
 type options struct {
@@ -69,6 +88,8 @@ type options struct {
 	// IcingaHost
 	hostname string
 	host     *icinga.IcingaHost
+	// print the receivers that would be notified, instead of sending notifications
+	dryRun bool
 }
 
 const (
@@ -95,18 +116,6 @@ func (o *options) complete(cmd *cobra.Command) (err error) {
 	}
 	o.time = t
 
-	// sanitized state to preferred form
-	switch strings.ToUpper(o.serviceState) {
-	case "OK":
-		o.serviceState = stateOK
-	case "WARNING":
-		o.serviceState = stateWarning
-	case "CRITICAL":
-		o.serviceState = stateCritical
-	default:
-		o.serviceState = stateUnknown
-	}
-
 	o.kubeconfigPath, err = cmd.Flags().GetString(plugins.FlagKubeConfig)
 	if err != nil {
 		return
@@ -122,6 +131,20 @@ func (o *options) validate() error {
 	return nil
 }
 
+// sanitizeState returns state of Icinga in preferred form.
+func sanitizeState(state string) string {
+	switch strings.ToUpper(state) {
+	case "OK":
+		return stateOK
+	case "WARNING":
+		return stateWarning
+	case "CRITICAL":
+		return stateCritical
+	default:
+		return stateUnknown
+	}
+}
+
 type Secret struct {
 	Namespace string `json:"namespace"`
 	Token     string `json:"token"`
@@ -133,101 +156,305 @@ func (n *notifier) getLoader(alert api.Alert) (envconfig.LoaderFunc, error) {
 		return nil, err
 	}
 
+	return SecretLoader(cfg), nil
+}
+
+// SecretLoader loads notifier credentials from a Secret.
+func SecretLoader(cfg *core.Secret) envconfig.LoaderFunc {
 	return func(key string) (value string, found bool) {
 		var bytes []byte
 		bytes, found = cfg.Data[key]
 		value = string(bytes)
 		return
-	}, nil
+	}
 }
 
 func (n *notifier) getAlert() (api.Alert, error) {
 	opts := n.options
+	// alerts are copied from cache, as they are set with the defaults of their templates
 	switch opts.host.Type {
 	case icinga.TypePod:
-		return n.extClient.PodAlerts(opts.host.AlertNamespace).Get(opts.alertName, metav1.GetOptions{})
+		alert, err := n.listers.podAlerts.PodAlerts(opts.host.AlertNamespace).Get(opts.alertName)
+		if err != nil {
+			return nil, err
+		}
+		alert = alert.DeepCopy()
+		return alert, n.setTemplateDefaults(alert)
+	case icinga.TypeService:
+		alert, err := n.listers.serviceAlerts.ServiceAlerts(opts.host.AlertNamespace).Get(opts.alertName)
+		if err != nil {
+			return nil, err
+		}
+		return alert.DeepCopy(), nil
+	case icinga.TypeWorkload:
+		alert, err := n.listers.workloadAlerts.WorkloadAlerts(opts.host.AlertNamespace).Get(opts.alertName)
+		if err != nil {
+			return nil, err
+		}
+		return alert.DeepCopy(), nil
 	case icinga.TypeNode:
-		return n.extClient.NodeAlerts(opts.host.AlertNamespace).Get(opts.alertName, metav1.GetOptions{})
+		alert, err := n.listers.nodeAlerts.NodeAlerts(opts.host.AlertNamespace).Get(opts.alertName)
+		if err != nil {
+			return nil, err
+		}
+		alert = alert.DeepCopy()
+		return alert, n.setTemplateDefaults(alert)
 	case icinga.TypeCluster:
-		return n.extClient.ClusterAlerts(opts.host.AlertNamespace).Get(opts.alertName, metav1.GetOptions{})
+		alert, err := n.listers.clusterAlerts.ClusterAlerts(opts.host.AlertNamespace).Get(opts.alertName)
+		if err != nil {
+			return nil, err
+		}
+		alert = alert.DeepCopy()
+		return alert, n.setTemplateDefaults(alert)
 	}
 	return nil, fmt.Errorf("unknown host type %s", opts.host.Type)
 }
 
-func (n *notifier) sendToReceiver(alert api.Alert, receiver api.Receiver, loader envconfig.LoaderFunc) error {
-	notifyVia, err := unified.LoadVia(receiver.Notifier, loader)
+// setTemplateDefaults sets the receivers, notifier secret and other fields of alert that are not set
+// from its AlertTemplate.
+func (n *notifier) setTemplateDefaults(alert api.TemplatedAlert) error {
+	ref := alert.GetTemplateRef()
+	if ref == nil {
+		return nil
+	}
+	t, err := n.listers.alertTemplates.AlertTemplates(alert.GetNamespace()).Get(ref.Name)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to get AlertTemplate %s. Reason: %v", ref.Name, err)
+	}
+	alert.SetTemplateDefaults(t)
+	return nil
+}
+
+// renderMessage renders the notification for receiver in the format of its notifier.
+func (n *notifier) renderMessage(alert api.Alert, receiver routedReceiver, incident *api.Incident) (api.NotificationMessage, error) {
+	notifyVia, err := unified.LoadVia(receiver.Notifier, receiver.loader)
+	if err != nil {
+		return api.NotificationMessage{}, err
+	}
+
+	// user defined templates are rendered, if any, falling back to the built-in ones
+	var data TemplateData
+	if receiver.templates != nil {
+		data = n.receiverTemplateData(alert, receiver, incident)
+	} else {
+		data = n.templateData(alert)
+	}
+	data.AcknowledgeURL, data.UnacknowledgeURL = n.acknowledgeURLs(receiver, incident)
+	subject, msg := n.RenderSubject(receiver.Receiver), appendLinks(n.RenderSMS(receiver.Receiver), data)
+	if n.digest != nil {
+		subject, msg = n.RenderDigestSubject(n.digest), n.RenderDigest(n.digest)
+	}
+	render := func(channel string, builtin string) string {
+		if msg, ok := receiver.templates.render(channel, data); ok {
+			return msg
+		}
+		return builtin
 	}
 
-	switch nv := notifyVia.(type) {
+	switch notifyVia.(type) {
 	case notify.ByEmail:
-		var body string
-		body, err = n.RenderMail(alert)
-		if err != nil {
-			return fmt.Errorf("failed to render email. Reason: %s", err)
+		body, ok := receiver.templates.render(ChannelMailBody, data)
+		if !ok && n.digest != nil {
+			body = n.RenderDigestMail(n.digest)
+		} else if !ok {
+			body, err = n.RenderMail(data)
+			if err != nil {
+				return api.NotificationMessage{}, fmt.Errorf("failed to render email. Reason: %s", err)
+			}
 		}
-		return nv.To(receiver.To[0], receiver.To[1:]...).
-			WithSubject(n.RenderSubject(receiver)).
-			WithBody(body).
-			WithNoTracking().
-			SendHtml()
+		return api.NotificationMessage{Subject: render(ChannelMailSubject, subject), Body: body, HTML: true}, nil
 	case notify.BySMS:
-		return nv.To(receiver.To[0], receiver.To[1:]...).
-			WithBody(n.RenderSMS(receiver)).
-			Send()
+		return api.NotificationMessage{Body: render(ChannelSMS, msg)}, nil
 	case notify.ByChat:
-		return nv.To(receiver.To[0], receiver.To[1:]...).
-			WithBody(n.RenderSMS(receiver)).
-			Send()
+		return api.NotificationMessage{Body: render(ChannelChat, msg)}, nil
 	case notify.ByPush:
-		return nv.To(receiver.To[0:]...).
-			WithBody(n.RenderSMS(receiver)).
-			Send()
+		return api.NotificationMessage{Body: render(ChannelPush, msg)}, nil
 	default:
-		return fmt.Errorf(`invalid notifier "%s"`, receiver.Notifier)
+		return api.NotificationMessage{}, fmt.Errorf(`invalid notifier "%s"`, receiver.Notifier)
 	}
-
 }
 
-func (n *notifier) sendNotification() {
-
+func (n *notifier) sendNotification() error {
 	alert, err := n.getAlert()
 	if err != nil {
-		log.Fatalln(err)
+		return err
+	}
+
+	// alerts whose notifications are only routed by NotificationPolicies may have no notifier secret
+	var config notifierConfig
+	if alert.GetNotifierSecretName() != "" {
+		config.secret = &api.NotifierSecretReference{Namespace: n.options.host.AlertNamespace, Name: alert.GetNotifierSecretName()}
+		if config.loader, err = n.getLoader(alert); err != nil {
+			return err
+		}
+	} else if ref := api.DefaultNotifierSecret; ref != nil {
+		config.secret = ref
+		if config.loader, err = n.getSecretLoader(ref.Namespace, ref.Name); err != nil {
+			log.Errorf("failed to get default notifier secret %s/%s. Reason: %v", ref.Namespace, ref.Name, err)
+		}
+	}
+	if config.secret != nil {
+		if config.templates, err = n.getTemplates(config.secret.Namespace, config.loader); err != nil {
+			log.Errorln(err)
+		}
 	}
 
-	loader, err := n.getLoader(alert)
+	incident, err := n.getIncident()
 	if err != nil {
-		log.Fatalln(err)
+		log.Errorln(err)
+	}
+	if n.delayed() && (incident == nil || !isOpen(incident)) {
+		return nil
+	}
+	if n.notifyWaiting {
+		if n.dueRoutes = incident.Status.DueWaitingRoutes(n.options.time); len(n.dueRoutes) == 0 {
+			return nil
+		}
 	}
 
 	serviceState := n.options.serviceState
-	if api.AlertType(n.options.notificationType) == api.NotificationRecovery {
-		if incident, _ := n.getIncident(); incident != nil {
-			if lastNonOKState := n.getLastNonOKState(incident); lastNonOKState != "" {
-				serviceState = lastNonOKState
-			}
+	if api.AlertType(n.options.notificationType) == api.NotificationRecovery && incident != nil {
+		if lastNonOKState := n.getLastNonOKState(incident); lastNonOKState != "" {
+			serviceState = lastNonOKState
 		}
 	}
 
-	receivers := alert.GetReceivers()
+	// escalations requested by user are neither suppressed nor grouped
+	if !n.escalate {
+		if err := n.suppress(alert); err != nil {
+			log.Errorln(err)
+		} else if n.suppressedBy != "" {
+			log.Infof("Notification suppressed by %s", n.suppressedBy)
+		}
+	}
+	// notifications of incidents correlated under an open parent are only sent for the parent
+	if !n.escalate && n.suppressedBy == "" {
+		if err := n.correlate(incident); err != nil {
+			log.Errorln(err)
+		} else if n.parent != nil {
+			n.suppressedBy = api.ResourceKindIncident + "/" + n.parent.Name
+			log.Infof("Notification is grouped under parent incident %s/%s", n.parent.Namespace, n.parent.Name)
+		}
+	}
+
+	routed, replace, err := n.getRoutedReceivers(alert, serviceState, incident, config)
+	if err != nil {
+		log.Errorln(err)
+	}
+	var receivers []routedReceiver
+	if !replace && !n.notifyWaiting {
+		for _, receiver := range alert.GetReceivers() {
+			receivers = append(receivers, routedReceiver{
+				Receiver:        receiver,
+				secretNamespace: n.options.host.AlertNamespace,
+				notifierConfig:  config,
+			})
+		}
+	}
+	for _, receiver := range routed {
+		// other receivers got the problem already, when receivers of waiting routes are notified
+		if !n.notifyWaiting || n.dueRoute(receiver.route) {
+			receivers = append(receivers, receiver)
+		}
+	}
+	if n.escalate {
+		if receivers, err = n.escalationReceivers(receivers, serviceState, incident); err != nil {
+			return err
+		}
+	} else if n.suppressedBy != "" {
+		receivers = nil
+	} else if n.notifyWaiting || n.escalateDue {
+		// receivers of waiting routes and escalation steps get the problem of target, even if it is grouped
+	} else if digest, grouped, err := n.groupNotification(alert); err != nil {
+		log.Errorln(err)
+	} else if grouped {
+		if n.digest = digest; digest == nil {
+			log.Infoln("Notification is grouped, digest of its group is not due")
+			receivers = nil
+		}
+	}
+	if n.flushGroup && n.digest == nil {
+		// problem of target was sent before, if it is not grouped any more
+		receivers = nil
+	}
 
 	for _, receiver := range receivers {
-		if len(receiver.To) == 0 || !strings.EqualFold(receiver.State, serviceState) {
+		if n.digest != nil {
+			// digests are sent to receivers of states of targets in them
+			if !n.digest.hasState(receiver.State) {
+				continue
+			}
+		} else if !strings.EqualFold(receiver.State, serviceState) {
+			continue
+		}
+		if n.escalateDue && !newEscalation(receiver.Receiver, incident) {
+			continue
+		}
+		if !n.escalate && !n.isEscalated(receiver.Receiver, incident) {
+			continue
+		}
+		if receiver.Receiver, err = n.resolveOnCall(receiver.Receiver); err != nil {
+			log.Errorln(err)
+		}
+		if len(receiver.To) == 0 {
+			continue
+		}
+		if n.options.dryRun {
+			n.addReceiver(receiver)
+			continue
+		}
+		if receiver, err = n.setReceiverSecret(receiver); err != nil {
+			log.Errorln(err)
+			continue
+		}
+		if receiver.loader == nil {
+			log.Errorf("notifier secret is not set for %s", receiver.Notifier)
 			continue
 		}
 
-		if err = n.sendToReceiver(alert, receiver, loader); err != nil {
+		msg, err := n.renderMessage(alert, receiver, incident)
+		if err != nil {
 			log.Errorln(err)
-		} else {
+			continue
+		}
+		n.addReceiver(receiver)
+		if d := n.deliver(receiver, msg); d.Phase == api.DeliveryPhaseSent {
 			log.Infof("Notification sent using %s", receiver.Notifier)
+		} else {
+			log.Errorf("failed to send notification using %s, it is retried in background. Reason: %s", receiver.Notifier, d.LastError)
 		}
 	}
 
-	if err := n.reconcileIncident(); err != nil {
+	if n.options.dryRun {
+		return nil
+	}
+
+	if err := n.reconcileIncident(alert); err != nil {
 		log.Errorln(err)
 	}
+
+	if n.escalate || n.delayed() {
+		return nil
+	}
+	if err := n.updateAlertTarget(alert); err != nil {
+		log.Errorln(err)
+	}
+	return nil
+}
+
+// delayed returns true, if this notification sends the problem of an open incident to the receivers that
+// waited for it. Such notifications only record their deliveries and escalations in incident.
+func (n *notifier) delayed() bool {
+	return n.notifyWaiting || n.flushGroup || n.escalateDue
+}
+
+// addReceiver records a receiver this notification is sent to.
+func (n *notifier) addReceiver(receiver routedReceiver) {
+	n.receivers = append(n.receivers, incidents.NotificationReceiver{
+		Route:    receiver.route,
+		Notifier: receiver.Notifier,
+		To:       receiver.To,
+	})
 }
 
 const (
@@ -253,11 +480,13 @@ func NewCmd() *cobra.Command {
 			if err := opts.validate(); err != nil {
 				icinga.Output(icinga.Unknown, err)
 			}
-			plugin, err := newPluginFromConfig(opts)
+			client, err := newClientFromConfig(opts)
 			if err != nil {
 				icinga.Output(icinga.Unknown, err)
 			}
-			plugin.sendNotification()
+			if err := postNotification(client, opts); err != nil {
+				log.Fatalln(err)
+			}
 		},
 	}
 
@@ -269,6 +498,7 @@ func NewCmd() *cobra.Command {
 	c.Flags().String(flagEventTime, "", "Event time")
 	c.Flags().StringVarP(&opts.author, "author", "a", "", "Event author name")
 	c.Flags().StringVarP(&opts.comment, "comment", "c", "", "Event comment")
+	c.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Print the receivers and routes of NotificationPolicies the notification would be sent to, without sending it")
 
 	c.Flags().AddGoFlagSet(flag.CommandLine)
 	logs.InitLogs()
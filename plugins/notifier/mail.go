@@ -18,16 +18,22 @@ func (n *notifier) RenderSubject(receiver api.Receiver) string {
 		return fmt.Sprintf("Problem Recovered: Service [%s] for [%s] was in \"%s\" state.", opts.alertName, opts.hostname, receiver.State)
 	case api.NotificationProblem:
 		return fmt.Sprintf("Problem Detected: Service [%s] for [%s] is in \"%s\" state.", opts.alertName, opts.hostname, receiver.State)
+	case api.NotificationFlappingStart:
+		return fmt.Sprintf("Flapping Started: Service [%s] for [%s] is changing state frequently, now in \"%s\" state.", opts.alertName, opts.hostname, receiver.State)
+	case api.NotificationFlappingEnd:
+		return fmt.Sprintf("Flapping Stopped: Service [%s] for [%s] is in \"%s\" state.", opts.alertName, opts.hostname, receiver.State)
 	default:
 		return fmt.Sprintf("Service [%s] for [%s] is in \"%s\" state.", opts.alertName, opts.hostname, receiver.State)
 	}
 }
 
+// TemplateData is the data that mails and user defined notification templates are rendered with.
 type TemplateData struct {
 	AlertNamespace     string
 	AlertType          string
 	AlertName          string
 	ObjectName         string
+	ObjectNamespace    string
 	IcingaHostName     string
 	IcingaServiceName  string
 	IcingaCheckCommand string
@@ -37,16 +43,45 @@ type TemplateData struct {
 	Author             string
 	Comment            string
 	IcingaTime         time.Time
+
+	// Type of notification, such as Problem
+	NotificationType api.IncidentNotificationType
+	// State of receiver being notified
+	ReceiverState string
+	// ClusterAlert, NodeAlert, PodAlert, ServiceAlert or WorkloadAlert object
+	Alert api.Alert
+	// Labels of target pod, node, service or workload. Empty for ClusterAlerts.
+	TargetLabels map[string]string
+	// Incident with the history of notifications. Nil for notifications without an incident.
+	Incident *api.Incident
+	// Link to the service in Icingaweb, if ICINGAWEB_URL is set in notifier Secret
+	IcingaWebURL string
+	// Digest of targets, if this notification is sent for a group of targets. Nil otherwise.
+	Digest *Digest
+	// Signed link to acknowledge the problem, if Searchlight operator is configured with --acknowledge-url
+	AcknowledgeURL string
+	// Signed link to remove the acknowledgement of problem, for Acknowledgement notifications
+	UnacknowledgeURL string
+}
+
+func (n *notifier) RenderMail(data TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := mailTemplate.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	config := buf.String()
+	return config, nil
 }
 
-func (n *notifier) RenderMail(alert api.Alert) (string, error) {
+func (n *notifier) templateData(alert api.Alert) TemplateData {
 	opts := n.options
 	host := opts.host
-	data := TemplateData{
+	return TemplateData{
 		AlertName:          alert.GetName(),
 		AlertNamespace:     host.AlertNamespace,
 		AlertType:          host.Type,
 		ObjectName:         host.ObjectName,
+		ObjectNamespace:    n.objectNamespace(),
 		IcingaHostName:     n.options.hostname,
 		IcingaServiceName:  alert.GetName(),
 		IcingaCheckCommand: alert.Command(),
@@ -56,12 +91,7 @@ func (n *notifier) RenderMail(alert api.Alert) (string, error) {
 		Author:             opts.author,
 		Comment:            opts.comment,
 		IcingaTime:         opts.time,
+		NotificationType:   api.AlertType(opts.notificationType),
+		Alert:              alert,
 	}
-
-	var buf bytes.Buffer
-	if err := mailTemplate.Execute(&buf, data); err != nil {
-		return "", err
-	}
-	config := buf.String()
-	return config, nil
 }
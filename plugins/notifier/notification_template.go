@@ -0,0 +1,245 @@
+package notifier
+
+import (
+	"bytes"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/registry/acknowledgement"
+	"gomodules.xyz/envconfig"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Key of notifier Secret with the name of ConfigMap of notification templates, in the namespace of Secret
+	NotificationTemplatesKey = "NOTIFICATION_TEMPLATES"
+	// Key of notifier Secret with the address of Icingaweb, such as https://icinga.example.com
+	IcingaWebURLKey = "ICINGAWEB_URL"
+)
+
+// Channels of notification templates. Templates for a type of notification are keyed by
+// <channel>.<type>, such as sms.Problem, and are preferred to the template keyed by <channel>.
+const (
+	ChannelMailSubject = "mail.subject"
+	ChannelMailBody    = "mail.body"
+	ChannelSMS         = "sms"
+	ChannelChat        = "chat"
+	ChannelPush        = "push"
+)
+
+var (
+	templateChannels = []string{ChannelMailSubject, ChannelMailBody, ChannelSMS, ChannelChat, ChannelPush}
+
+	templateTypes = []api.IncidentNotificationType{
+		api.NotificationProblem,
+		api.NotificationAcknowledgement,
+		api.NotificationRecovery,
+		api.NotificationCustom,
+		api.NotificationFlappingStart,
+		api.NotificationFlappingEnd,
+		api.NotificationEscalation,
+	}
+
+	templateFuncs = template.FuncMap{
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"title": strings.Title,
+		"join":  strings.Join,
+	}
+)
+
+// Templates are the user defined templates of notifications, keyed by <channel> or <channel>.<type>.
+type Templates map[string]*template.Template
+
+// ParseTemplates parses the data of a ConfigMap of notification templates.
+func ParseTemplates(data map[string]string) (Templates, error) {
+	templates := Templates{}
+	for key, text := range data {
+		if !isTemplateKey(key) {
+			return nil, fmt.Errorf("unknown notification template %s", key)
+		}
+		t, err := template.New(key).Funcs(templateFuncs).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse notification template %s. Reason: %v", key, err)
+		}
+		templates[key] = t
+	}
+	return templates, nil
+}
+
+func isTemplateKey(key string) bool {
+	for _, channel := range templateChannels {
+		if key == channel {
+			return true
+		}
+		for _, t := range templateTypes {
+			if key == channel+"."+string(t) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateTemplates parses the data of a ConfigMap of notification templates and renders them
+// with sample data of alert, so that templates referring to unknown fields are rejected.
+// Templates are only parsed, if alert is nil.
+func ValidateTemplates(data map[string]string, alert api.Alert) error {
+	templates, err := ParseTemplates(data)
+	if err != nil || alert == nil {
+		return err
+	}
+	now := metav1.Now()
+	sample := TemplateData{
+		AlertNamespace:   alert.GetNamespace(),
+		AlertName:        alert.GetName(),
+		Alert:            alert,
+		NotificationType: api.NotificationProblem,
+		ReceiverState:    stateCritical,
+		TargetLabels:     map[string]string{},
+		Incident: &api.Incident{
+			Status: api.IncidentStatus{
+				LastNotificationType: api.NotificationProblem,
+				Notifications: []api.IncidentNotification{
+					{Type: api.NotificationProblem, FirstTimestamp: now, LastTimestamp: now, LastState: stateCritical},
+				},
+			},
+		},
+		IcingaTime: now.Time,
+	}
+
+	keys := make([]string, 0, len(templates))
+	for key := range templates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := templates[key].Execute(&bytes.Buffer{}, sample); err != nil {
+			return fmt.Errorf("failed to render notification template %s. Reason: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// render renders the template of channel for the type of data. ok is false, if there is no such
+// template or it fails to render.
+func (t Templates) render(channel string, data TemplateData) (msg string, ok bool) {
+	tpl, found := t[channel+"."+string(data.NotificationType)]
+	if !found {
+		if tpl, found = t[channel]; !found {
+			return "", false
+		}
+	}
+	var buf bytes.Buffer
+	if err := tpl.Execute(&buf, data); err != nil {
+		log.Errorf("failed to render notification template %s, using the built-in one. Reason: %v", tpl.Name(), err)
+		return "", false
+	}
+	return buf.String(), true
+}
+
+// getTemplates returns the notification templates referred by the notifier Secret in namespace.
+func (n *notifier) getTemplates(namespace string, loader envconfig.LoaderFunc) (Templates, error) {
+	if loader == nil {
+		return nil, nil
+	}
+	name, found := loader(NotificationTemplatesKey)
+	if !found || name == "" {
+		return nil, nil
+	}
+	if n.kubeClient == nil {
+		return nil, fmt.Errorf("kubernetes client is not configured")
+	}
+	cm, err := n.kubeClient.CoreV1().ConfigMaps(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ConfigMap %s/%s of notification templates. Reason: %v", namespace, name, err)
+	}
+	return ParseTemplates(cm.Data)
+}
+
+// icingaWebURL returns the link to the service of this notification in Icingaweb, if its address
+// is set in the notifier Secret.
+func (n *notifier) icingaWebURL(loader envconfig.LoaderFunc) string {
+	if loader == nil {
+		return ""
+	}
+	addr, found := loader(IcingaWebURLKey)
+	if !found || addr == "" {
+		return ""
+	}
+	q := url.Values{}
+	q.Set("host", n.options.hostname)
+	q.Set("service", n.options.alertName)
+	return strings.TrimSuffix(addr, "/") + "/monitoring/service/show?" + q.Encode()
+}
+
+// acknowledgeURLs returns the signed links for receiver to acknowledge the problem of this notification,
+// or to remove its acknowledgement. Links are empty, if they are not configured, or for digests that
+// cover the incidents of many targets.
+func (n *notifier) acknowledgeURLs(receiver routedReceiver, incident *api.Incident) (ack, unack string) {
+	if n.links == nil || n.digest != nil {
+		return "", ""
+	}
+	var action string
+	switch api.AlertType(n.options.notificationType) {
+	case api.NotificationProblem:
+		action = acknowledgement.ActionAcknowledge
+	case api.NotificationAcknowledgement:
+		action = acknowledgement.ActionUnacknowledge
+	default:
+		return "", ""
+	}
+
+	// incident of the first notification of a problem is created after it is sent
+	var name string
+	if incident != nil {
+		name = incident.Name
+	} else {
+		var err error
+		if name, err = n.generateIncidentName(); err != nil {
+			log.Errorln(err)
+			return "", ""
+		}
+	}
+	link, err := n.links.URL(action, n.options.host.AlertNamespace, name, strings.Join(receiver.To, ", "), time.Now())
+	if err != nil {
+		log.Errorln(err)
+		return "", ""
+	}
+	if action == acknowledgement.ActionAcknowledge {
+		return link, ""
+	}
+	return "", link
+}
+
+// appendLinks appends the acknowledge links of data to the built-in message of SMS, chat and push notifications.
+func appendLinks(msg string, data TemplateData) string {
+	if data.AcknowledgeURL != "" {
+		msg += "\nAcknowledge: " + data.AcknowledgeURL
+	}
+	if data.UnacknowledgeURL != "" {
+		msg += "\nUnacknowledge: " + data.UnacknowledgeURL
+	}
+	return msg
+}
+
+// receiverTemplateData returns the data that templates of receiver are rendered with.
+func (n *notifier) receiverTemplateData(alert api.Alert, receiver routedReceiver, incident *api.Incident) TemplateData {
+	data := n.templateData(alert)
+	data.ReceiverState = receiver.State
+	data.Incident = incident
+	data.IcingaWebURL = n.icingaWebURL(receiver.loader)
+	data.Digest = n.digest
+	if labels, err := n.getTargetLabels(); err != nil {
+		log.Errorln(err)
+	} else {
+		data.TargetLabels = labels
+	}
+	return data
+}
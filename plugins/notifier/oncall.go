@@ -0,0 +1,35 @@
+package notifier
+
+import (
+	"fmt"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+)
+
+// resolveOnCall adds the contacts on call in the OnCallSchedule of receiver at the time of notification to its To.
+func (n *notifier) resolveOnCall(receiver api.Receiver) (api.Receiver, error) {
+	if receiver.OnCallSchedule == "" {
+		return receiver, nil
+	}
+	schedule, err := n.listers.onCallSchedules.OnCallSchedules(n.options.host.AlertNamespace).Get(receiver.OnCallSchedule)
+	if err != nil {
+		return receiver, fmt.Errorf("failed to get OnCallSchedule %s. Reason: %v", receiver.OnCallSchedule, err)
+	}
+	contacts, err := schedule.OnCall(n.options.time)
+	if err != nil {
+		return receiver, err
+	}
+
+	to := make([]string, 0, len(receiver.To)+len(contacts))
+	seen := map[string]bool{}
+	for _, list := range [][]string{receiver.To, contacts} {
+		for _, c := range list {
+			if !seen[c] {
+				seen[c] = true
+				to = append(to, c)
+			}
+		}
+	}
+	receiver.To = to
+	return receiver, nil
+}
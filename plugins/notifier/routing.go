@@ -0,0 +1,166 @@
+package notifier
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/appscode/go/log"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"gomodules.xyz/envconfig"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// routedReceiver is a receiver together with the configuration of its notifier.
+type routedReceiver struct {
+	api.Receiver
+	// NotificationPolicy/route that selected the receiver. Empty for receivers of alert.
+	route string
+	// Namespace of the Secret referred by secretRef of receiver
+	secretNamespace string
+	notifierConfig
+}
+
+// notifierConfig is the configuration loaded from a notifier Secret.
+type notifierConfig struct {
+	secret    *api.NotifierSecretReference
+	loader    envconfig.LoaderFunc
+	templates Templates
+}
+
+// getRoutedReceivers returns the receivers of routes in NotificationPolicies that match this notification.
+// Policies are evaluated in order of their names. replace is true, if a matching policy replaces the
+// receivers of alert.
+func (n *notifier) getRoutedReceivers(alert api.Alert, state string, incident *api.Incident, alertConfig notifierConfig) ([]routedReceiver, bool, error) {
+	policies, err := n.listers.notificationPolicies.List(labels.Everything())
+	if err != nil {
+		return nil, false, err
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	var receivers []routedReceiver
+	var replace bool
+	var errs []error
+	var targetLabels map[string]string
+	labelsLoaded := false
+	for _, p := range policies {
+		if p.IsValid() != nil {
+			continue
+		}
+		if !labelsLoaded {
+			if targetLabels, err = n.getTargetLabels(); err != nil {
+				return nil, false, err
+			}
+			labelsLoaded = true
+		}
+		routes := p.Match(n.options.host.AlertNamespace, alert.ObjectReference().Kind, n.options.alertName, state, targetLabels)
+		if len(routes) == 0 {
+			continue
+		}
+		if p.Spec.Mode == api.NotificationPolicyModeReplace {
+			replace = true
+		}
+
+		config := alertConfig
+		secretNamespace := n.options.host.AlertNamespace
+		if ref := p.Spec.NotifierSecret; ref != nil {
+			secretNamespace = ref.Namespace
+			config = notifierConfig{secret: ref}
+			if config.loader, err = n.getSecretLoader(ref.Namespace, ref.Name); err != nil {
+				errs = append(errs, fmt.Errorf("failed to get notifier secret of NotificationPolicy %s. Reason: %v", p.Name, err))
+				continue
+			}
+			if config.templates, err = n.getTemplates(ref.Namespace, config.loader); err != nil {
+				errs = append(errs, fmt.Errorf("failed to get notification templates of NotificationPolicy %s. Reason: %v", p.Name, err))
+			}
+		}
+		for _, r := range routes {
+			route := p.Name + "/" + r.Name
+			if !n.waited(r.GroupWait.Duration, incident) {
+				n.wait(route, r.GroupWait.Duration, incident)
+				continue
+			}
+			for _, rcv := range r.Receivers {
+				receivers = append(receivers, routedReceiver{
+					Receiver:        rcv,
+					route:           route,
+					secretNamespace: secretNamespace,
+					notifierConfig:  config,
+				})
+			}
+		}
+	}
+	return receivers, replace, utilerrors.NewAggregate(errs)
+}
+
+// setReceiverSecret sets the notifier configuration of receiver from the Secret of its secretRef, if any.
+func (n *notifier) setReceiverSecret(receiver routedReceiver) (routedReceiver, error) {
+	if receiver.SecretRef == nil {
+		return receiver, nil
+	}
+	config := notifierConfig{
+		secret: &api.NotifierSecretReference{Namespace: receiver.secretNamespace, Name: receiver.SecretRef.Name},
+	}
+	var err error
+	if config.loader, err = n.getSecretLoader(config.secret.Namespace, config.secret.Name); err != nil {
+		return receiver, fmt.Errorf("failed to get notifier secret %s/%s of receiver for %s. Reason: %v", config.secret.Namespace, config.secret.Name, receiver.Notifier, err)
+	}
+	if config.templates, err = n.getTemplates(config.secret.Namespace, config.loader); err != nil {
+		log.Errorln(err)
+	}
+	receiver.notifierConfig = config
+	return receiver, nil
+}
+
+// getSecretLoader loads notifier credentials from a Secret in any namespace.
+func (n *notifier) getSecretLoader(namespace, name string) (envconfig.LoaderFunc, error) {
+	if n.kubeClient == nil {
+		return nil, fmt.Errorf("kubernetes client is not configured")
+	}
+	cfg, err := n.kubeClient.CoreV1().Secrets(namespace).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return SecretLoader(cfg), nil
+}
+
+// waited returns true, if the problem of incident has lasted for groupWait at the time of notification.
+func (n *notifier) waited(groupWait time.Duration, incident *api.Incident) bool {
+	if groupWait <= 0 {
+		return true
+	}
+	if incident == nil {
+		return false
+	}
+	return n.options.time.Sub(problemStart(incident).Time) >= groupWait
+}
+
+// wait records route, whose receivers are notified of the problem of this notification once it has lasted
+// for groupWait. Searchlight operator notifies them then, unless the problem recovers before.
+func (n *notifier) wait(route string, groupWait time.Duration, incident *api.Incident) {
+	if api.AlertType(n.options.notificationType) != api.NotificationProblem {
+		return
+	}
+	start := metav1.NewTime(n.options.time)
+	if incident != nil {
+		start = problemStart(incident)
+	}
+	n.waitingRoutes = append(n.waitingRoutes, api.WaitingRoute{
+		Route:           route,
+		NotifyTimestamp: metav1.NewTime(start.Add(groupWait)),
+	})
+}
+
+// dueRoute returns true, if route is a waiting route of incident whose receivers are notified now.
+func (n *notifier) dueRoute(route string) bool {
+	for _, r := range n.dueRoutes {
+		if r == route {
+			return true
+		}
+	}
+	return false
+}
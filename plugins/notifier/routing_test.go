@@ -0,0 +1,166 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/appscode/searchlight/apis/incidents"
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/client/clientset/versioned/fake"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetRoutedReceivers(t *testing.T) {
+	now := time.Now()
+	alert := &api.ClusterAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-cert", Namespace: "prod-payments"},
+		Spec: api.ClusterAlertSpec{
+			Receivers: []api.Receiver{{State: stateCritical, To: []string{"team@example.com"}, Notifier: "Mailgun"}},
+		},
+	}
+	chat := &api.NotificationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "b-chat"},
+		Spec: api.NotificationPolicySpec{
+			Routes: []api.NotificationRoute{
+				{
+					Name:      "all",
+					Receivers: []api.Receiver{{State: stateCritical, To: []string{"#alerts"}, Notifier: "Slack"}},
+				},
+			},
+		},
+	}
+	pager := &api.NotificationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "a-pager"},
+		Spec: api.NotificationPolicySpec{
+			Mode: api.NotificationPolicyModeReplace,
+			Routes: []api.NotificationRoute{
+				{
+					Name:      "prod",
+					Match:     api.NotificationMatcher{Namespaces: []string{"prod-*"}},
+					Receivers: []api.Receiver{{State: stateCritical, To: []string{"+1-234-567-8901"}, Notifier: "Twilio"}},
+					GroupWait: metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+		},
+	}
+
+	host, err := icinga.ParseHost("prod-payments@cluster")
+	assert.Nil(t, err)
+	opts := options{
+		alertName:        alert.Name,
+		notificationType: "PROBLEM",
+		serviceState:     stateCritical,
+		time:             now,
+		host:             host,
+	}
+	n := newPlugin(nil, nil, opts)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	n.listers = newTestListers(t, stopCh, alert, chat, pager)
+
+	// pager waits for 5m before the first notification of a problem
+	receivers, replace, err := n.getRoutedReceivers(alert, stateCritical, nil, notifierConfig{})
+	assert.Nil(t, err)
+	assert.True(t, replace)
+	assert.Len(t, receivers, 1)
+	assert.Equal(t, "b-chat/all", receivers[0].route)
+	// operator notifies pager, once the wait expires
+	assert.Equal(t, []api.WaitingRoute{{Route: "a-pager/prod", NotifyTimestamp: metav1.NewTime(now.Add(5 * time.Minute))}}, n.waitingRoutes)
+
+	incident := &api.Incident{
+		Status: api.IncidentStatus{
+			Notifications: []api.IncidentNotification{
+				{Type: api.NotificationProblem, FirstTimestamp: metav1.NewTime(now.Add(-10 * time.Minute))},
+			},
+		},
+	}
+	receivers, _, err = n.getRoutedReceivers(alert, stateCritical, incident, notifierConfig{})
+	assert.Nil(t, err)
+	assert.Len(t, receivers, 2)
+	assert.Equal(t, "a-pager/prod", receivers[0].route)
+	assert.Equal(t, []string{"+1-234-567-8901"}, receivers[0].To)
+}
+
+func TestNotifyWaitingRoutes(t *testing.T) {
+	now := time.Now()
+	alert := &api.ClusterAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "ca-cert", Namespace: "prod-payments"},
+		Spec: api.ClusterAlertSpec{
+			Receivers: []api.Receiver{{State: stateCritical, To: []string{"team@example.com"}, Notifier: "Mailgun"}},
+		},
+	}
+	policy := &api.NotificationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: api.NotificationPolicySpec{
+			Routes: []api.NotificationRoute{
+				{
+					Name:      "prod",
+					Match:     api.NotificationMatcher{Namespaces: []string{"prod-*"}},
+					Receivers: []api.Receiver{{State: stateCritical, To: []string{"#alerts"}, Notifier: "Slack"}},
+					Continue:  true,
+					Routes: []api.NotificationRoute{
+						{
+							Name:      "pager",
+							GroupWait: metav1.Duration{Duration: 5 * time.Minute},
+							Receivers: []api.Receiver{{State: stateCritical, To: []string{"+1-234-567-8901"}, Notifier: "Twilio"}},
+						},
+					},
+				},
+				{
+					Name:      "oncall",
+					GroupWait: metav1.Duration{Duration: 15 * time.Minute},
+					Receivers: []api.Receiver{{State: stateCritical, To: []string{"oncall@example.com"}, Notifier: "Mailgun"}},
+				},
+			},
+		},
+	}
+
+	host, err := icinga.ParseHost("prod-payments@cluster")
+	assert.Nil(t, err)
+	opts := options{
+		alertName:        alert.Name,
+		notificationType: string(api.NotificationProblem),
+		serviceState:     stateCritical,
+		time:             now,
+		host:             host,
+		dryRun:           true,
+	}
+	start := metav1.NewTime(now.Add(-6 * time.Minute))
+	incident := &api.Incident{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "cluster.ca-cert.20190107-0900",
+			Namespace:         alert.Namespace,
+			Labels:            newPlugin(nil, nil, opts).getLabel(),
+			CreationTimestamp: start,
+		},
+		Status: api.IncidentStatus{
+			LastNotificationType: api.NotificationProblem,
+			Notifications: []api.IncidentNotification{
+				{Type: api.NotificationProblem, LastState: stateCritical, FirstTimestamp: start, LastTimestamp: start},
+			},
+			WaitingRoutes: []api.WaitingRoute{
+				{Route: "default/prod/pager", NotifyTimestamp: metav1.NewTime(start.Add(5 * time.Minute))},
+				{Route: "default/oncall", NotifyTimestamp: metav1.NewTime(start.Add(15 * time.Minute))},
+			},
+		},
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	l := newTestListers(t, stopCh, alert, policy)
+
+	send := func(at time.Time) []incidents.NotificationReceiver {
+		opts.time = at
+		n := newPlugin(nil, fake.NewSimpleClientset(incident).MonitoringV1alpha1(), opts)
+		n.listers = l
+		n.notifyWaiting = true
+		assert.Nil(t, n.sendNotification())
+		return n.receivers
+	}
+
+	// only receivers of routes whose wait has expired get the problem
+	assert.Equal(t, []incidents.NotificationReceiver{{Route: "default/prod/pager", Notifier: "Twilio", To: []string{"+1-234-567-8901"}}}, send(now))
+	assert.Empty(t, send(now.Add(-2*time.Minute)))
+	assert.Len(t, send(now.Add(10*time.Minute)), 2)
+}
@@ -38,6 +38,10 @@ func (m *SMS) Render() string {
 		msg = fmt.Sprintf("Service [%s] for [%s] was in \"%s\" state.\nThis issue is recovered.", m.AlertName, m.Hostname, m.ServiceState)
 	case api.NotificationProblem:
 		msg = fmt.Sprintf("Service [%s] for [%s] is in \"%s\" state.\nCheck this issue in Icingaweb.", m.AlertName, m.Hostname, m.ServiceState)
+	case api.NotificationFlappingStart:
+		msg = fmt.Sprintf("Service [%s] for [%s] is flapping, now in \"%s\" state.", m.AlertName, m.Hostname, m.ServiceState)
+	case api.NotificationFlappingEnd:
+		msg = fmt.Sprintf("Service [%s] for [%s] stopped flapping, now in \"%s\" state.", m.AlertName, m.Hostname, m.ServiceState)
 	default:
 		msg = fmt.Sprintf("Service [%s] for [%s] is in \"%s\" state.", m.AlertName, m.Hostname, m.ServiceState)
 	}
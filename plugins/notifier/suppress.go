@@ -0,0 +1,133 @@
+package notifier
+
+import (
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/plugins"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// suppress sets suppressedBy, if this notification is muted by a Downtime or a Silence.
+// Suppressed notifications are recorded in Incident, but not sent to receivers.
+func (n *notifier) suppress(alert api.Alert) error {
+	downtime, err := n.getActiveDowntime(alert)
+	if err != nil {
+		return err
+	}
+	if downtime != nil {
+		n.suppressedBy = api.ResourceKindDowntime + "/" + downtime.Name
+		return nil
+	}
+
+	silence, err := n.getActiveSilence()
+	if err != nil {
+		return err
+	}
+	if silence != nil {
+		n.suppressedBy = api.ResourceKindSilence + "/" + silence.Name
+	}
+	return nil
+}
+
+// getActiveDowntime returns a Downtime of the alert whose maintenance window is in progress
+// at the time of notification, if any.
+func (n *notifier) getActiveDowntime(alert api.Alert) (*api.Downtime, error) {
+	obj, err := meta.Accessor(alert)
+	if err != nil {
+		return nil, err
+	}
+	downtimes, err := n.listers.downtimes.Downtimes(alert.GetNamespace()).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	kind := alert.ObjectReference().Kind
+	for _, d := range downtimes {
+		if d.IsValid() == nil && d.Selects(kind, obj) && d.IsActive(n.options.time) {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
+
+// getActiveSilence returns a Silence in the namespace of alert that matches this notification
+// and has not expired at the time of notification, if any.
+func (n *notifier) getActiveSilence() (*api.Silence, error) {
+	silences, err := n.listers.silences.Silences(n.options.host.AlertNamespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var lbls map[string]string
+	for _, s := range silences {
+		if s.IsValid() != nil || !s.IsActive(n.options.time) {
+			continue
+		}
+		if lbls == nil {
+			if lbls, err = n.getNotificationLabels(); err != nil {
+				return nil, err
+			}
+		}
+		if s.Matches(lbls) {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// getNotificationLabels returns the labels of target object together with the labels
+// that identify the alert and the target in Incident.
+func (n *notifier) getNotificationLabels() (map[string]string, error) {
+	target, err := n.getTargetLabels()
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[string]string, len(target)+4)
+	for k, v := range target {
+		out[k] = v
+	}
+	host := n.options.host
+	out[api.LabelKeyAlert] = n.options.alertName
+	out[api.LabelKeyAlertType] = host.Type
+	if host.Type != icinga.TypeCluster {
+		out[api.LabelKeyObjectName] = host.ObjectName
+	}
+	if host.Type == icinga.TypePod {
+		out[api.LabelKeyObjectNamespace] = host.ObjectNamespace
+	}
+	return out, nil
+}
+
+// getTargetLabels returns the labels of pod, node, service or workload the notification is about.
+// Labels are not available for ClusterAlerts or when the target has been deleted.
+func (n *notifier) getTargetLabels() (map[string]string, error) {
+	if n.kubeClient == nil {
+		return nil, nil
+	}
+	host := n.options.host
+
+	var obj metav1.Object
+	var err error
+	switch host.Type {
+	case icinga.TypePod:
+		obj, err = n.kubeClient.CoreV1().Pods(host.ObjectNamespace).Get(host.ObjectName, metav1.GetOptions{})
+	case icinga.TypeService:
+		obj, err = n.kubeClient.CoreV1().Services(host.AlertNamespace).Get(host.ObjectName, metav1.GetOptions{})
+	case icinga.TypeNode:
+		obj, err = n.kubeClient.CoreV1().Nodes().Get(host.ObjectName, metav1.GetOptions{})
+	case icinga.TypeWorkload:
+		var kind, name string
+		if kind, name, err = icinga.ParseWorkloadObjectName(host.ObjectName); err == nil {
+			obj, err = plugins.GetWorkload(n.kubeClient, kind, host.AlertNamespace, name)
+		}
+	default:
+		return nil, nil
+	}
+	if kerr.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return obj.GetLabels(), nil
+}
@@ -0,0 +1,77 @@
+package notifier
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/stretchr/testify/assert"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestSuppressBySilence(t *testing.T) {
+	now := time.Now()
+	pod := &core.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "payments-0",
+			Namespace: "demo",
+			Labels:    map[string]string{"team": "payments"},
+		},
+	}
+	alert := &api.PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+	}
+	silence := &api.Silence{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "payments",
+			Namespace:         "demo",
+			CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+		},
+		Spec: api.SilenceSpec{
+			Selector: metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"team":            "payments",
+					api.LabelKeyAlert: "pod-exec",
+				},
+			},
+			EndsAt:    metav1.NewTime(now.Add(time.Hour)),
+			CreatedBy: "admin",
+		},
+	}
+
+	host, err := icinga.ParseHost("demo@pod@payments-0")
+	assert.Nil(t, err)
+	opts := options{
+		alertName: alert.Name,
+		time:      now,
+		host:      host,
+	}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	listers := newTestListers(t, stopCh, alert, silence)
+
+	n := newPlugin(nil, nil, opts)
+	n.listers = listers
+	n.kubeClient = kfake.NewSimpleClientset(pod)
+	assert.Nil(t, n.suppress(alert))
+	assert.Equal(t, "Silence/payments", n.suppressedBy)
+
+	// silence has expired
+	n = newPlugin(nil, nil, opts)
+	n.listers = listers
+	n.options.time = now.Add(2 * time.Hour)
+	n.kubeClient = kfake.NewSimpleClientset(pod)
+	assert.Nil(t, n.suppress(alert))
+	assert.Empty(t, n.suppressedBy)
+
+	// labels of pod don't match
+	pod.Labels["team"] = "search"
+	n = newPlugin(nil, nil, opts)
+	n.listers = listers
+	n.kubeClient = kfake.NewSimpleClientset(pod)
+	assert.Nil(t, n.suppress(alert))
+	assert.Empty(t, n.suppressedBy)
+}
@@ -125,6 +125,13 @@ text-decoration: none;
                             </tr>
                              {{ end }}
 
+                            {{ if .ObjectNamespace }}
+                            <tr>
+                                <td style="text-align: left; vertical-align: top; color: #575757; font-size: 12px; padding: 6px; border: 1px solid #f0f0f0;" align="left" valign="top">Object Namespace</td>
+                                <td style="text-align: left; vertical-align: top; color: #575757; font-size: 12px; padding: 6px; border: 1px solid #f0f0f0;" align="left" valign="top">{{ .ObjectNamespace  }}</td>
+                            </tr>
+                             {{ end }}
+
                         </table>
                     </div>
 
@@ -205,6 +212,12 @@ text-decoration: none;
                         </table>
                     </div>
                     <h2 style="font-size: 14px; color: #484f64; font-weight: 400; margin: 10px 0 0; padding: 4px 0;"> Reported at <span style="border-bottom-width: 1px; border-bottom-color: #3bb778; border-bottom-style: dotted; margin: 0px;">{{ .IcingaTime }}</span></h2>
+                    {{ if .AcknowledgeURL }}
+                    <h2 style="font-size: 14px; color: #484f64; font-weight: 400; margin: 10px 0 0; padding: 4px 0;"><a href="{{ .AcknowledgeURL }}" target="_blank" style="color: #3bb778;">Acknowledge this problem</a></h2>
+                    {{ end }}
+                    {{ if .UnacknowledgeURL }}
+                    <h2 style="font-size: 14px; color: #484f64; font-weight: 400; margin: 10px 0 0; padding: 4px 0;"><a href="{{ .UnacknowledgeURL }}" target="_blank" style="color: #3bb778;">Remove acknowledgement</a></h2>
+                    {{ end }}
                 </div>
             </div>
             <!-- end content-top -->
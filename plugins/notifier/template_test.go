@@ -2,11 +2,13 @@ package notifier
 
 import (
 	"fmt"
+	"net/url"
 	"testing"
 	"time"
 
 	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
 	"github.com/appscode/searchlight/pkg/icinga"
+	"github.com/appscode/searchlight/pkg/registry/acknowledgement"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -43,8 +45,115 @@ func TestRenderMail(t *testing.T) {
 		host:             host,
 	}
 
-	config, err := newPlugin(nil, nil, opts).RenderMail(&alert)
+	n := newPlugin(nil, nil, opts)
+	config, err := n.RenderMail(n.templateData(&alert))
 	fmt.Println(err)
 	assert.Nil(t, err)
 	fmt.Println(config)
 }
+
+func TestNotificationTemplates(t *testing.T) {
+	alert := &api.PodAlert{
+		ObjectMeta: metav1.ObjectMeta{Name: "pod-exec", Namespace: "demo"},
+		Spec: api.PodAlertSpec{
+			Check: api.CheckPodExec,
+			Vars:  map[string]string{"cmd": "ls"},
+		},
+	}
+	data := map[string]string{
+		ChannelSMS:                  "{{ .AlertName }} is {{ lower .IcingaState }}",
+		ChannelSMS + ".Recovery":    "{{ .AlertName }} recovered",
+		ChannelMailSubject:          "{{ .Alert.Spec.Vars.cmd }} on {{ .ObjectName }} for {{ .ReceiverState }}",
+		ChannelChat + ".Escalation": "{{ with .Incident }}{{ len .Status.Notifications }}{{ end }}",
+	}
+	assert.NoError(t, ValidateTemplates(data, alert))
+	assert.Error(t, ValidateTemplates(map[string]string{"sms.Unknown": "{{ .AlertName }}"}, alert))
+	assert.Error(t, ValidateTemplates(map[string]string{ChannelSMS: "{{ .AlertName "}, alert))
+	assert.Error(t, ValidateTemplates(map[string]string{ChannelSMS: "{{ .NoSuchField }}"}, alert))
+	assert.NoError(t, ValidateTemplates(map[string]string{ChannelSMS: "{{ .NoSuchField }}"}, nil))
+
+	host, err := icinga.ParseHost("demo@pod@nginx-0")
+	assert.NoError(t, err)
+	n := newPlugin(nil, nil, options{
+		hostname:         "demo@pod@nginx-0",
+		alertName:        alert.Name,
+		notificationType: "PROBLEM",
+		serviceState:     stateCritical,
+		host:             host,
+	})
+	templates, err := ParseTemplates(data)
+	assert.NoError(t, err)
+
+	receiver := routedReceiver{Receiver: api.Receiver{State: stateCritical}, notifierConfig: notifierConfig{templates: templates}}
+	d := n.receiverTemplateData(alert, receiver, nil)
+	msg, ok := templates.render(ChannelSMS, d)
+	assert.True(t, ok)
+	assert.Equal(t, "pod-exec is critical", msg)
+	msg, ok = templates.render(ChannelMailSubject, d)
+	assert.True(t, ok)
+	assert.Equal(t, "ls on nginx-0 for Critical", msg)
+	_, ok = templates.render(ChannelPush, d)
+	assert.False(t, ok)
+
+	n.options.notificationType = "RECOVERY"
+	msg, ok = templates.render(ChannelSMS, n.receiverTemplateData(alert, receiver, nil))
+	assert.True(t, ok)
+	assert.Equal(t, "pod-exec recovered", msg)
+
+	receiver.loader = func(key string) (string, bool) {
+		return "https://icinga.example.com/", key == IcingaWebURLKey
+	}
+	d = n.receiverTemplateData(alert, receiver, nil)
+	assert.Equal(t, "https://icinga.example.com/monitoring/service/show?host=demo%40pod%40nginx-0&service=pod-exec", d.IcingaWebURL)
+}
+
+func TestAcknowledgeURLs(t *testing.T) {
+	host, err := icinga.ParseHost("demo@pod@nginx-0")
+	assert.NoError(t, err)
+	n := newPlugin(nil, nil, options{
+		hostname:         "demo@pod@nginx-0",
+		alertName:        "pod-exec",
+		notificationType: string(api.NotificationProblem),
+		serviceState:     stateCritical,
+		time:             time.Date(2018, 4, 28, 11, 9, 0, 0, time.UTC),
+		host:             host,
+	})
+	receiver := routedReceiver{Receiver: api.Receiver{State: stateCritical, To: []string{"ops@example.com"}}}
+
+	ack, unack := n.acknowledgeURLs(receiver, nil)
+	assert.Empty(t, ack)
+	assert.Empty(t, unack)
+
+	n.links, err = acknowledgement.NewLinks("https://searchlight.example.com", []byte("secret"), time.Hour)
+	assert.NoError(t, err)
+	verify := func(link string) *acknowledgement.Token {
+		u, err := url.Parse(link)
+		assert.NoError(t, err)
+		token, err := n.links.Verify(u.Query().Get("token"), time.Now())
+		assert.NoError(t, err)
+		return token
+	}
+
+	// first notification of problem links to the incident created after it
+	ack, unack = n.acknowledgeURLs(receiver, nil)
+	assert.Empty(t, unack)
+	token := verify(ack)
+	assert.Equal(t, acknowledgement.ActionAcknowledge, token.Action)
+	assert.Equal(t, "demo", token.Namespace)
+	assert.Equal(t, "pod.nginx-0.pod-exec.20180428-1109", token.Incident)
+	assert.Equal(t, "ops@example.com", token.Author)
+	assert.Contains(t, appendLinks("msg", TemplateData{AcknowledgeURL: ack}), "\nAcknowledge: "+ack)
+
+	incident := &api.Incident{ObjectMeta: metav1.ObjectMeta{Name: "pod.nginx-0.pod-exec.20180428-1000", Namespace: "demo"}}
+	n.options.notificationType = string(api.NotificationAcknowledgement)
+	ack, unack = n.acknowledgeURLs(receiver, incident)
+	assert.Empty(t, ack)
+	token = verify(unack)
+	assert.Equal(t, acknowledgement.ActionUnacknowledge, token.Action)
+	assert.Equal(t, incident.Name, token.Incident)
+
+	n.options.notificationType = string(api.NotificationRecovery)
+	ack, unack = n.acknowledgeURLs(receiver, incident)
+	assert.Empty(t, ack)
+	assert.Empty(t, unack)
+}
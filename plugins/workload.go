@@ -0,0 +1,88 @@
+package plugins
+
+import (
+	"fmt"
+
+	api "github.com/appscode/searchlight/apis/monitoring/v1alpha1"
+	apps "k8s.io/api/apps/v1"
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Workload is the part of a Deployment, StatefulSet or DaemonSet used by workload checks.
+type Workload struct {
+	Kind string
+	metav1.ObjectMeta
+	Selector           *metav1.LabelSelector
+	ObservedGeneration int64
+	// Desired is the number of replicas for Deployment and StatefulSet, and the number of
+	// nodes that should run the daemon pod for DaemonSet.
+	Desired   int32
+	Available int32
+	Updated   int32
+	// ProgressDeadlineExceeded is only set for Deployments.
+	ProgressDeadlineExceeded bool
+}
+
+func GetWorkload(kc kubernetes.Interface, kind, namespace, name string) (*Workload, error) {
+	switch kind {
+	case api.WorkloadKindDeployment:
+		obj, err := kc.AppsV1().Deployments(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		w := &Workload{
+			Kind:               kind,
+			ObjectMeta:         obj.ObjectMeta,
+			Selector:           obj.Spec.Selector,
+			ObservedGeneration: obj.Status.ObservedGeneration,
+			Desired:            replicas(obj.Spec.Replicas),
+			Available:          obj.Status.AvailableReplicas,
+			Updated:            obj.Status.UpdatedReplicas,
+		}
+		for _, c := range obj.Status.Conditions {
+			if c.Type == apps.DeploymentProgressing && c.Status == core.ConditionFalse && c.Reason == "ProgressDeadlineExceeded" {
+				w.ProgressDeadlineExceeded = true
+			}
+		}
+		return w, nil
+	case api.WorkloadKindStatefulSet:
+		obj, err := kc.AppsV1().StatefulSets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &Workload{
+			Kind:               kind,
+			ObjectMeta:         obj.ObjectMeta,
+			Selector:           obj.Spec.Selector,
+			ObservedGeneration: obj.Status.ObservedGeneration,
+			Desired:            replicas(obj.Spec.Replicas),
+			Available:          obj.Status.ReadyReplicas,
+			Updated:            obj.Status.UpdatedReplicas,
+		}, nil
+	case api.WorkloadKindDaemonSet:
+		obj, err := kc.AppsV1().DaemonSets(namespace).Get(name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &Workload{
+			Kind:               kind,
+			ObjectMeta:         obj.ObjectMeta,
+			Selector:           obj.Spec.Selector,
+			ObservedGeneration: obj.Status.ObservedGeneration,
+			Desired:            obj.Status.DesiredNumberScheduled,
+			Available:          obj.Status.NumberAvailable,
+			Updated:            obj.Status.UpdatedNumberScheduled,
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown workload kind %s", kind)
+}
+
+// replicas defaults to 1, same as the API server.
+func replicas(r *int32) int32 {
+	if r == nil {
+		return 1
+	}
+	return *r
+}